@@ -17,19 +17,27 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	stdlog "log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	k8sflag "k8s.io/component-base/cli/flag"
 
 	"github.com/prometheus-operator/prometheus-operator/internal/goruntime"
 	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
 	"github.com/prometheus-operator/prometheus-operator/internal/metrics"
 	"github.com/prometheus-operator/prometheus-operator/pkg/admission"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
 )
@@ -42,6 +50,12 @@ func main() {
 		flagset       = flag.CommandLine
 		logConfig     logging.Config
 		memlimitRatio float64
+
+		validateReferences bool
+
+		ruleLintPrometheusVersion string
+		ruleLintChecks            = k8sflag.NewMapStringString(&map[string]string{})
+		ruleLintDuplicateAlerts   bool
 	)
 
 	server.RegisterFlags(flagset, &serverConfig)
@@ -50,6 +64,12 @@ func main() {
 
 	flagset.Float64Var(&memlimitRatio, "auto-gomemlimit-ratio", defaultGOMemlimitRatio, "The ratio of reserved GOMEMLIMIT memory to the detected maximum container or system memory. The value should be greater than 0.0 and less than 1.0. Default: 0.0 (disabled).")
 
+	flagset.BoolVar(&validateReferences, "validate-references", false, "Verify that the Secret and ConfigMap keys referenced by ServiceMonitor, PodMonitor, Probe and ScrapeConfig objects exist. The webhook connects to the Kubernetes API using the in-cluster configuration (or the KUBECONFIG environment variable) and its service account needs the permissions to get Secrets and ConfigMaps. Default: false.")
+
+	flagset.StringVar(&ruleLintPrometheusVersion, "rule-lint-prometheus-version", operator.DefaultPrometheusVersion, "Prometheus version used to check that the functions of the PrometheusRule expressions are supported.")
+	flagset.Var(ruleLintChecks, "rule-lint-checks", fmt.Sprintf("Comma-separated list of <check>=<action> pairs configuring how the PrometheusRule lint checks are reported. Checks: %s. Actions: warn (default), deny, disabled.", strings.Join(ruleLintCheckNames(), ", ")))
+	flagset.BoolVar(&ruleLintDuplicateAlerts, "rule-lint-duplicate-alerts", false, "Detect alerting rules whose name is already used by other PrometheusRule objects in the same namespace. The webhook connects to the Kubernetes API using the in-cluster configuration (or the KUBECONFIG environment variable) and its service account needs the permissions to list PrometheusRules. Default: false.")
+
 	_ = flagset.Parse(os.Args[1:])
 
 	if versionutil.ShouldPrintVersion() {
@@ -69,8 +89,45 @@ func main() {
 	defer cancel()
 	wg, ctx := errgroup.WithContext(ctx)
 
+	ruleLinter, err := operator.NewRuleLinter(ruleLintPrometheusVersion, *ruleLintChecks.Map)
+	if err != nil {
+		logger.Error("invalid rule lint configuration", "err", err)
+		os.Exit(1)
+	}
+
+	opts := []admission.Option{admission.WithRuleLinter(ruleLinter)}
+
+	var restConfig *rest.Config
+	if validateReferences || ruleLintDuplicateAlerts {
+		restConfig, err = k8sutil.NewClusterConfig(k8sutil.ClusterConfig{})
+		if err != nil {
+			logger.Error("failed to create Kubernetes client configuration", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	if validateReferences {
+		kclient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create Kubernetes client", "err", err)
+			os.Exit(1)
+		}
+
+		opts = append(opts, admission.WithKubernetesClient(kclient))
+	}
+
+	if ruleLintDuplicateAlerts {
+		mclient, err := monitoringclient.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create monitoring client", "err", err)
+			os.Exit(1)
+		}
+
+		opts = append(opts, admission.WithMonitoringClient(mclient))
+	}
+
 	mux := http.NewServeMux()
-	admit := admission.New(logger.With("component", "admissionwebhook"))
+	admit := admission.New(logger.With("component", "admissionwebhook"), opts...)
 	admit.Register(mux)
 
 	r := metrics.NewRegistry("prometheus_operator_admission_webhook")
@@ -111,3 +168,12 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+func ruleLintCheckNames() []string {
+	names := make([]string, 0, len(operator.RuleLintChecks))
+	for _, c := range operator.RuleLintChecks {
+		names = append(names, string(c))
+	}
+
+	return names
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/internal/goruntime"
 	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
 	"github.com/prometheus-operator/prometheus-operator/internal/metrics"
+	"github.com/prometheus-operator/prometheus-operator/internal/tracing"
 	"github.com/prometheus-operator/prometheus-operator/pkg/admission"
 	alertmanagercontroller "github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
@@ -56,6 +57,7 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	prometheusagentcontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/agent"
 	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
+	ruletestcontroller "github.com/prometheus-operator/prometheus-operator/pkg/ruletest"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	thanoscontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
@@ -109,6 +111,8 @@ var (
 
 	logConfig logging.Config
 
+	tracingConfig tracing.Config
+
 	impersonateUser string
 	apiServer       string
 	tlsClientConfig rest.TLSClientConfig
@@ -119,6 +123,8 @@ var (
 
 	disableUnmanagedPrometheusConfiguration bool
 
+	dryRun bool
+
 	// Parameters for the kubelet endpoints controller.
 	kubeletObject        string
 	kubeletSelector      operator.LabelSelector
@@ -170,7 +176,7 @@ func parseFlags(fs *flag.FlagSet) {
 	fs.Var(cfg.Namespaces.PrometheusAllowList, "prometheus-instance-namespaces", "Namespaces where Prometheus and PrometheusAgent custom resources and corresponding Secrets, Configmaps and StatefulSets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for Prometheus custom resources.")
 	fs.Var(cfg.Namespaces.AlertmanagerAllowList, "alertmanager-instance-namespaces", "Namespaces where Alertmanager custom resources and corresponding StatefulSets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for Alertmanager custom resources.")
 	fs.Var(cfg.Namespaces.AlertmanagerConfigAllowList, "alertmanager-config-namespaces", "Namespaces where AlertmanagerConfig custom resources and corresponding Secrets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for AlertmanagerConfig custom resources.")
-	fs.Var(cfg.Namespaces.ThanosRulerAllowList, "thanos-ruler-instance-namespaces", "Namespaces where ThanosRuler custom resources and corresponding StatefulSets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for ThanosRuler custom resources.")
+	fs.Var(cfg.Namespaces.ThanosRulerAllowList, "thanos-ruler-instance-namespaces", "Namespaces where ThanosRuler, ThanosQuery, ThanosCompactor and ThanosStore custom resources and corresponding StatefulSets and Deployments are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for ThanosRuler, ThanosQuery, ThanosCompactor and ThanosStore custom resources.")
 	fs.BoolVar(&cfg.WatchObjectRefsInAllNamespaces, "watch-referenced-objects-in-all-namespaces", false, "When true the operator watches for configmaps and secrets in both workload and configuration resource namespaces.\nWhen false (default), the operator will only watch for secrets and configmaps in:\n* Workload namespaces for Prometheus and PrometheusAgent resources.\n* Configuration namespaces for Alertmanager resources.")
 
 	fs.Var(&cfg.Annotations, "annotations", "Annotations to be add to all resources created by the operator")
@@ -187,9 +193,11 @@ func parseFlags(fs *flag.FlagSet) {
 
 	fs.Float64Var(&memlimitRatio, "auto-gomemlimit-ratio", defaultMemlimitRatio, "The ratio of reserved GOMEMLIMIT memory to the detected maximum container or system memory. The value should be greater than 0.0 and less than 1.0. Default: 0.0 (disabled).")
 	fs.BoolVar(&disableUnmanagedPrometheusConfiguration, "disable-unmanaged-prometheus-configuration", false, "Disable support for unmanaged Prometheus configuration when all resource selectors are nil. As stated in the API documentation, unmanaged Prometheus configuration is a deprecated feature which can be avoided with '.spec.additionalScrapeConfigs' or the ScrapeConfig CRD. Default: false.")
+	fs.BoolVar(&dryRun, "dry-run", false, "Run the reconciliation loops without modifying any Kubernetes object. Every write request is sent to the API server as a server-side dry-run request (create and update requests are converted into server-side apply requests) and the changes which would have been made are logged as a diff per object. The RBAC permissions required are the same as in normal mode, plus the 'patch' verb on all the managed resources. Default: false.")
 	cfg.RegisterFeatureGatesFlags(fs, featureGates)
 
 	logging.RegisterFlags(fs, &logConfig)
+	tracing.RegisterFlags(fs, &tracingConfig)
 	versionutil.RegisterFlags(fs)
 
 	// No need to check for errors because Parse would exit on error.
@@ -232,6 +240,17 @@ func run(fs *flag.FlagSet) int {
 	}
 	logger.Info("Namespaces filtering configuration ", "config", cfg.Namespaces.String())
 
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig, "prometheus-operator", version.Version)
+	if err != nil {
+		logger.Error("failed to configure tracing", "err", err)
+		return 1
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Warn("failed to flush the pending traces", "err", err)
+		}
+	}()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	wg, ctx := errgroup.WithContext(ctx)
 	r := metrics.NewRegistry("prometheus_operator")
@@ -250,6 +269,16 @@ func run(fs *flag.FlagSet) int {
 		return 1
 	}
 
+	if dryRun {
+		logger.Warn("Dry-run mode enabled, changes to Kubernetes objects are logged but not persisted")
+		k8sutil.EnableDryRun(restConfig, logger.With("component", "dry-run"), operator.PrometheusOperatorFieldManager, r)
+	}
+
+	if tracingConfig.Endpoint != "" {
+		logger.Info("Tracing enabled", "endpoint", tracingConfig.Endpoint, "protocol", tracingConfig.Protocol)
+		k8sutil.EnableTracing(restConfig)
+	}
+
 	kclient, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
 		logger.Error("failed to create Kubernetes client", "err", err)
@@ -278,6 +307,7 @@ func run(fs *flag.FlagSet) int {
 		promAgentControllerOptions    = []prometheusagentcontroller.ControllerOption{}
 		promControllerOptions         = []prometheuscontroller.ControllerOption{}
 		thanosControllerOptions       = []thanoscontroller.ControllerOption{}
+		thanosBucketControllerOptions = []thanoscontroller.BucketControllerOption{}
 	)
 	if disableUnmanagedPrometheusConfiguration {
 		logger.Info("Disabling support for unmanaged Prometheus configurations")
@@ -308,6 +338,7 @@ func run(fs *flag.FlagSet) int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithStorageClassValidation())
 		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithStorageClassValidation())
 		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithStorageClassValidation())
+		thanosBucketControllerOptions = append(thanosBucketControllerOptions, thanoscontroller.WithBucketStorageClassValidation())
 	}
 
 	canEmitEvents, reasons, err := k8sutil.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), nil,
@@ -486,6 +517,81 @@ func run(fs *flag.FlagSet) int {
 		return 1
 	}
 
+	silenceSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.AlertmanagerSilenceName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.AlertmanagerSilenceName,
+			Verbs:    []string{"get", "list", "watch", "patch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.AlertmanagerSilenceName),
+			Verbs:    []string{"update"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check AlertmanagerSilence support", "err", err)
+		cancel()
+		return 1
+	}
+	if silenceSupported {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithSilences(alertmanagercontroller.NewHTTPSilenceClient()))
+	}
+
+	sharedReceiverSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.AlertmanagerReceiverName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.AlertmanagerReceiverName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check AlertmanagerReceiver support", "err", err)
+		cancel()
+		return 1
+	}
+	if sharedReceiverSupported {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithSharedReceivers())
+	}
+
+	templateSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.AlertmanagerTemplateName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.AlertmanagerTemplateName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check AlertmanagerTemplate support", "err", err)
+		cancel()
+		return 1
+	}
+	if templateSupported {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithTemplates())
+	}
+
 	var ao *alertmanagercontroller.Operator
 	if alertmanagerSupported {
 		ao, err = alertmanagercontroller.New(ctx, restConfig, cfg, logger, r, alertmanagerControllerOptions...)
@@ -532,6 +638,174 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
+	thanosQuerySupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosQueryName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosQueryName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosQueryName),
+			Verbs:    []string{"update"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    appsv1.SchemeGroupVersion.Group,
+			Version:  appsv1.SchemeGroupVersion.Version,
+			Resource: "deployments",
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosQuery support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tqo *thanoscontroller.QueryOperator
+	if thanosQuerySupported {
+		tqo, err = thanoscontroller.NewQueryOperator(ctx, restConfig, cfg, logger, r)
+		if err != nil {
+			logger.Error("instantiating thanos query controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	thanosCompactorSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosCompactorName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosCompactorName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosCompactorName),
+			Verbs:    []string{"update"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    appsv1.SchemeGroupVersion.Group,
+			Version:  appsv1.SchemeGroupVersion.Version,
+			Resource: "statefulsets",
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosCompactor support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tco *thanoscontroller.CompactorOperator
+	if thanosCompactorSupported {
+		tco, err = thanoscontroller.NewCompactorOperator(restConfig, cfg, logger, r, thanosBucketControllerOptions...)
+		if err != nil {
+			logger.Error("instantiating thanos compactor controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	thanosStoreSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosStoreName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosStoreName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosStoreName),
+			Verbs:    []string{"update"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    appsv1.SchemeGroupVersion.Group,
+			Version:  appsv1.SchemeGroupVersion.Version,
+			Resource: "statefulsets",
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosStore support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tso *thanoscontroller.StoreOperator
+	if thanosStoreSupported {
+		tso, err = thanoscontroller.NewStoreOperator(restConfig, cfg, logger, r, thanosBucketControllerOptions...)
+		if err != nil {
+			logger.Error("instantiating thanos store controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	ruleTestSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.PrometheusRuleTestName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.PrometheusRuleTestName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.PrometheusRuleTestName),
+			Verbs:    []string{"update"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1.Version,
+			Resource: monitoringv1.PrometheusRuleName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check PrometheusRuleTest support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var rto *ruletestcontroller.Operator
+	if ruleTestSupported {
+		rto, err = ruletestcontroller.New(restConfig, cfg, logger, r)
+		if err != nil {
+			logger.Error("instantiating prometheusruletest controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
 	var kec *kubelet.Controller
 	if kubeletObject != "" {
 		opts := []kubelet.ControllerOption{
@@ -593,7 +867,7 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
-	if po == nil && pao == nil && ao == nil && to == nil && kec == nil {
+	if po == nil && pao == nil && ao == nil && to == nil && tqo == nil && tco == nil && tso == nil && rto == nil && kec == nil {
 		logger.Error("no controller can be started, check the RBAC permissions of the service account")
 		cancel()
 		return 1
@@ -603,6 +877,15 @@ func run(fs *flag.FlagSet) int {
 	mux := http.NewServeMux()
 	admit := admission.New(logger.With("component", "admissionwebhook"))
 	admit.Register(mux)
+	if po != nil {
+		po.Register(mux)
+	}
+	if pao != nil {
+		pao.Register(mux)
+	}
+	if ao != nil {
+		ao.Register(mux)
+	}
 
 	r.MustRegister(cfg.Gates)
 
@@ -639,6 +922,18 @@ func run(fs *flag.FlagSet) int {
 	if to != nil {
 		wg.Go(func() error { return to.Run(ctx) })
 	}
+	if tqo != nil {
+		wg.Go(func() error { return tqo.Run(ctx) })
+	}
+	if tco != nil {
+		wg.Go(func() error { return tco.Run(ctx) })
+	}
+	if tso != nil {
+		wg.Go(func() error { return tso.Run(ctx) })
+	}
+	if rto != nil {
+		wg.Go(func() error { return rto.Run(ctx) })
+	}
 	if kec != nil {
 		wg.Go(func() error { return kec.Run(ctx) })
 	}
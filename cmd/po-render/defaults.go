@@ -0,0 +1,95 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// schemaDefaulter applies the default values declared by the OpenAPI schemas
+// of the CustomResourceDefinitions, the same way as the Kubernetes API server
+// does when the objects are created.
+type schemaDefaulter map[schema.GroupVersionKind]*structuralschema.Structural
+
+// newSchemaDefaulter loads the CustomResourceDefinition manifests from the
+// directory. A nil defaulter is returned if the directory is empty.
+func newSchemaDefaulter(dir string) (schemaDefaulter, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	sd := schemaDefaulter{}
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(b, crd); err != nil {
+			return nil, fmt.Errorf("%s: failed to decode CustomResourceDefinition: %w", f, err)
+		}
+
+		if crd.Kind != "CustomResourceDefinition" {
+			continue
+		}
+
+		for _, v := range crd.Spec.Versions {
+			if v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+				continue
+			}
+
+			internal := &apiextensions.JSONSchemaProps{}
+			if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(v.Schema.OpenAPIV3Schema, internal, nil); err != nil {
+				return nil, fmt.Errorf("%s: failed to convert schema of version %q: %w", f, v.Name, err)
+			}
+
+			s, err := structuralschema.NewStructural(internal)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid schema for version %q: %w", f, v.Name, err)
+			}
+
+			sd[schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Spec.Names.Kind}] = s
+		}
+	}
+
+	return sd, nil
+}
+
+// Default applies the schema defaults to the unstructured object. It returns
+// false if no schema is known for the object's kind.
+func (sd schemaDefaulter) Default(gvk schema.GroupVersionKind, obj map[string]any) bool {
+	s, found := sd[gvk]
+	if !found {
+		return false
+	}
+
+	defaulting.Default(obj, s)
+	return true
+}
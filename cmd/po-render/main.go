@@ -0,0 +1,116 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-render generates the Prometheus configuration, the rule files and the
+// web configuration that the operator would produce for a Prometheus object,
+// using a directory of manifests instead of a Kubernetes cluster.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	stdlog "log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"k8s.io/klog/v2"
+
+	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+func main() {
+	os.Exit(run(flag.CommandLine))
+}
+
+func run(fs *flag.FlagSet) int {
+	var (
+		logConfig        logging.Config
+		manifestsDir     string
+		crdsDir          string
+		outputDir        string
+		defaultNamespace string
+		opts             renderOptions
+	)
+
+	fs.StringVar(&manifestsDir, "manifests", "", "Directory containing the YAML or JSON manifests (Prometheus, ServiceMonitor, PodMonitor, Probe, ScrapeConfig, PrometheusRule, Secret, ConfigMap and Namespace objects). Sub-directories are read recursively.")
+	fs.StringVar(&crdsDir, "crds", "", "Directory containing the CustomResourceDefinition manifests of the operator (e.g. example/prometheus-operator-crd). When set, the default values declared by the CRD schemas are applied to the objects like the Kubernetes API server does. Without it, fields such as scrapeInterval must be set explicitly.")
+	fs.StringVar(&outputDir, "output-dir", "", "Directory where the generated files are written.")
+	fs.StringVar(&defaultNamespace, "namespace", "default", "Namespace assigned to the objects which have no namespace.")
+	fs.StringVar(&opts.prometheus, "prometheus", "", "Prometheus object to render in the \"<namespace>/<name>\" or \"<name>\" format. It can be omitted if the manifests contain only one Prometheus object.")
+	fs.BoolVar(&opts.endpointSlice, "endpointslice", true, "Assume that the Kubernetes API supports the EndpointSlice API. When disabled, ServiceMonitors configured with the EndpointSlice role fall back to the Endpoints role.")
+	logging.RegisterFlags(fs, &logConfig)
+	versionutil.RegisterFlags(fs)
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-render")
+		return 0
+	}
+
+	logger, err := logging.NewLoggerSlog(logConfig)
+	if err != nil {
+		stdlog.Fatal(err)
+	}
+	klog.SetSlogLogger(logger)
+
+	if manifestsDir == "" || outputDir == "" {
+		fmt.Fprintln(os.Stderr, "the --manifests and --output-dir flags are required")
+		fs.PrintDefaults()
+		return 1
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	defaulter, err := newSchemaDefaulter(crdsDir)
+	if err != nil {
+		logger.Error("failed to load CustomResourceDefinitions", "err", err)
+		return 1
+	}
+
+	if defaulter == nil {
+		logger.Warn("no CustomResourceDefinitions provided, schema defaults aren't applied")
+	}
+
+	m, err := loadManifests(logger, manifestsDir, defaultNamespace, defaulter)
+	if err != nil {
+		logger.Error("failed to load manifests", "err", err)
+		return 1
+	}
+
+	p, err := m.prometheus(opts.prometheus)
+	if err != nil {
+		logger.Error("failed to find the Prometheus object", "err", err)
+		return 1
+	}
+
+	r, err := newRenderer(ctx, logger, m)
+	if err != nil {
+		logger.Error("failed to initialize the renderer", "err", err)
+		return 1
+	}
+
+	if err := r.render(ctx, p, opts, outputDir); err != nil {
+		logger.Error("failed to render the Prometheus configuration", "err", err, "prometheus", p.Name, "namespace", p.Namespace)
+		return 1
+	}
+
+	logger.Info("configuration rendered", "prometheus", p.Name, "namespace", p.Namespace, "output_dir", outputDir)
+	return 0
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	k8sYAML "k8s.io/apimachinery/pkg/util/yaml"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringscheme "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/scheme"
+)
+
+// manifests holds the objects decoded from the input directory, sorted by
+// kind.
+type manifests struct {
+	prometheuses []*monitoringv1.Prometheus
+
+	// Objects served by the Kubernetes clientset (Namespaces, Secrets and
+	// ConfigMaps).
+	kubeObjects []runtime.Object
+	// Objects served by the monitoring clientset (ServiceMonitors,
+	// PodMonitors, Probes, ScrapeConfigs and PrometheusRules).
+	monitoringObjects []runtime.Object
+}
+
+func newDecoder() (runtime.Decoder, error) {
+	scheme := runtime.NewScheme()
+	if err := kscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	if err := monitoringscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	return serializer.NewCodecFactory(scheme).UniversalDeserializer(), nil
+}
+
+// loadManifests reads all the YAML and JSON files from the directory (and its
+// sub-directories). Objects without namespace are assigned to
+// defaultNamespace. Objects of unsupported kinds are ignored.
+// If the defaulter isn't nil, the schema defaults are applied to the custom
+// resources before decoding.
+func loadManifests(logger *slog.Logger, dir string, defaultNamespace string, defaulter schemaDefaulter) (*manifests, error) {
+	decoder, err := newDecoder()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decoder: %w", err)
+	}
+
+	var files []string
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files in %q: %w", dir, err)
+	}
+	slices.Sort(files)
+
+	m := &manifests{}
+	for _, f := range files {
+		if err := m.loadFile(logger, decoder, defaulter, f, defaultNamespace); err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+	}
+
+	m.addMissingNamespaces()
+
+	return m, nil
+}
+
+func (m *manifests) loadFile(logger *slog.Logger, decoder runtime.Decoder, defaulter schemaDefaulter, path string, defaultNamespace string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := k8sYAML.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		if defaulter != nil {
+			doc, err = applyDefaults(defaulter, doc)
+			if err != nil {
+				return err
+			}
+		}
+
+		obj, gvk, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) || runtime.IsMissingKind(err) {
+				logger.Debug("ignoring unsupported document", "file", path, "err", err)
+				continue
+			}
+			return fmt.Errorf("failed to decode document: %w", err)
+		}
+
+		if o, ok := obj.(metav1.Object); ok && o.GetNamespace() == "" {
+			if _, isNamespace := obj.(*v1.Namespace); !isNamespace {
+				o.SetNamespace(defaultNamespace)
+			}
+		}
+
+		switch o := obj.(type) {
+		case *monitoringv1.Prometheus:
+			m.prometheuses = append(m.prometheuses, o)
+		case *v1.Secret:
+			// The API server merges the write-only stringData field into data.
+			for k, v := range o.StringData {
+				if o.Data == nil {
+					o.Data = map[string][]byte{}
+				}
+				o.Data[k] = []byte(v)
+			}
+			o.StringData = nil
+			m.kubeObjects = append(m.kubeObjects, o)
+		case *v1.Namespace, *v1.ConfigMap:
+			m.kubeObjects = append(m.kubeObjects, o)
+		case *monitoringv1.ServiceMonitor, *monitoringv1.PodMonitor, *monitoringv1.Probe, *monitoringv1alpha1.ScrapeConfig, *monitoringv1.PrometheusRule:
+			m.monitoringObjects = append(m.monitoringObjects, o)
+		default:
+			logger.Debug("ignoring unsupported object", "file", path, "kind", gvk.Kind)
+		}
+	}
+}
+
+// applyDefaults returns the document with the schema defaults applied. The
+// document is returned unchanged if its kind isn't known by the defaulter.
+func applyDefaults(defaulter schemaDefaulter, doc []byte) ([]byte, error) {
+	u := map[string]any{}
+	if err := yaml.Unmarshal(doc, &u); err != nil {
+		return nil, fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	apiVersion, _ := u["apiVersion"].(string)
+	kind, _ := u["kind"].(string)
+	if !defaulter.Default(schema.FromAPIVersionAndKind(apiVersion, kind), u) {
+		return doc, nil
+	}
+
+	return json.Marshal(u)
+}
+
+// addMissingNamespaces creates the Namespace objects which aren't defined by
+// the manifests but contain at least one object.
+func (m *manifests) addMissingNamespaces() {
+	declared := map[string]struct{}{}
+	for _, o := range m.kubeObjects {
+		if ns, ok := o.(*v1.Namespace); ok {
+			declared[ns.Name] = struct{}{}
+		}
+	}
+
+	var objects []runtime.Object
+	objects = append(objects, m.kubeObjects...)
+	objects = append(objects, m.monitoringObjects...)
+	for _, p := range m.prometheuses {
+		objects = append(objects, p)
+	}
+
+	for _, o := range objects {
+		ns := o.(metav1.Object).GetNamespace()
+		if ns == "" {
+			continue
+		}
+
+		if _, found := declared[ns]; found {
+			continue
+		}
+
+		declared[ns] = struct{}{}
+		m.kubeObjects = append(m.kubeObjects, &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ns,
+				Labels: map[string]string{
+					"kubernetes.io/metadata.name": ns,
+				},
+			},
+		})
+	}
+}
+
+// prometheus returns the Prometheus object identified by key which is either
+// "<namespace>/<name>" or "<name>". If key is empty, the manifests should
+// contain exactly one Prometheus object.
+func (m *manifests) prometheus(key string) (*monitoringv1.Prometheus, error) {
+	if key == "" {
+		switch len(m.prometheuses) {
+		case 0:
+			return nil, errors.New("no Prometheus object found")
+		case 1:
+			return m.prometheuses[0], nil
+		default:
+			return nil, fmt.Errorf("found %d Prometheus objects, use the --prometheus flag to select one", len(m.prometheuses))
+		}
+	}
+
+	ns, name, found := strings.Cut(key, "/")
+	if !found {
+		name, ns = ns, ""
+	}
+
+	var matches []*monitoringv1.Prometheus
+	for _, p := range m.prometheuses {
+		if p.Name != name || (ns != "" && p.Namespace != ns) {
+			continue
+		}
+		matches = append(matches, p)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Prometheus %q not found", key)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d Prometheus objects named %q, use the \"<namespace>/<name>\" format", len(matches), key)
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+const (
+	// configFilename is the name of the generated Prometheus configuration file.
+	configFilename = "prometheus.yaml"
+	// rulesDirname is the name of the directory containing the rule files.
+	// Rule files are written in one sub-directory per ConfigMap, the same
+	// way as they are mounted into the Prometheus pods.
+	rulesDirname = "rules"
+)
+
+type renderOptions struct {
+	// Key of the Prometheus object to render ("<namespace>/<name>" or "<name>").
+	prometheus string
+	// Whether the generated configuration should use the EndpointSlice role
+	// for service discovery.
+	endpointSlice bool
+}
+
+// renderer generates the configuration files of a Prometheus object without
+// a Kubernetes cluster. The input objects are served by fake clientsets and
+// the same selection and generation logic as the operator is applied.
+type renderer struct {
+	logger *slog.Logger
+
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+
+	nsInf     cache.SharedIndexInformer
+	smonInfs  *informers.ForResource
+	pmonInfs  *informers.ForResource
+	probeInfs *informers.ForResource
+	sconInfs  *informers.ForResource
+	ruleInfs  *informers.ForResource
+}
+
+func newRenderer(ctx context.Context, logger *slog.Logger, m *manifests) (*renderer, error) {
+	r := &renderer{
+		logger:  logger,
+		kclient: fake.NewClientset(m.kubeObjects...),
+		mclient: monitoringfake.NewSimpleClientset(m.monitoringObjects...),
+	}
+
+	kubeFactory := kubeinformers.NewSharedInformerFactory(r.kclient, 0)
+	r.nsInf = kubeFactory.Core().V1().Namespaces().Informer()
+
+	for _, infs := range []struct {
+		informersForResource **informers.ForResource
+		resource             schema.GroupVersionResource
+	}{
+		{&r.smonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName)},
+		{&r.pmonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName)},
+		{&r.probeInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName)},
+		{&r.sconInfs, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName)},
+		{&r.ruleInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName)},
+	} {
+		var err error
+		*infs.informersForResource, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				map[string]struct{}{metav1.NamespaceAll: {}},
+				map[string]struct{}{},
+				r.mclient,
+				0,
+				nil,
+			),
+			infs.resource,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create informers for %s: %w", infs.resource.Resource, err)
+		}
+	}
+
+	kubeFactory.Start(ctx.Done())
+	hasSynced := []cache.InformerSynced{r.nsInf.HasSynced}
+	for _, infs := range []*informers.ForResource{r.smonInfs, r.pmonInfs, r.probeInfs, r.sconInfs, r.ruleInfs} {
+		infs.Start(ctx.Done())
+		hasSynced = append(hasSynced, infs.HasSynced)
+	}
+
+	if !cache.WaitForCacheSync(ctx.Done(), hasSynced...) {
+		return nil, errors.New("failed to sync caches")
+	}
+
+	return r, nil
+}
+
+// render writes the Prometheus configuration, the rule files and the web
+// configuration of the Prometheus object to the output directory.
+func (r *renderer) render(ctx context.Context, p *monitoringv1.Prometheus, opts renderOptions, outputDir string) error {
+	logger := r.logger.With("prometheus", p.Name, "namespace", p.Namespace)
+
+	if p.Spec.ServiceMonitorSelector == nil && p.Spec.PodMonitorSelector == nil &&
+		p.Spec.ProbeSelector == nil && p.Spec.ScrapeConfigSelector == nil {
+		return errors.New("the Prometheus configuration isn't managed by the operator because no resource selector is defined")
+	}
+
+	ruleFiles, err := r.selectRules(p, logger)
+	if err != nil {
+		return err
+	}
+
+	ruleConfigMaps, err := prometheuscontroller.MakeRulesConfigMaps(p, ruleFiles)
+	if err != nil {
+		return fmt.Errorf("failed to make rules ConfigMaps: %w", err)
+	}
+
+	ruleConfigMapNames := make([]string, 0, len(ruleConfigMaps))
+	for _, cm := range ruleConfigMaps {
+		ruleConfigMapNames = append(ruleConfigMapNames, cm.Name)
+	}
+
+	var cgOpts []prompkg.ConfigGeneratorOption
+	if opts.endpointSlice {
+		cgOpts = append(cgOpts, prompkg.WithEndpointSliceSupport())
+	}
+
+	cg, err := prompkg.NewConfigGenerator(logger, p, cgOpts...)
+	if err != nil {
+		return err
+	}
+
+	store := assets.NewStoreBuilder(r.kclient.CoreV1(), r.kclient.CoreV1())
+	eventRecorder := operator.NewEventRecorderFactory(false)(r.kclient, "po-render")(p)
+
+	rs, err := prompkg.NewResourceSelector(logger, p, store, r.nsInf, operator.NewMetrics(prometheus.NewRegistry()), eventRecorder)
+	if err != nil {
+		return err
+	}
+
+	smons, err := rs.SelectServiceMonitors(ctx, r.smonInfs.ListAllByNamespace)
+	if err != nil {
+		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+	}
+
+	pmons, err := rs.SelectPodMonitors(ctx, r.pmonInfs.ListAllByNamespace)
+	if err != nil {
+		return fmt.Errorf("selecting PodMonitors failed: %w", err)
+	}
+
+	bmons, err := rs.SelectProbes(ctx, r.probeInfs.ListAllByNamespace)
+	if err != nil {
+		return fmt.Errorf("selecting Probes failed: %w", err)
+	}
+
+	scrapeConfigs, err := rs.SelectScrapeConfigs(ctx, r.sconInfs.ListAllByNamespace)
+	if err != nil {
+		return fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+	}
+
+	conf, err := prometheuscontroller.GenerateConfiguration(
+		ctx,
+		logger,
+		r.kclient.CoreV1().Secrets(p.Namespace),
+		p,
+		cg,
+		store,
+		smons.ValidResources(),
+		pmons.ValidResources(),
+		bmons.ValidResources(),
+		scrapeConfigs.ValidResources(),
+		ruleConfigMapNames,
+	)
+	if err != nil {
+		return err
+	}
+
+	var fields monitoringv1.WebConfigFileFields
+	if p.Spec.Web != nil {
+		fields = p.Spec.Web.WebConfigFileFields
+	}
+
+	webConfig, err := webconfig.New(prompkg.WebConfigDir, prompkg.WebConfigSecretName(p), fields)
+	if err != nil {
+		return fmt.Errorf("failed to initialize web config: %w", err)
+	}
+
+	webConfigData, err := webConfig.GenerateConfigFileContents()
+	if err != nil {
+		return fmt.Errorf("failed to generate web config: %w", err)
+	}
+
+	if err := writeFile(filepath.Join(outputDir, configFilename), conf); err != nil {
+		return err
+	}
+
+	for _, cm := range ruleConfigMaps {
+		for filename, content := range cm.Data {
+			if err := writeFile(filepath.Join(outputDir, rulesDirname, cm.Name, filename), []byte(content)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writeFile(filepath.Join(outputDir, webconfig.ConfigFile), webConfigData)
+}
+
+// selectRules returns the rule files generated from the PrometheusRule
+// objects selected by the Prometheus object.
+func (r *renderer) selectRules(p *monitoringv1.Prometheus, logger *slog.Logger) (map[string]string, error) {
+	namespaces := []string{p.Namespace}
+	if p.Spec.RuleNamespaceSelector != nil {
+		ruleNamespaceSelector, err := metav1.LabelSelectorAsSelector(p.Spec.RuleNamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("convert rule namespace label selector to selector: %w", err)
+		}
+
+		namespaces, err = operator.ListMatchingNamespaces(ruleNamespaceSelector, r.nsInf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	eventRecorder := operator.NewEventRecorderFactory(false)(r.kclient, "po-render")(p)
+	promRuleSelector, err := prometheuscontroller.NewRuleSelector(p, r.ruleInfs, eventRecorder, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, _, err := promRuleSelector.Select(namespaces)
+	if err != nil {
+		return nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
+	}
+
+	return rules, nil
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+
+	return nil
+}
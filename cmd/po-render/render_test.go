@@ -0,0 +1,81 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+
+	defaulter, err := newSchemaDefaulter("../../example/prometheus-operator-crd")
+	require.NoError(t, err)
+
+	m, err := loadManifests(logger, "testdata/manifests", "default", defaulter)
+	require.NoError(t, err)
+
+	p, err := m.prometheus("monitoring/main")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := newRenderer(ctx, logger, m)
+	require.NoError(t, err)
+
+	outputDir := t.TempDir()
+	require.NoError(t, r.render(ctx, p, renderOptions{endpointSlice: true}, outputDir))
+
+	conf, err := os.ReadFile(filepath.Join(outputDir, configFilename))
+	require.NoError(t, err)
+	require.Contains(t, string(conf), "scrape_interval: 30s")
+	require.Contains(t, string(conf), "job_name: serviceMonitor/apps/frontend/0")
+	require.Contains(t, string(conf), "basic_auth:")
+	require.NotContains(t, string(conf), "serviceMonitor/apps/invalid")
+	require.NotContains(t, string(conf), "serviceMonitor/apps/backend")
+
+	rules, err := filepath.Glob(filepath.Join(outputDir, rulesDirname, "*", "*.yaml"))
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+
+	b, err := os.ReadFile(rules[0])
+	require.NoError(t, err)
+	require.Contains(t, string(b), "alert: FrontendDown")
+
+	_, err = os.Stat(filepath.Join(outputDir, "web-config.yaml"))
+	require.NoError(t, err)
+}
+
+func TestPrometheusKey(t *testing.T) {
+	m, err := loadManifests(slog.New(slog.DiscardHandler), "testdata/manifests", "default", nil)
+	require.NoError(t, err)
+
+	p, err := m.prometheus("")
+	require.NoError(t, err)
+	require.Equal(t, "main", p.Name)
+
+	_, err = m.prometheus("main")
+	require.NoError(t, err)
+
+	_, err = m.prometheus("default/main")
+	require.Error(t, err)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing configures the OpenTelemetry tracer provider of the
+// operator's binaries.
+package tracing
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http/protobuf"
+)
+
+// AvailableProtocols is the list of supported OTLP protocols.
+var AvailableProtocols = []string{
+	ProtocolGRPC,
+	ProtocolHTTP,
+}
+
+// Config defines the OTLP exporter's parameters.
+type Config struct {
+	// Endpoint is the address of the OTLP receiver. Tracing is disabled when
+	// empty.
+	Endpoint      string
+	Protocol      string
+	Insecure      bool
+	SamplingRatio float64
+}
+
+// RegisterFlags registers the tracing flags.
+func RegisterFlags(fs *flag.FlagSet, c *Config) {
+	fs.StringVar(&c.Endpoint, "tracing-endpoint", "", "Address (host:port) of the OTLP receiver to which the operator sends the traces of the reconciliation loops. Tracing is disabled when empty (default).")
+	fs.StringVar(&c.Protocol, "tracing-protocol", ProtocolGRPC, fmt.Sprintf("Protocol used to send the traces to the OTLP receiver. Possible values: %s", strings.Join(AvailableProtocols, ", ")))
+	fs.BoolVar(&c.Insecure, "tracing-insecure", false, "Disable TLS when sending the traces to the OTLP receiver.")
+	fs.Float64Var(&c.SamplingRatio, "tracing-sampling-ratio", 1.0, "Ratio of reconciliations which are traced (between 0.0 and 1.0).")
+}
+
+// Setup installs the global tracer provider and returns a function which
+// flushes the pending spans and shuts the provider down.
+// When no endpoint is configured, the global no-op tracer provider is kept.
+func Setup(ctx context.Context, c Config, serviceName, version string) (func(context.Context) error, error) {
+	if c.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if c.SamplingRatio < 0 || c.SamplingRatio > 1 {
+		return nil, fmt.Errorf("invalid tracing sampling ratio %v: must be between 0.0 and 1.0", c.SamplingRatio)
+	}
+
+	var client otlptrace.Client
+	switch c.Protocol {
+	case ProtocolGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.Endpoint)}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		client = otlptracegrpc.NewClient(opts...)
+	case ProtocolHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(c.Endpoint)}
+		if c.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		client = otlptracehttp.NewClient(opts...)
+	default:
+		return nil, fmt.Errorf("tracing protocol %q unknown, %v are possible values", c.Protocol, AvailableProtocols)
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version),
+			attribute.String("service.component", "operator"),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the tracing resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SamplingRatio))),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tp.Shutdown, nil
+}
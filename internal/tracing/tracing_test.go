@@ -0,0 +1,66 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config Config
+		err    bool
+	}{
+		{
+			name:   "disabled",
+			config: Config{Protocol: "invalid"},
+		},
+		{
+			name:   "grpc",
+			config: Config{Endpoint: "localhost:4317", Protocol: ProtocolGRPC, Insecure: true, SamplingRatio: 1},
+		},
+		{
+			name:   "http",
+			config: Config{Endpoint: "localhost:4318", Protocol: ProtocolHTTP, SamplingRatio: 0.1},
+		},
+		{
+			name:   "invalid protocol",
+			config: Config{Endpoint: "localhost:4317", Protocol: "thrift", SamplingRatio: 1},
+			err:    true,
+		},
+		{
+			name:   "invalid sampling ratio",
+			config: Config{Endpoint: "localhost:4317", Protocol: ProtocolGRPC, SamplingRatio: 2},
+			err:    true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			shutdown, err := Setup(context.Background(), tc.config, "test", "0.0.0")
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_ = shutdown(ctx)
+		})
+	}
+}
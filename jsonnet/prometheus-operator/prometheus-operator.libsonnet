@@ -50,6 +50,13 @@ function(params) {
   '0prometheusruleCustomResourceDefinition': import 'prometheusrules-crd.json',
   '0thanosrulerCustomResourceDefinition': import 'thanosrulers-crd.json',
   '0scrapeconfigCustomResourceDefinition': import 'scrapeconfigs-crd.json',
+  '0prometheusruletestCustomResourceDefinition': import 'prometheusruletests-crd.json',
+  '0alertmanagersilenceCustomResourceDefinition': import 'alertmanagersilences-crd.json',
+  '0alertmanagerreceiverCustomResourceDefinition': import 'alertmanagerreceivers-crd.json',
+  '0alertmanagertemplateCustomResourceDefinition': import 'alertmanagertemplates-crd.json',
+  '0thanosqueryCustomResourceDefinition': import 'thanosqueries-crd.json',
+  '0thanoscompactorCustomResourceDefinition': import 'thanoscompactors-crd.json',
+  '0thanosstoreCustomResourceDefinition': import 'thanosstores-crd.json',
 
   clusterRoleBinding: {
     apiVersion: 'rbac.authorization.k8s.io/v1',
@@ -85,6 +92,13 @@ function(params) {
                  'alertmanagers/finalizers',
                  'alertmanagers/status',
                  'alertmanagerconfigs',
+                 'alertmanagerconfigs/status',
+                 'alertmanagersilences',
+                 'alertmanagersilences/finalizers',
+                 'alertmanagersilences/status',
+                 'alertmanagerreceivers',
+                 'alertmanagertemplates',
+                 'alertmanagertemplates/status',
                  'prometheuses',
                  'prometheuses/finalizers',
                  'prometheuses/status',
@@ -94,6 +108,15 @@ function(params) {
                  'thanosrulers',
                  'thanosrulers/finalizers',
                  'thanosrulers/status',
+                 'thanosqueries',
+                 'thanosqueries/finalizers',
+                 'thanosqueries/status',
+                 'thanoscompactors',
+                 'thanoscompactors/finalizers',
+                 'thanoscompactors/status',
+                 'thanosstores',
+                 'thanosstores/finalizers',
+                 'thanosstores/status',
                  'scrapeconfigs',
                  'scrapeconfigs/status',
                  'servicemonitors',
@@ -103,12 +126,14 @@ function(params) {
                  'probes',
                  'probes/status',
                  'prometheusrules',
+                 'prometheusruletests',
+                 'prometheusruletests/status',
                ],
                verbs: ['*'],
              },
              {
                apiGroups: ['apps'],
-               resources: ['statefulsets'],
+               resources: ['statefulsets', 'deployments'],
                verbs: ['*'],
              },
              {
@@ -119,7 +144,7 @@ function(params) {
              {
                apiGroups: [''],
                resources: ['pods'],
-               verbs: ['list', 'delete'],
+               verbs: ['list', 'delete', 'patch'],
              },
              {
                apiGroups: [''],
@@ -127,7 +152,7 @@ function(params) {
                  'services',
                  'services/finalizers',
                ],
-               verbs: ['get', 'create', 'update', 'delete'],
+               verbs: ['get', 'list', 'watch', 'create', 'update', 'patch', 'delete'],
              },
              {
                apiGroups: [''],
@@ -162,7 +187,7 @@ function(params) {
                    resources: [
                      'endpoints',
                    ],
-                   verbs: ['get', 'create', 'update', 'delete'],
+                   verbs: ['get', 'create', 'update', 'patch', 'delete'],
                  },
                ]
              else
@@ -176,7 +201,7 @@ function(params) {
                    resources: [
                      'endpointslices',
                    ],
-                   verbs: ['get', 'create', 'list', 'update', 'delete'],
+                   verbs: ['get', 'create', 'list', 'update', 'patch', 'delete'],
                  },
                ]
              else
@@ -15,7 +15,9 @@
 package admission
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -26,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
@@ -34,7 +37,10 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
 const (
@@ -43,6 +49,7 @@ const (
 	errUnmarshalAdmission        = "Cannot unmarshal admission request"
 	errUnmarshalRules            = "Cannot unmarshal rules from spec"
 	errUnmarshalConfig           = "Cannot unmarhsal config from spec"
+	errUnmarshalObject           = "Cannot unmarshal object"
 
 	group                  = "monitoring.coreos.com"
 	prometheusRuleResource = monitoringv1.PrometheusRuleName
@@ -54,6 +61,10 @@ const (
 	prometheusRuleValidatePath     = "/admission-prometheusrules/validate"
 	prometheusRuleMutatePath       = "/admission-prometheusrules/mutate"
 	alertmanagerConfigValidatePath = "/admission-alertmanagerconfigs/validate"
+	serviceMonitorValidatePath     = "/admission-servicemonitors/validate"
+	podMonitorValidatePath         = "/admission-podmonitors/validate"
+	probeValidatePath              = "/admission-probes/validate"
+	scrapeConfigValidatePath       = "/admission-scrapeconfigs/validate"
 	convertPath                    = "/convert"
 )
 
@@ -68,35 +79,109 @@ var (
 		Group:    group,
 		Resource: alertManagerConfigResource,
 	}
+	serviceMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ServiceMonitorName,
+	}
+	podMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.PodMonitorName,
+	}
+	probeGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ProbeName,
+	}
+	scrapeConfigGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1alpha1.Version,
+		Resource: monitoringv1alpha1.ScrapeConfigName,
+	}
 )
 
 // Admission control for:
 // 1. PrometheusRules (validation, mutation) - ensuring created resources can be loaded by Promethues
 // 2. monitoringv1alpha1.AlertmanagerConfig (validation) - ensuring.
+// 3. ServiceMonitors, PodMonitors, Probes and ScrapeConfigs (validation) - ensuring created resources wouldn't be rejected by the operator.
 type Admission struct {
 	logger *slog.Logger
 	wh     http.Handler
+
+	// Optional client used to resolve the Secret and ConfigMap references.
+	kclient kubernetes.Interface
+
+	ruleLinter *promoperator.RuleLinter
+	// Optional client used to detect duplicate alert names.
+	mclient monitoringclient.Interface
+}
+
+// Option configures the admission webhook.
+type Option func(*Admission)
+
+// WithKubernetesClient configures the client used to verify that the Secret
+// and ConfigMap keys referenced by the ServiceMonitors, PodMonitors, Probes
+// and ScrapeConfigs exist. Without client, the references aren't resolved.
+func WithKubernetesClient(kclient kubernetes.Interface) Option {
+	return func(a *Admission) {
+		a.kclient = kclient
+	}
+}
+
+// WithRuleLinter configures the linter used to check the PrometheusRule
+// objects. By default, the issues are reported as warnings for the default
+// Prometheus version.
+func WithRuleLinter(rl *promoperator.RuleLinter) Option {
+	return func(a *Admission) {
+		a.ruleLinter = rl
+	}
+}
+
+// WithMonitoringClient configures the client used to detect alerting rules
+// whose name is already used by other PrometheusRule objects in the same
+// namespace. Without client, the duplicate-alert check isn't performed.
+func WithMonitoringClient(mclient monitoringclient.Interface) Option {
+	return func(a *Admission) {
+		a.mclient = mclient
+	}
 }
 
-func New(logger *slog.Logger) *Admission {
+func New(logger *slog.Logger, opts ...Option) *Admission {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(monitoringv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1beta1.AddToScheme(scheme))
 
-	return &Admission{
+	a := &Admission{
 		logger: logger,
 		wh:     conversion.NewWebhookHandler(scheme),
 	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if a.ruleLinter == nil {
+		rl, err := promoperator.NewRuleLinter(promoperator.DefaultPrometheusVersion, nil)
+		utilruntime.Must(err)
+		a.ruleLinter = rl
+	}
+
+	return a
 }
 
 func (a *Admission) Register(mux *http.ServeMux) {
 	mux.HandleFunc(prometheusRuleValidatePath, a.servePrometheusRulesValidate)
 	mux.HandleFunc(prometheusRuleMutatePath, a.servePrometheusRulesMutate)
 	mux.HandleFunc(alertmanagerConfigValidatePath, a.serveAlertmanagerConfigValidate)
+	mux.HandleFunc(serviceMonitorValidatePath, a.serveServiceMonitorsValidate)
+	mux.HandleFunc(podMonitorValidatePath, a.servePodMonitorsValidate)
+	mux.HandleFunc(probeValidatePath, a.serveProbesValidate)
+	mux.HandleFunc(scrapeConfigValidatePath, a.serveScrapeConfigsValidate)
 	mux.HandleFunc(convertPath, a.serveConvert)
 }
 
-type admitFunc func(ar v1.AdmissionReview) *v1.AdmissionResponse
+type admitFunc func(ctx context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse
 
 func (a *Admission) servePrometheusRulesMutate(w http.ResponseWriter, r *http.Request) {
 	a.serveAdmission(w, r, a.mutatePrometheusRules)
@@ -110,6 +195,22 @@ func (a *Admission) serveAlertmanagerConfigValidate(w http.ResponseWriter, r *ht
 	a.serveAdmission(w, r, a.validateAlertmanagerConfig)
 }
 
+func (a *Admission) serveServiceMonitorsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.validateServiceMonitor)
+}
+
+func (a *Admission) servePodMonitorsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.validatePodMonitor)
+}
+
+func (a *Admission) serveProbesValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.validateProbe)
+}
+
+func (a *Admission) serveScrapeConfigsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, a.validateScrapeConfig)
+}
+
 func (a *Admission) serveConvert(w http.ResponseWriter, r *http.Request) {
 	a.wh.ServeHTTP(w, r)
 }
@@ -163,7 +264,7 @@ func (a *Admission) serveAdmission(w http.ResponseWriter, r *http.Request, admit
 		a.logger.Warn("Unable to deserialize request", "err", err)
 		responseAdmissionReview.Response = toAdmissionResponseFailure("Unable to deserialize request", "", []error{err})
 	} else {
-		responseAdmissionReview.Response = admit(requestedAdmissionReview)
+		responseAdmissionReview.Response = admit(r.Context(), requestedAdmissionReview)
 	}
 
 	responseAdmissionReview.Response.UID = requestedAdmissionReview.Request.UID
@@ -184,7 +285,7 @@ func (a *Admission) serveAdmission(w http.ResponseWriter, r *http.Request, admit
 	}
 }
 
-func (a *Admission) mutatePrometheusRules(ar v1.AdmissionReview) *v1.AdmissionResponse {
+func (a *Admission) mutatePrometheusRules(_ context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
 	a.logger.Debug("Mutating prometheusrules")
 
 	if ar.Request.Resource != prometheusRuleGVR {
@@ -218,7 +319,7 @@ func (a *Admission) mutatePrometheusRules(ar v1.AdmissionReview) *v1.AdmissionRe
 	return reviewResponse
 }
 
-func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.AdmissionResponse {
+func (a *Admission) validatePrometheusRules(ctx context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
 	a.logger.Debug("Validating prometheusrules")
 
 	if ar.Request.Resource != prometheusRuleGVR {
@@ -244,10 +345,51 @@ func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.Admission
 		return toAdmissionResponseFailure("Rules are not valid", prometheusRuleResource, errors)
 	}
 
-	return &v1.AdmissionResponse{Allowed: true}
+	return a.lintPrometheusRules(ctx, ar, promRule)
 }
 
-func (a *Admission) validateAlertmanagerConfig(ar v1.AdmissionReview) *v1.AdmissionResponse {
+// lintPrometheusRules returns the lint issues as warnings or denies the
+// request if at least one of the issues is configured as such.
+func (a *Admission) lintPrometheusRules(ctx context.Context, ar v1.AdmissionReview, promRule *monitoringv1.PrometheusRule) *v1.AdmissionResponse {
+	issues := a.ruleLinter.Lint(promRule.Spec)
+
+	if a.mclient != nil && a.ruleLinter.Action(promoperator.RuleLintDuplicateAlert) != promoperator.RuleLintDisabled {
+		if promRule.Namespace == "" {
+			promRule.Namespace = ar.Request.Namespace
+		}
+
+		others, err := a.mclient.MonitoringV1().PrometheusRules(promRule.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			a.logger.Warn("failed to list prometheusrules", "namespace", promRule.Namespace, "err", err)
+		} else {
+			issues = append(issues, a.ruleLinter.LintDuplicateAlerts(promRule, others.Items)...)
+		}
+	}
+
+	var (
+		warnings []string
+		denials  []error
+	)
+	for _, issue := range issues {
+		if issue.Action == promoperator.RuleLintDeny {
+			denials = append(denials, errors.New(issue.String()))
+			continue
+		}
+
+		warnings = append(warnings, issue.String())
+	}
+
+	if len(denials) != 0 {
+		a.logger.Debug("Rules denied by linter", "prometheusrule", promRule.Name, "namespace", promRule.Namespace)
+		resp := toAdmissionResponseFailure("Rules failed lint checks", prometheusRuleResource, denials)
+		resp.Warnings = warnings
+		return resp
+	}
+
+	return &v1.AdmissionResponse{Allowed: true, Warnings: warnings}
+}
+
+func (a *Admission) validateAlertmanagerConfig(_ context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
 	a.logger.Debug("Validating alertmanagerconfigs")
 
 	gr := metav1.GroupResource{Group: ar.Request.Resource.Group, Resource: ar.Request.Resource.Resource}
@@ -291,3 +433,78 @@ func (a *Admission) validateAlertmanagerConfig(ar v1.AdmissionReview) *v1.Admiss
 	}
 	return &v1.AdmissionResponse{Allowed: true}
 }
+
+func (a *Admission) validateServiceMonitor(ctx context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
+	sm := &monitoringv1.ServiceMonitor{}
+	return a.validateScrapeResource(ar, serviceMonitorGVR, sm, func(rv *prompkg.ResourceValidator) error {
+		return rv.ValidateServiceMonitor(ctx, sm)
+	})
+}
+
+func (a *Admission) validatePodMonitor(ctx context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
+	pm := &monitoringv1.PodMonitor{}
+	return a.validateScrapeResource(ar, podMonitorGVR, pm, func(rv *prompkg.ResourceValidator) error {
+		return rv.ValidatePodMonitor(ctx, pm)
+	})
+}
+
+func (a *Admission) validateProbe(ctx context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
+	probe := &monitoringv1.Probe{}
+	return a.validateScrapeResource(ar, probeGVR, probe, func(rv *prompkg.ResourceValidator) error {
+		return rv.ValidateProbe(ctx, probe)
+	})
+}
+
+func (a *Admission) validateScrapeConfig(ctx context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
+	sc := &monitoringv1alpha1.ScrapeConfig{}
+	return a.validateScrapeResource(ar, scrapeConfigGVR, sc, func(rv *prompkg.ResourceValidator) error {
+		return rv.ValidateScrapeConfig(ctx, sc)
+	})
+}
+
+// validateScrapeResource decodes the admitted object into obj and runs the
+// validate function with the same checks as the operator when it selects
+// the object.
+func (a *Admission) validateScrapeResource(
+	ar v1.AdmissionReview,
+	gvr metav1.GroupVersionResource,
+	obj metav1.Object,
+	validate func(*prompkg.ResourceValidator) error,
+) *v1.AdmissionResponse {
+	a.logger.Debug("Validating " + gvr.Resource)
+
+	if ar.Request.Resource != gvr {
+		err := fmt.Errorf("expected resource to be %v, but received %v", gvr, ar.Request.Resource)
+		a.logger.Warn("", "err", err)
+		return toAdmissionResponseFailure("Unexpected resource kind", gvr.Resource, []error{err})
+	}
+
+	if err := json.Unmarshal(ar.Request.Object.Raw, obj); err != nil {
+		a.logger.Info(errUnmarshalObject, "err", err)
+		return toAdmissionResponseFailure(errUnmarshalObject, gvr.Resource, []error{err})
+	}
+
+	// The namespace may be omitted from the object's metadata.
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(ar.Request.Namespace)
+	}
+
+	store := assets.NewOfflineStoreBuilder()
+	if a.kclient != nil {
+		store = assets.NewStoreBuilder(a.kclient.CoreV1(), a.kclient.CoreV1())
+	}
+
+	rv, err := prompkg.NewResourceValidator(a.logger, store)
+	if err != nil {
+		return toAdmissionResponseFailure("Internal error", gvr.Resource, []error{err})
+	}
+
+	if err := validate(rv); err != nil {
+		msg := "invalid " + gvr.Resource
+		a.logger.Debug(msg, "content", string(ar.Request.Object.Raw))
+		a.logger.Info(msg, "err", err)
+		return toAdmissionResponseFailure(fmt.Sprintf("%s %q is invalid", gvr.Resource, obj.GetName()), gvr.Resource, []error{err})
+	}
+
+	return &v1.AdmissionResponse{Allowed: true}
+}
@@ -29,10 +29,19 @@ import (
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/golden"
 	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
 func TestMutateRule(t *testing.T) {
@@ -310,6 +319,306 @@ func TestAlertmanagerConfigConversion(t *testing.T) {
 	}
 }
 
+func TestScrapeResourcesAdmission(t *testing.T) {
+	kclient := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "auth",
+				Namespace: "monitoring",
+			},
+			Data: map[string][]byte{
+				"user":     []byte("user"),
+				"password": []byte("pass"),
+			},
+		},
+	)
+
+	basicAuth := func(secret string) *monitoringv1.BasicAuth {
+		return &monitoringv1.BasicAuth{
+			Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret}, Key: "user"},
+			Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret}, Key: "password"},
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		gvr      metav1.GroupVersionResource
+		kind     string
+		spec     any
+		opts     []Option
+		expected bool
+	}{
+		{
+			name: "valid ServiceMonitor",
+			gvr:  serviceMonitorGVR,
+			kind: monitoringv1.ServiceMonitorsKind,
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{Port: "web"}},
+			},
+			expected: true,
+		},
+		{
+			name: "ServiceMonitor with invalid relabeling regex",
+			gvr:  serviceMonitorGVR,
+			kind: monitoringv1.ServiceMonitorsKind,
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{
+					Port: "web",
+					RelabelConfigs: []monitoringv1.RelabelConfig{{
+						Action: "drop",
+						Regex:  "foo(",
+					}},
+				}},
+			},
+		},
+		{
+			name: "ServiceMonitor with scrape timeout greater than scrape interval",
+			gvr:  serviceMonitorGVR,
+			kind: monitoringv1.ServiceMonitorsKind,
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:          "web",
+					Interval:      "10s",
+					ScrapeTimeout: "20s",
+				}},
+			},
+		},
+		{
+			name: "ServiceMonitor with scrape class",
+			gvr:  serviceMonitorGVR,
+			kind: monitoringv1.ServiceMonitorsKind,
+			spec: monitoringv1.ServiceMonitorSpec{
+				ScrapeClassName: ptr.To("default"),
+				Endpoints:       []monitoringv1.Endpoint{{Port: "web"}},
+			},
+			expected: true,
+		},
+		{
+			name: "ServiceMonitor with missing secret and no client",
+			gvr:  serviceMonitorGVR,
+			kind: monitoringv1.ServiceMonitorsKind,
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:      "web",
+					BasicAuth: basicAuth("missing"),
+				}},
+			},
+			expected: true,
+		},
+		{
+			name: "ServiceMonitor with missing secret",
+			gvr:  serviceMonitorGVR,
+			kind: monitoringv1.ServiceMonitorsKind,
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:      "web",
+					BasicAuth: basicAuth("missing"),
+				}},
+			},
+			opts: []Option{WithKubernetesClient(kclient)},
+		},
+		{
+			name: "ServiceMonitor with existing secret",
+			gvr:  serviceMonitorGVR,
+			kind: monitoringv1.ServiceMonitorsKind,
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:      "web",
+					BasicAuth: basicAuth("auth"),
+				}},
+			},
+			opts:     []Option{WithKubernetesClient(kclient)},
+			expected: true,
+		},
+		{
+			name: "PodMonitor with invalid hashmod relabeling",
+			gvr:  podMonitorGVR,
+			kind: monitoringv1.PodMonitorsKind,
+			spec: monitoringv1.PodMonitorSpec{
+				PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{
+					MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
+						Action:      "hashmod",
+						TargetLabel: "shard",
+					}},
+				}},
+			},
+		},
+		{
+			name: "Probe with invalid prober URL",
+			gvr:  probeGVR,
+			kind: monitoringv1.ProbesKind,
+			spec: monitoringv1.ProbeSpec{
+				ProberSpec: monitoringv1.ProberSpec{URL: "http://blackbox:9115"},
+			},
+		},
+		{
+			name: "valid ScrapeConfig",
+			gvr:  scrapeConfigGVR,
+			kind: v1alpha1.ScrapeConfigsKind,
+			spec: v1alpha1.ScrapeConfigSpec{
+				StaticConfigs: []v1alpha1.StaticConfig{{
+					Targets: []v1alpha1.Target{"localhost:9090"},
+				}},
+			},
+			expected: true,
+		},
+		{
+			name: "ScrapeConfig with invalid metric relabeling regex",
+			gvr:  scrapeConfigGVR,
+			kind: v1alpha1.ScrapeConfigsKind,
+			spec: v1alpha1.ScrapeConfigSpec{
+				MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
+					Action: "keep",
+					Regex:  "(",
+				}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := New(slog.New(slog.DiscardHandler), tc.opts...)
+
+			var h http.HandlerFunc
+			switch tc.gvr {
+			case serviceMonitorGVR:
+				h = a.serveServiceMonitorsValidate
+			case podMonitorGVR:
+				h = a.servePodMonitorsValidate
+			case probeGVR:
+				h = a.serveProbesValidate
+			case scrapeConfigGVR:
+				h = a.serveScrapeConfigsValidate
+			}
+
+			ts := server(h)
+			t.Cleanup(ts.Close)
+
+			resp := sendAdmissionReview(t, ts, buildAdmissionReview(t, tc.gvr, tc.kind, tc.spec))
+			require.Equal(t, tc.expected, resp.Response.Allowed, "%v", resp.Response.Result)
+		})
+	}
+}
+
+func TestPrometheusRuleLinting(t *testing.T) {
+	mclient := monitoringfake.NewSimpleClientset(
+		&monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other",
+				Namespace: "monitoring",
+			},
+			Spec: monitoringv1.PrometheusRuleSpec{
+				Groups: []monitoringv1.RuleGroup{{
+					Name:  "group",
+					Rules: []monitoringv1.Rule{{Alert: "InstanceDown", Expr: intstr.FromString("up == 0")}},
+				}},
+			},
+		},
+	)
+
+	spec := monitoringv1.PrometheusRuleSpec{
+		Groups: []monitoringv1.RuleGroup{{
+			Name: "group",
+			Rules: []monitoringv1.Rule{{
+				Alert: "InstanceDown",
+				Expr:  intstr.FromString("up == 0"),
+			}},
+		}},
+	}
+
+	newLinter := func(actions map[string]string) *promoperator.RuleLinter {
+		rl, err := promoperator.NewRuleLinter(promoperator.DefaultPrometheusVersion, actions)
+		require.NoError(t, err)
+		return rl
+	}
+
+	for _, tc := range []struct {
+		name             string
+		opts             []Option
+		expectedAllowed  bool
+		expectedWarnings []string
+		expectedCauses   []string
+	}{
+		{
+			name:            "default",
+			expectedAllowed: true,
+			expectedWarnings: []string{
+				`group "group", rule "InstanceDown": alerting rule without 'for' duration fires on the first evaluation (missing-for)`,
+			},
+		},
+		{
+			name:            "disabled check",
+			opts:            []Option{WithRuleLinter(newLinter(map[string]string{"missing-for": "disabled"}))},
+			expectedAllowed: true,
+		},
+		{
+			name: "denied check",
+			opts: []Option{WithRuleLinter(newLinter(map[string]string{"missing-for": "deny"}))},
+			expectedCauses: []string{
+				`group "group", rule "InstanceDown": alerting rule without 'for' duration fires on the first evaluation (missing-for)`,
+			},
+		},
+		{
+			name:            "duplicate alert",
+			opts:            []Option{WithMonitoringClient(mclient)},
+			expectedAllowed: true,
+			expectedWarnings: []string{
+				`group "group", rule "InstanceDown": alerting rule without 'for' duration fires on the first evaluation (missing-for)`,
+				`group "group", rule "InstanceDown": alert name already defined by PrometheusRule "other" (duplicate-alert)`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := server(New(slog.New(slog.DiscardHandler), tc.opts...).servePrometheusRulesValidate)
+			t.Cleanup(ts.Close)
+
+			resp := sendAdmissionReview(t, ts, buildAdmissionReview(t, prometheusRuleGVR, monitoringv1.PrometheusRuleKind, spec))
+			require.Equal(t, tc.expectedAllowed, resp.Response.Allowed)
+			require.Equal(t, tc.expectedWarnings, resp.Response.Warnings)
+
+			if len(tc.expectedCauses) == 0 {
+				return
+			}
+
+			var causes []string
+			for _, c := range resp.Response.Result.Details.Causes {
+				causes = append(causes, c.Message)
+			}
+			require.Equal(t, tc.expectedCauses, causes)
+		})
+	}
+}
+
+func buildAdmissionReview(t *testing.T, gvr metav1.GroupVersionResource, kind string, spec any) []byte {
+	t.Helper()
+
+	object, err := json.Marshal(map[string]any{
+		"apiVersion": gvr.Group + "/" + gvr.Version,
+		"kind":       kind,
+		"metadata": map[string]any{
+			"name": "test",
+		},
+		"spec": spec,
+	})
+	require.NoError(t, err)
+
+	b, err := json.Marshal(v1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "admission.k8s.io/v1",
+			Kind:       "AdmissionReview",
+		},
+		Request: &v1.AdmissionRequest{
+			UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+			Kind:      metav1.GroupVersionKind{Group: gvr.Group, Version: gvr.Version, Kind: kind},
+			Resource:  gvr,
+			Namespace: "monitoring",
+			Operation: v1.Create,
+			Object:    runtime.RawExtension{Raw: object},
+		},
+	})
+	require.NoError(t, err)
+
+	return b
+}
+
 func api() *Admission {
 	a := New(
 		slog.New(slog.DiscardHandler),
@@ -17,6 +17,7 @@ package alertmanager
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -24,6 +25,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/prometheus/alertmanager/config"
@@ -231,14 +233,32 @@ type ConfigBuilder struct {
 	amVersion semver.Version
 	store     *assets.StoreBuilder
 	enforcer  enforcer
+
+	// sharedReceivers are the AlertmanagerReceiver objects which can be
+	// referenced by the AlertmanagerConfig routes, indexed by
+	// `<namespace>/<name>`.
+	sharedReceivers map[string]*monitoringv1alpha1.AlertmanagerReceiver
+	// routeOrigins maps the routes generated from AlertmanagerConfig objects
+	// to their source.
+	routeOrigins map[*route]types.NamespacedName
+	// rateLimits are the notification rate limits applying to all the
+	// AlertmanagerConfig receivers.
+	rateLimits *monitoringv1.AlertmanagerConfigRateLimits
+	// notificationFloors maps the rate-limited receivers to the minimum
+	// interval between notifications.
+	notificationFloors map[string]time.Duration
 }
 
 func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *assets.StoreBuilder, am *monitoringv1.Alertmanager) *ConfigBuilder {
 	cg := &ConfigBuilder{
-		logger:    logger,
-		amVersion: amVersion,
-		store:     store,
-		enforcer:  getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace),
+		logger:       logger,
+		amVersion:    amVersion,
+		store:        store,
+		enforcer:     getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace),
+		routeOrigins: map[*route]types.NamespacedName{},
+		rateLimits:   am.Spec.AlertmanagerConfigRateLimits,
+
+		notificationFloors: map[string]time.Duration{},
 	}
 	return cg
 }
@@ -305,6 +325,7 @@ func (cb *ConfigBuilder) initializeFromAlertmanagerConfig(ctx context.Context, g
 
 	// Add routes to globalAlertmanagerConfig.Route without enforce namespace
 	globalAlertmanagerConfig.Route = cb.convertRoute(amConfig.Spec.Route, crKey)
+	cb.routeOrigins[globalAlertmanagerConfig.Route] = crKey
 
 	for _, receiver := range amConfig.Spec.Receivers {
 		receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
@@ -312,6 +333,18 @@ func (cb *ConfigBuilder) initializeFromAlertmanagerConfig(ctx context.Context, g
 			return err
 		}
 		globalAlertmanagerConfig.Receivers = append(globalAlertmanagerConfig.Receivers, receivers)
+
+		// The platform-wide limits don't apply to the global configuration.
+		if err := cb.addReceiverRateLimit(receivers.Name, &receiver, false); err != nil {
+			return err
+		}
+	}
+
+	if globalAlertmanagerConfig.Route != nil {
+		defaults := routeIntervals{group: defaultGroupInterval, repeat: defaultRepeatInterval}
+		if err := cb.enforceRateLimits(globalAlertmanagerConfig.Route, "", defaults, defaults); err != nil {
+			return err
+		}
 	}
 
 	for _, muteTimeInterval := range amConfig.Spec.MuteTimeIntervals {
@@ -345,9 +378,34 @@ func (cb *ConfigBuilder) InitializeFromRawConfiguration(b []byte) error {
 	return nil
 }
 
+// addMuteTimeIntervals adds the time intervals of an AlertmanagerConfig object
+// to the current configuration. If exportedOnly is true, only the exported
+// time intervals are added.
+func (cb *ConfigBuilder) addMuteTimeIntervals(in []monitoringv1alpha1.MuteTimeInterval, crKey types.NamespacedName, exportedOnly bool) error {
+	for _, muteTimeInterval := range in {
+		if exportedOnly && !muteTimeInterval.Exported {
+			continue
+		}
+
+		mti, err := convertMuteTimeInterval(&muteTimeInterval, crKey)
+		if err != nil {
+			return err
+		}
+		cb.cfg.MuteTimeIntervals = append(cb.cfg.MuteTimeIntervals, mti)
+	}
+
+	return nil
+}
+
+// routingTree returns the routing tree of the current configuration.
+func (cb *ConfigBuilder) routingTree() *routingTree {
+	return newRoutingTree(cb.cfg, cb.routeOrigins)
+}
+
 // AddAlertmanagerConfigs adds AlertmanagerConfig objects to the current configuration.
 func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) error {
 	subRoutes := make([]*route, 0, len(amConfigs))
+	sharedReceiverRefs := map[string]struct{}{}
 	for _, amConfigIdentifier := range sortutil.SortedKeys(amConfigs) {
 		crKey := types.NamespacedName{
 			Name:      amConfigs[amConfigIdentifier].Name,
@@ -356,6 +414,13 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 
 		// Add inhibitRules to baseConfig.InhibitRules.
 		for _, inhibitRule := range amConfigs[amConfigIdentifier].Spec.InhibitRules {
+			// Cluster-wide inhibition rules aren't restricted to the
+			// namespace of the AlertmanagerConfig object.
+			if inhibitRule.ClusterWide {
+				cb.cfg.InhibitRules = append(cb.cfg.InhibitRules, cb.convertInhibitRule(&inhibitRule))
+				continue
+			}
+
 			cb.cfg.InhibitRules = append(cb.cfg.InhibitRules,
 				cb.enforcer.processInhibitRule(
 					crKey,
@@ -366,20 +431,28 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 			)
 		}
 
-		// Skip early if there's no route definition.
+		// Skip early if there's no route definition. The exported time
+		// intervals are kept since other objects can reference them.
 		if amConfigs[amConfigIdentifier].Spec.Route == nil {
+			if err := cb.addMuteTimeIntervals(amConfigs[amConfigIdentifier].Spec.MuteTimeIntervals, crKey, true); err != nil {
+				return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+			}
 			continue
 		}
 
-		subRoutes = append(subRoutes,
-			cb.enforcer.processRoute(
+		subRoute := cb.enforcer.processRoute(
+			crKey,
+			cb.convertRoute(
+				amConfigs[amConfigIdentifier].Spec.Route,
 				crKey,
-				cb.convertRoute(
-					amConfigs[amConfigIdentifier].Spec.Route,
-					crKey,
-				),
 			),
 		)
+		cb.routeOrigins[subRoute] = crKey
+		subRoutes = append(subRoutes, subRoute)
+
+		if err := collectSharedReceiverRefs(amConfigs[amConfigIdentifier].Spec.Route, sharedReceiverRefs); err != nil {
+			return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+		}
 
 		for _, receiver := range amConfigs[amConfigIdentifier].Spec.Receivers {
 			receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
@@ -387,14 +460,51 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 				return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
 			}
 			cb.cfg.Receivers = append(cb.cfg.Receivers, receivers)
-		}
 
-		for _, muteTimeInterval := range amConfigs[amConfigIdentifier].Spec.MuteTimeIntervals {
-			mti, err := convertMuteTimeInterval(&muteTimeInterval, crKey)
-			if err != nil {
+			if err := cb.addReceiverRateLimit(receivers.Name, &receiver, true); err != nil {
 				return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
 			}
-			cb.cfg.MuteTimeIntervals = append(cb.cfg.MuteTimeIntervals, mti)
+		}
+
+		if err := cb.addMuteTimeIntervals(amConfigs[amConfigIdentifier].Spec.MuteTimeIntervals, crKey, false); err != nil {
+			return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+		}
+	}
+
+	// The AlertmanagerReceiver objects are added only once, no matter how
+	// many routes reference them.
+	for _, ref := range sortutil.SortedKeys(sharedReceiverRefs) {
+		sr, found := cb.sharedReceivers[ref]
+		if !found {
+			return fmt.Errorf("AlertmanagerReceiver %q not found", ref)
+		}
+
+		// The secrets are read from the receiver's namespace.
+		rcv, err := cb.convertReceiver(ctx, &sr.Spec, types.NamespacedName{Namespace: sr.Namespace, Name: sr.Name})
+		if err != nil {
+			return fmt.Errorf("AlertmanagerReceiver %s: %w", ref, err)
+		}
+		rcv.Name = ref
+
+		cb.cfg.Receivers = append(cb.cfg.Receivers, rcv)
+
+		if err := cb.addReceiverRateLimit(rcv.Name, &sr.Spec, true); err != nil {
+			return fmt.Errorf("AlertmanagerReceiver %s: %w", ref, err)
+		}
+	}
+
+	// Raise the intervals of the routes sending notifications to
+	// rate-limited receivers.
+	if len(cb.notificationFloors) > 0 {
+		root, err := rootRouteIntervals(cb.cfg.Route)
+		if err != nil {
+			return fmt.Errorf("root route: %w", err)
+		}
+
+		for _, r := range subRoutes {
+			if err := cb.enforceRateLimits(r, cb.cfg.Route.Receiver, root, root); err != nil {
+				return fmt.Errorf("AlertmanagerConfig %s: %w", cb.routeOrigins[r].String(), err)
+			}
 		}
 	}
 
@@ -550,18 +660,24 @@ func (cb *ConfigBuilder) convertRoute(in *monitoringv1alpha1.Route, crKey types.
 	}
 
 	receiver := makeNamespacedString(in.Receiver, crKey)
+	if in.SharedReceiver != "" {
+		// The AlertmanagerReceiver objects keep the `<namespace>/<name>`
+		// reference as name which can't conflict with the namespaced names
+		// made of 3 segments.
+		receiver = in.SharedReceiver
+	}
 
 	var prefixedMuteTimeIntervals []string
 	if len(in.MuteTimeIntervals) > 0 {
 		for _, mti := range in.MuteTimeIntervals {
-			prefixedMuteTimeIntervals = append(prefixedMuteTimeIntervals, makeNamespacedString(mti, crKey))
+			prefixedMuteTimeIntervals = append(prefixedMuteTimeIntervals, makeTimeIntervalName(mti, crKey))
 		}
 	}
 
 	var prefixedActiveTimeIntervals []string
 	if len(in.ActiveTimeIntervals) > 0 {
 		for _, ati := range in.ActiveTimeIntervals {
-			prefixedActiveTimeIntervals = append(prefixedActiveTimeIntervals, makeNamespacedString(ati, crKey))
+			prefixedActiveTimeIntervals = append(prefixedActiveTimeIntervals, makeTimeIntervalName(ati, crKey))
 		}
 	}
 
@@ -763,6 +879,30 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		}
 	}
 
+	var jiraConfigs []*jiraConfig
+	if l := len(in.JiraConfigs); l > 0 {
+		jiraConfigs = make([]*jiraConfig, l)
+		for i := range in.JiraConfigs {
+			receiver, err := cb.convertJiraConfig(ctx, in.JiraConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("JiraConfig[%d]: %w", i, err)
+			}
+			jiraConfigs[i] = receiver
+		}
+	}
+
+	var incidentioConfigs []*incidentioConfig
+	if l := len(in.IncidentIOConfigs); l > 0 {
+		incidentioConfigs = make([]*incidentioConfig, l)
+		for i := range in.IncidentIOConfigs {
+			receiver, err := cb.convertIncidentIOConfig(ctx, in.IncidentIOConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("IncidentIOConfig[%d]: %w", i, err)
+			}
+			incidentioConfigs[i] = receiver
+		}
+	}
+
 	return &receiver{
 		Name:              makeNamespacedString(in.Name, crKey),
 		OpsgenieConfigs:   opsgenieConfigs,
@@ -780,6 +920,8 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		MSTeamsConfigs:    msTeamsConfigs,
 		MSTeamsV2Configs:  msTeamsV2Configs,
 		RocketChatConfigs: rocketchatConfigs,
+		JiraConfigs:       jiraConfigs,
+		IncidentIOConfigs: incidentioConfigs,
 	}, nil
 }
 
@@ -973,6 +1115,118 @@ func (cb *ConfigBuilder) convertSlackConfig(ctx context.Context, in monitoringv1
 	return out, nil
 }
 
+func (cb *ConfigBuilder) convertJiraConfig(ctx context.Context, in monitoringv1alpha1.JiraConfig, crKey types.NamespacedName) (*jiraConfig, error) {
+	out := &jiraConfig{
+		SendResolved: in.SendResolved,
+		Project:      in.Project,
+		IssueType:    in.IssueType,
+		Labels:       in.Labels,
+	}
+
+	if in.APIURL != nil {
+		out.APIURL = string(*in.APIURL)
+	}
+
+	if in.Summary != nil {
+		out.Summary = *in.Summary
+	}
+
+	if in.Description != nil {
+		out.Description = *in.Description
+	}
+
+	if in.Priority != nil {
+		out.Priority = *in.Priority
+	}
+
+	if in.ReopenTransition != nil {
+		out.ReopenTransition = *in.ReopenTransition
+	}
+
+	if in.ResolveTransition != nil {
+		out.ResolveTransition = *in.ResolveTransition
+	}
+
+	if in.WontFixResolution != nil {
+		out.WontFixResolution = *in.WontFixResolution
+	}
+
+	if in.ReopenDuration != nil && *in.ReopenDuration != "" {
+		reopenDuration, err := model.ParseDuration(string(*in.ReopenDuration))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reopenDuration: %w", err)
+		}
+		out.ReopenDuration = reopenDuration
+	}
+
+	if len(in.Fields) > 0 {
+		out.Fields = make(map[string]any, len(in.Fields))
+		for k, v := range in.Fields {
+			var value any
+			if err := json.Unmarshal(v.Raw, &value); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal field %q: %w", k, err)
+			}
+			out.Fields[k] = value
+		}
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertIncidentIOConfig(ctx context.Context, in monitoringv1alpha1.IncidentIOConfig, crKey types.NamespacedName) (*incidentioConfig, error) {
+	out := &incidentioConfig{
+		SendResolved: in.SendResolved,
+	}
+
+	if in.URLSecret != nil {
+		url, err := cb.getValidURLFromSecret(ctx, crKey.Namespace, *in.URLSecret)
+		if err != nil {
+			return nil, err
+		}
+		out.URL = url
+	} else if in.URL != nil {
+		url, err := validation.ValidateURL(string(*in.URL))
+		if err != nil {
+			return nil, err
+		}
+		out.URL = url.String()
+	}
+
+	if in.AlertSourceToken != nil {
+		token, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AlertSourceToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get incident.io alert source token: %w", err)
+		}
+		out.AlertSourceToken = token
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	if in.MaxAlerts > 0 {
+		out.MaxAlerts = in.MaxAlerts
+	}
+
+	if in.Timeout != nil && *in.Timeout != "" {
+		timeout, err := model.ParseDuration(string(*in.Timeout))
+		if err != nil {
+			return nil, err
+		}
+		out.Timeout = &timeout
+	}
+
+	return out, nil
+}
+
 func (cb *ConfigBuilder) convertPagerdutyConfig(ctx context.Context, in monitoringv1alpha1.PagerDutyConfig, crKey types.NamespacedName) (*pagerdutyConfig, error) {
 	out := &pagerdutyConfig{
 		VSendResolved: in.SendResolved,
@@ -1608,6 +1862,41 @@ func makeNamespacedString(in string, crKey types.NamespacedName) string {
 	return crKey.Namespace + "/" + crKey.Name + "/" + in
 }
 
+// makeTimeIntervalName returns the name of the time interval referenced by a
+// route. References to time intervals exported by other AlertmanagerConfig
+// objects are already fully qualified.
+func makeTimeIntervalName(in string, crKey types.NamespacedName) string {
+	if validation.IsTimeIntervalReference(in) {
+		return in
+	}
+	return makeNamespacedString(in, crKey)
+}
+
+// collectSharedReceiverRefs adds the references to the AlertmanagerReceiver
+// objects of the route and its children to refs.
+func collectSharedReceiverRefs(in *monitoringv1alpha1.Route, refs map[string]struct{}) error {
+	if in == nil {
+		return nil
+	}
+
+	if in.SharedReceiver != "" {
+		refs[in.SharedReceiver] = struct{}{}
+	}
+
+	children, err := in.ChildRoutes()
+	if err != nil {
+		return err
+	}
+
+	for i := range children {
+		if err := collectSharedReceiverRefs(&children[i], refs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (cb *ConfigBuilder) convertSMTPConfig(ctx context.Context, out *globalConfig, in monitoringv1.GlobalSMTPConfig, crKey types.NamespacedName) error {
 	if in.From != nil {
 		out.SMTPFrom = *in.From
@@ -2329,6 +2618,12 @@ func (r *receiver) sanitize(amVersion semver.Version, logger *slog.Logger) error
 			return err
 		}
 	}
+
+	for _, conf := range r.IncidentIOConfigs {
+		if err := conf.sanitize(amVersion, withLogger); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -2714,6 +3009,25 @@ func (rc *rocketChatConfig) sanitize(amVersion semver.Version, logger *slog.Logg
 	return rc.HTTPConfig.sanitize(amVersion, logger)
 }
 
+func (ic *incidentioConfig) sanitize(amVersion semver.Version, logger *slog.Logger) error {
+	incidentioAllowed := amVersion.GTE(semver.MustParse("0.29.0"))
+	if !incidentioAllowed {
+		return fmt.Errorf(`invalid syntax in receivers config; incidentio integration is available in Alertmanager >= 0.29.0`)
+	}
+
+	if ic.URL == "" && ic.URLFile == "" {
+		return errors.New("one of url or url_file must be configured")
+	}
+	if ic.URL != "" && ic.URLFile != "" {
+		return errors.New("at most one of url & url_file must be configured")
+	}
+	if ic.AlertSourceToken != "" && ic.AlertSourceTokenFile != "" {
+		return errors.New("at most one of alert_source_token & alert_source_token_file must be configured")
+	}
+
+	return ic.HTTPConfig.sanitize(amVersion, logger)
+}
+
 func (ir *inhibitRule) sanitize(amVersion semver.Version, logger *slog.Logger) error {
 	matchersV2Allowed := amVersion.GTE(semver.MustParse("0.22.0"))
 
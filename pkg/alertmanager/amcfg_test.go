@@ -1955,6 +1955,8 @@ func TestGenerateConfig(t *testing.T) {
 		amVersion       *semver.Version
 		matcherStrategy monitoringv1.AlertmanagerConfigMatcherStrategy
 		amConfigs       map[string]*monitoringv1alpha1.AlertmanagerConfig
+		sharedRcvrs     map[string]*monitoringv1alpha1.AlertmanagerReceiver
+		rateLimits      *monitoringv1.AlertmanagerConfigRateLimits
 		golden          string
 		expectedError   bool
 	}
@@ -3741,6 +3743,317 @@ func TestGenerateConfig(t *testing.T) {
 			},
 			golden: "CR_with_WebhookConfig_with_Timeout_Setup_Older_Version.golden",
 		},
+		{
+			name:      "CR with Jira Receiver",
+			amVersion: &version28,
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "jira",
+						Namespace: "mynamespace",
+					},
+					Data: map[string][]byte{
+						"password": []byte("secret-token"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								JiraConfigs: []monitoringv1alpha1.JiraConfig{
+									{
+										APIURL:            ptr.To(monitoringv1alpha1.URL("https://example.atlassian.net/rest/api/2")),
+										Project:           "OPS",
+										IssueType:         "Bug",
+										Summary:           ptr.To(`{{ template "jira.default.summary" . }}`),
+										Labels:            []string{"alertmanager"},
+										Priority:          ptr.To("High"),
+										ResolveTransition: ptr.To("Done"),
+										ReopenDuration:    ptr.To(monitoringv1.Duration("1h")),
+										Fields: map[string]apiextensionsv1.JSON{
+											"customfield_10001": {Raw: []byte(`{"value":"production"}`)},
+										},
+										HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+											BasicAuth: &monitoringv1.BasicAuth{
+												Username: corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{Name: "jira"},
+													Key:                  "password",
+												},
+												Password: corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{Name: "jira"},
+													Key:                  "password",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_Jira_Receiver.golden",
+		},
+		{
+			name:      "CR with Jira Receiver Older Version",
+			amVersion: &version26,
+			kclient:   fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route: &route{
+					Receiver: "null",
+				},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "test",
+								JiraConfigs: []monitoringv1alpha1.JiraConfig{
+									{
+										Project:   "OPS",
+										IssueType: "Bug",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "CR with shared receiver referenced by several routes",
+			kclient: fake.NewSimpleClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oncall",
+						Namespace: "platform",
+					},
+					Data: map[string][]byte{
+						"url": []byte("http://oncall.example.com/"),
+					},
+				},
+			),
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"ns1/amc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amc",
+						Namespace: "ns1",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							SharedReceiver: "platform/oncall",
+						},
+					},
+				},
+				"ns2/amc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amc",
+						Namespace: "ns2",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver: "test",
+							Routes: []apiextensionsv1.JSON{
+								{Raw: []byte(`{"sharedReceiver":"platform/oncall","matchers":[{"name":"severity","value":"critical"}]}`)},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			sharedRcvrs: map[string]*monitoringv1alpha1.AlertmanagerReceiver{
+				"platform/oncall": {
+					ObjectMeta: metav1.ObjectMeta{Name: "oncall", Namespace: "platform"},
+					Spec: monitoringv1alpha1.Receiver{
+						Name: "oncall",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{
+								URLSecret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "oncall"},
+									Key:                  "url",
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_shared_receiver_referenced_by_several_routes.golden",
+		},
+		{
+			name:      "CR with cluster-wide inhibit rule and exported time interval",
+			kclient:   fake.NewSimpleClientset(),
+			amVersion: &version28,
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"platform/shared": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shared",
+						Namespace: "platform",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						InhibitRules: []monitoringv1alpha1.InhibitRule{
+							{
+								SourceMatch: []monitoringv1alpha1.Matcher{
+									{Name: "alertname", Value: "ClusterDown", MatchType: monitoringv1alpha1.MatchEqual},
+								},
+								ClusterWide: true,
+							},
+						},
+						MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+							{
+								Name: "business-hours",
+								TimeIntervals: []monitoringv1alpha1.TimeInterval{
+									{
+										Times: []monitoringv1alpha1.TimeRange{
+											{StartTime: "09:00", EndTime: "17:00"},
+										},
+									},
+								},
+								Exported: true,
+							},
+						},
+					},
+				},
+				"team-a/amc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amc",
+						Namespace: "team-a",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						InhibitRules: []monitoringv1alpha1.InhibitRule{
+							{
+								SourceMatch: []monitoringv1alpha1.Matcher{
+									{Name: "severity", Value: "critical", MatchType: monitoringv1alpha1.MatchEqual},
+								},
+							},
+						},
+						Route: &monitoringv1alpha1.Route{
+							Receiver:            "test",
+							ActiveTimeIntervals: []string{"platform/shared/business-hours"},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{{Name: "test"}},
+					},
+				},
+			},
+			golden: "CR_with_cluster-wide_inhibit_rule_and_exported_time_interval.golden",
+		},
+		{
+			name:    "CR with rate-limited receivers",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null", GroupInterval: "1m"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			rateLimits: &monitoringv1.AlertmanagerConfigRateLimits{
+				Integrations: []monitoringv1.IntegrationRateLimit{
+					{
+						Integration: "webhook",
+						NotificationRateLimit: monitoringv1.NotificationRateLimit{
+							MaxNotifications: 12,
+							Interval:         "1h",
+						},
+					},
+				},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"mynamespace/myamc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "myamc",
+						Namespace: "mynamespace",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							Receiver:       "webhook",
+							RepeatInterval: "10h",
+							Routes: []apiextensionsv1.JSON{
+								{Raw: []byte(`{"receiver":"team-a","matchers":[{"name":"team","value":"a"}]}`)},
+								{Raw: []byte(`{"receiver":"team-b","groupInterval":"30m","matchers":[{"name":"team","value":"b"}]}`)},
+							},
+						},
+						Receivers: []monitoringv1alpha1.Receiver{
+							{
+								Name: "webhook",
+								WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+									{URL: ptr.To("http://test.example.com/")},
+								},
+							},
+							{
+								Name: "team-a",
+								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+									{To: "team-a@example.com", From: "am@example.com", Smarthost: "smtp.example.com:25"},
+								},
+								RateLimit: &monitoringv1.NotificationRateLimit{
+									MaxNotifications: 1,
+									Interval:         "15m",
+								},
+							},
+							{
+								Name: "team-b",
+								EmailConfigs: []monitoringv1alpha1.EmailConfig{
+									{To: "team-b@example.com", From: "am@example.com", Smarthost: "smtp.example.com:25"},
+								},
+							},
+						},
+					},
+				},
+			},
+			golden: "CR_with_rate-limited_receivers.golden",
+		},
+		{
+			name:    "CR with missing shared receiver",
+			kclient: fake.NewSimpleClientset(),
+			baseConfig: alertmanagerConfig{
+				Route:     &route{Receiver: "null"},
+				Receivers: []*receiver{{Name: "null"}},
+			},
+			amConfigs: map[string]*monitoringv1alpha1.AlertmanagerConfig{
+				"ns1/amc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amc",
+						Namespace: "ns1",
+					},
+					Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+						Route: &monitoringv1alpha1.Route{
+							SharedReceiver: "platform/oncall",
+						},
+					},
+				},
+			},
+			expectedError: true,
+		},
 	}
 
 	logger := newNopLogger(t)
@@ -3757,10 +4070,14 @@ func TestGenerateConfig(t *testing.T) {
 			cb := NewConfigBuilder(logger, *tc.amVersion, store,
 				&monitoringv1.Alertmanager{
 					ObjectMeta: metav1.ObjectMeta{Namespace: "alertmanager-namespace"},
-					Spec:       monitoringv1.AlertmanagerSpec{AlertmanagerConfigMatcherStrategy: tc.matcherStrategy},
+					Spec: monitoringv1.AlertmanagerSpec{
+						AlertmanagerConfigMatcherStrategy: tc.matcherStrategy,
+						AlertmanagerConfigRateLimits:      tc.rateLimits,
+					},
 				},
 			)
 			cb.cfg = &tc.baseConfig
+			cb.sharedReceivers = tc.sharedRcvrs
 
 			if tc.expectedError {
 				require.Error(t, cb.AddAlertmanagerConfigs(context.Background(), tc.amConfigs))
@@ -5840,6 +6157,122 @@ func TestSanitizeRocketChatConfig(t *testing.T) {
 	}
 }
 
+func TestSanitizeIncidentIOConfig(t *testing.T) {
+	logger := newNopLogger(t)
+	versionIncidentIOAllowed := semver.Version{Major: 0, Minor: 29}
+	versionIncidentIONotAllowed := semver.Version{Major: 0, Minor: 28}
+	for _, tc := range []struct {
+		name           string
+		againstVersion semver.Version
+		in             *alertmanagerConfig
+		golden         string
+		expectErr      bool
+	}{
+		{
+			name:           "incidentio_configs returns error for unsupported versions",
+			againstVersion: versionIncidentIONotAllowed,
+			in: &alertmanagerConfig{
+				Receivers: []*receiver{
+					{
+						IncidentIOConfigs: []*incidentioConfig{
+							{
+								URL: "https://api.incident.io/v2/alert_events/http/ID",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:           "incidentio_configs allows for supported versions",
+			againstVersion: versionIncidentIOAllowed,
+			in: &alertmanagerConfig{
+				Receivers: []*receiver{
+					{
+						IncidentIOConfigs: []*incidentioConfig{
+							{
+								URL:              "https://api.incident.io/v2/alert_events/http/ID",
+								AlertSourceToken: "token",
+								SendResolved:     ptr.To(true),
+								MaxAlerts:        10,
+								Timeout:          ptr.To(model.Duration(10 * time.Second)),
+							},
+						},
+					},
+				},
+			},
+			golden: "incidentio_configs_for_supported_versions.golden",
+		},
+		{
+			name:           "incidentio_configs without url or url_file",
+			againstVersion: versionIncidentIOAllowed,
+			in: &alertmanagerConfig{
+				Receivers: []*receiver{
+					{
+						IncidentIOConfigs: []*incidentioConfig{
+							{
+								AlertSourceToken: "token",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:           "incidentio_configs both url and url_file set",
+			againstVersion: versionIncidentIOAllowed,
+			in: &alertmanagerConfig{
+				Receivers: []*receiver{
+					{
+						IncidentIOConfigs: []*incidentioConfig{
+							{
+								URL:     "https://api.incident.io/v2/alert_events/http/ID",
+								URLFile: "/var/kubernetes/secrets/url",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:           "incidentio_configs both alert_source_token and alert_source_token_file set",
+			againstVersion: versionIncidentIOAllowed,
+			in: &alertmanagerConfig{
+				Receivers: []*receiver{
+					{
+						IncidentIOConfigs: []*incidentioConfig{
+							{
+								URL:                  "https://api.incident.io/v2/alert_events/http/ID",
+								AlertSourceToken:     "token",
+								AlertSourceTokenFile: "/var/kubernetes/secrets/token",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.in.sanitize(tc.againstVersion, logger)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			amConfigs, err := yaml.Marshal(tc.in)
+			require.NoError(t, err)
+
+			golden.Assert(t, string(amConfigs), tc.golden)
+		})
+	}
+}
+
 func TestSanitizeRoute(t *testing.T) {
 	logger := newNopLogger(t)
 	matcherV2SyntaxAllowed := semver.Version{Major: 0, Minor: 22}
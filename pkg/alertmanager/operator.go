@@ -21,7 +21,10 @@ import (
 	"fmt"
 	"log/slog"
 	"maps"
+	"net"
+	"net/http"
 	"path"
+	"slices"
 	"strings"
 	"time"
 
@@ -29,11 +32,14 @@ import (
 	"github.com/mitchellh/hashstructure"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/otel/attribute"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	authv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/client-go/metadata"
@@ -53,6 +59,7 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/listwatch"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
 )
 
@@ -82,6 +89,7 @@ type Operator struct {
 	kclient    kubernetes.Interface
 	mdClient   metadata.Interface
 	mclient    monitoringclient.Interface
+	dclient    dynamic.Interface
 	ssarClient authv1.SelfSubjectAccessReviewInterface
 
 	controllerID string
@@ -94,6 +102,8 @@ type Operator struct {
 
 	alrtInfs    *informers.ForResource
 	alrtCfgInfs *informers.ForResource
+	rcvrInfs    *informers.ForResource
+	tmplInfs    *informers.ForResource
 	cmapInfs    *informers.ForResource
 	secrInfs    *informers.ForResource
 	ssetInfs    *informers.ForResource
@@ -110,6 +120,21 @@ type Operator struct {
 	config Config
 
 	configResourcesStatusEnabled bool
+	finalizerSyncer              *operator.FinalizerSyncer
+
+	sharedReceiversEnabled bool
+	templatesEnabled       bool
+
+	silenceClient SilenceClient
+	silences      *silenceController
+
+	routingTrees *routingTracker
+
+	reloadStatusClient ReloadStatusClient
+	configReloads      *reloadTracker
+	now                func() time.Time
+
+	peerResolver PeerResolver
 }
 
 type ControllerOption func(*Operator)
@@ -122,6 +147,14 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithSharedReceivers tells that the controller should watch the
+// AlertmanagerReceiver objects.
+func WithSharedReceivers() ControllerOption {
+	return func(o *Operator) {
+		o.sharedReceiversEnabled = true
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -141,6 +174,14 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
 	}
 
+	dclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+	}
+
+	// All the metrics exposed by the silence controller get the controller="alertmanagersilence" label.
+	silenceReg := prometheus.WrapRegistererWith(prometheus.Labels{"controller": "alertmanagersilence"}, r)
+
 	// All the metrics exposed by the controller get the controller="alertmanager" label.
 	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "alertmanager"}, r)
 
@@ -148,6 +189,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		kclient:    client,
 		mdClient:   mdClient,
 		mclient:    mclient,
+		dclient:    dclient,
 		ssarClient: client.AuthorizationV1().SelfSubjectAccessReviews(),
 
 		logger:   logger,
@@ -156,6 +198,12 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		metrics:          operator.NewMetrics(r),
 		reconciliations:  &operator.ReconciliationTracker{},
 		newEventRecorder: c.EventRecorderFactory(client, controllerName),
+		routingTrees:     newRoutingTracker(),
+
+		reloadStatusClient: NewHTTPReloadStatusClient(),
+		configReloads:      newReloadTracker(),
+		now:                time.Now,
+		peerResolver:       net.DefaultResolver,
 
 		controllerID: c.ControllerID,
 
@@ -168,11 +216,19 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			Labels:                       c.Labels,
 		},
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
+		finalizerSyncer:              operator.NewFinalizerSyncer(mdClient, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName), c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature)),
 	}
 	for _, opt := range options {
 		opt(o)
 	}
 
+	// The status of the AlertmanagerTemplate resources is always updated,
+	// hence the finalizer removing the bindings when the Alertmanager is
+	// deleted.
+	if o.templatesEnabled {
+		o.finalizerSyncer = operator.NewFinalizerSyncer(mdClient, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName), true)
+	}
+
 	if err := o.bootstrap(ctx, c); err != nil {
 		return nil, err
 	}
@@ -187,6 +243,13 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		o.controllerID,
 	)
 
+	if o.silenceClient != nil {
+		o.silences, err = newSilenceController(o, c, silenceReg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return o, nil
 }
 
@@ -238,6 +301,38 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		)
 	}
 
+	if c.sharedReceiversEnabled {
+		c.rcvrInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				config.Namespaces.AlertmanagerConfigAllowList,
+				config.Namespaces.DenyList,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerReceiverName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating alertmanagerreceiver informers: %w", err)
+		}
+	}
+
+	if c.templatesEnabled {
+		c.tmplInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				config.Namespaces.AlertmanagerConfigAllowList,
+				config.Namespaces.DenyList,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerTemplateName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating alertmanagertemplate informers: %w", err)
+		}
+	}
+
 	c.secrInfs, err = informers.NewInformersForResourceWithTransform(
 		informers.NewMetadataInformerFactory(
 			allowList,
@@ -338,16 +433,25 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 
 // waitForCacheSync waits for the informers' caches to be synced.
 func (c *Operator) waitForCacheSync(ctx context.Context) error {
-	for _, infs := range []struct {
+	type infsWithName struct {
 		name                 string
 		informersForResource *informers.ForResource
-	}{
+	}
+	infs := []infsWithName{
 		{"Alertmanager", c.alrtInfs},
 		{"AlertmanagerConfig", c.alrtCfgInfs},
 		{"Secret", c.secrInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"StatefulSet", c.ssetInfs},
-	} {
+	}
+	if c.rcvrInfs != nil {
+		infs = append(infs, infsWithName{"AlertmanagerReceiver", c.rcvrInfs})
+	}
+	if c.tmplInfs != nil {
+		infs = append(infs, infsWithName{"AlertmanagerTemplate", c.tmplInfs})
+	}
+
+	for _, infs := range infs {
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", infs.name), inf.Informer()) {
 				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
@@ -391,6 +495,38 @@ func (c *Operator) addHandlers() {
 		),
 	))
 
+	if c.rcvrInfs != nil {
+		c.rcvrInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.AlertmanagerReceiversKind,
+			c.enqueueForSharedReceivers,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
+	if c.tmplInfs != nil {
+		c.tmplInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.AlertmanagerTemplatesKind,
+			c.enqueueForTemplates,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
 	hasRefFunc := operator.HasReferenceFunc(
 		c.alrtInfs,
 		c.reconciliations,
@@ -474,6 +610,28 @@ func (c *Operator) enqueueForNamespace(nsName string) {
 	}
 }
 
+// enqueueForSharedReceivers enqueues all Alertmanager objects allowing the
+// AlertmanagerReceiver objects of the given namespace.
+func (c *Operator) enqueueForSharedReceivers(nsName string) {
+	err := c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if slices.Contains(am.Spec.AlertmanagerReceiverNamespaces, nsName) {
+			c.rr.EnqueueForReconciliation(am)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// Register registers the HTTP handlers of the controller.
+func (c *Operator) Register(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/alertmanagers/{namespace}/{name}/routes/test", c.routingTrees)
+}
+
 // Run the controller.
 func (c *Operator) Run(ctx context.Context) error {
 	go c.rr.Run(ctx)
@@ -481,6 +639,12 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	go c.alrtInfs.Start(ctx.Done())
 	go c.alrtCfgInfs.Start(ctx.Done())
+	if c.rcvrInfs != nil {
+		go c.rcvrInfs.Start(ctx.Done())
+	}
+	if c.tmplInfs != nil {
+		go c.tmplInfs.Start(ctx.Done())
+	}
 	go c.secrInfs.Start(ctx.Done())
 	go c.cmapInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
@@ -503,6 +667,14 @@ func (c *Operator) Run(ctx context.Context) error {
 	// TODO(simonpasquier): watch for Alertmanager pods instead of polling.
 	go operator.StatusPoller(ctx, c)
 
+	if c.silences != nil {
+		go func() {
+			if err := c.silences.run(ctx); err != nil {
+				c.logger.Error("failed to run the AlertmanagerSilence controller", "err", err)
+			}
+		}()
+	}
+
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
 	return nil
@@ -543,23 +715,30 @@ func (c *Operator) handleNamespaceUpdate(oldo, curo any) {
 	c.logger.Debug("Namespace updated", "namespace", cur.GetName())
 	c.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
 
-	// Check for Alertmanager instances selecting AlertmanagerConfigs in the namespace.
+	// Check for Alertmanager instances selecting AlertmanagerConfigs or
+	// AlertmanagerTemplates in the namespace.
 	err := c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
 		a := obj.(*monitoringv1.Alertmanager)
 
-		sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, a.Spec.AlertmanagerConfigNamespaceSelector)
-		if err != nil {
-			c.logger.Error(
-				"failed to detect label selection change",
-				"err", err,
-				"name", a.Name,
-				"namespace", a.Namespace,
-			)
-			return
-		}
+		for _, selector := range []*metav1.LabelSelector{
+			a.Spec.AlertmanagerConfigNamespaceSelector,
+			a.Spec.TemplateNamespaceSelector,
+		} {
+			sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, selector)
+			if err != nil {
+				c.logger.Error(
+					"failed to detect label selection change",
+					"err", err,
+					"name", a.Name,
+					"namespace", a.Namespace,
+				)
+				return
+			}
 
-		if sync {
-			c.rr.EnqueueForReconciliation(a)
+			if sync {
+				c.rr.EnqueueForReconciliation(a)
+				return
+			}
 		}
 	})
 	if err != nil {
@@ -587,12 +766,31 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if am == nil {
 		c.reconciliations.ForgetObject(key)
+		c.routingTrees.Delete(key)
+		c.configReloads.Delete(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
 
+	statusCleanup := func() error {
+		return c.configResStatusCleanup(ctx, am)
+	}
+
+	finalizerAdded, err := c.finalizerSyncer.Sync(ctx, am, c.rr.DeletionInProgress(am), statusCleanup)
+	if err != nil {
+		return err
+	}
+
+	if finalizerAdded {
+		// Since the object has been updated, let's trigger another sync.
+		c.rr.EnqueueForReconciliation(am)
+		return nil
+	}
+
 	// Check if the Alertmanager instance is marked for deletion.
 	if c.rr.DeletionInProgress(am) {
+		c.routingTrees.Delete(key)
+		c.configReloads.Delete(key)
 		return nil
 	}
 
@@ -600,6 +798,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
+	operator.SetSpanObject(ctx, am)
 	logger := c.logger.With("key", key)
 	logDeprecatedFields(logger, am)
 
@@ -611,12 +810,28 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
 
+	// When the generated configuration has been rolled back, the other
+	// resources are still reconciled and the error is returned at the end.
+	var rollbackErr error
 	if err := c.provisionAlertmanagerConfiguration(ctx, am, assetStore); err != nil {
-		return fmt.Errorf("provision alertmanager configuration: %w", err)
+		var cre *configRollbackError
+		if !errors.As(err, &cre) {
+			return fmt.Errorf("provision alertmanager configuration: %w", err)
+		}
+		rollbackErr = err
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
 
-	tlsShardedSecret, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, c.newTLSAssetSecret(am))
+	// The reload status of a configuration which hasn't been confirmed yet
+	// is checked even if nothing else changes (the pods remain ready when
+	// the configuration fails to load).
+	if at, found := c.configReloads.NextCheck(key); found {
+		c.rr.EnqueueForStatusAfter(am, at.Sub(c.now()))
+	}
+
+	spanCtx, span := operator.StartSpan(ctx, "apply-tls-assets")
+	tlsShardedSecret, err := operator.ReconcileShardedSecret(spanCtx, assetStore.TLSAssets(), c.kclient, c.newTLSAssetSecret(am))
+	operator.EndSpan(span, err)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
@@ -657,7 +872,19 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 
 	if c.rr.DeletionInProgress(existingStatefulSet) {
-		return nil
+		return rollbackErr
+	}
+
+	if len(am.Spec.PeerDiscovery) > 0 {
+		peers, err := c.discoverPeers(ctx, am)
+		if err != nil {
+			return fmt.Errorf("failed to discover peers: %w", err)
+		}
+
+		// The discovered peers are added to the statefulset as if they were
+		// defined in the additionalPeers field.
+		am = am.DeepCopy()
+		am.Spec.AdditionalPeers = append(am.Spec.AdditionalPeers, peers...)
 	}
 
 	newSSetInputHash, err := createSSetInputHash(*am, c.config, tlsShardedSecret, existingStatefulSet.Spec)
@@ -673,20 +900,25 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
 		logger.Debug("new statefulset generation inputs match current, skipping any actions")
-		return nil
+		return rollbackErr
 	}
 
 	ssetClient := c.kclient.AppsV1().StatefulSets(am.Namespace)
 	if shouldCreate {
 		logger.Debug("no current statefulset found")
 		logger.Debug("creating statefulset")
-		if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
+		spanCtx, span := operator.StartSpan(ctx, "create-statefulset", attribute.String("statefulset", sset.Name))
+		_, err := ssetClient.Create(spanCtx, sset, metav1.CreateOptions{})
+		operator.EndSpan(span, err)
+		if err != nil {
 			return fmt.Errorf("creating statefulset failed: %w", err)
 		}
-		return nil
+		return rollbackErr
 	}
 
-	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
+	spanCtx, span = operator.StartSpan(ctx, "update-statefulset", attribute.String("statefulset", sset.Name))
+	err = k8sutil.UpdateStatefulSet(spanCtx, ssetClient, sset)
+	operator.EndSpan(span, err)
 	sErr, ok := err.(*apierrors.StatusError)
 
 	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
@@ -703,14 +935,14 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
 			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
 		}
-		return nil
+		return rollbackErr
 	}
 
 	if err != nil {
 		return fmt.Errorf("updating StatefulSet failed: %w", err)
 	}
 
-	return nil
+	return rollbackErr
 }
 
 // getStatefulSetFromAlertmanagerKey returns a copy of the StatefulSet object
@@ -762,6 +994,8 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 		return fmt.Errorf("failed to retrieve statefulset state: %w", err)
 	}
 
+	reloadErr := c.checkConfigReload(ctx, key, a, stsReporter)
+
 	selectorLabels := makeSelectorLabels(a.Name)
 	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: selectorLabels})
 	if err != nil {
@@ -771,6 +1005,12 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 	a.Status.Selector = selector.String()
 	availableCondition := stsReporter.Update(a)
 	reconciledCondition := c.reconciliations.GetCondition(key, a.Generation)
+	if reloadErr != nil && reconciledCondition.Status == monitoringv1.ConditionTrue {
+		// The operator can't detect that the generated configuration
+		// failed to load.
+		reconciledCondition.Reason = "ReloadStatusUnknown"
+		reconciledCondition.Message = reloadErr.Error()
+	}
 	a.Status.Conditions = operator.UpdateConditions(a.Status.Conditions, availableCondition, reconciledCondition)
 	a.Status.Paused = a.Spec.Paused
 
@@ -810,6 +1050,7 @@ func createSSetInputHash(a monitoringv1.Alertmanager, c Config, tlsAssets *opera
 		AlertmanagerAnnotations map[string]string
 		AlertmanagerGeneration  int64
 		AlertmanagerWebHTTP2    *bool
+		AdditionalPeers         []string
 		Config                  Config
 		StatefulSetSpec         appsv1.StatefulSetSpec
 		ShardedSecret           *operator.ShardedSecret
@@ -818,6 +1059,7 @@ func createSSetInputHash(a monitoringv1.Alertmanager, c Config, tlsAssets *opera
 		AlertmanagerAnnotations: a.Annotations,
 		AlertmanagerGeneration:  a.Generation,
 		AlertmanagerWebHTTP2:    http2,
+		AdditionalPeers:         a.Spec.AdditionalPeers,
 		Config:                  c,
 		StatefulSetSpec:         s,
 		ShardedSecret:           tlsAssets,
@@ -896,23 +1138,47 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
 		}
 
-		err = c.createOrUpdateGeneratedConfigSecret(ctx, am, amRawConfiguration, additionalData)
+		err = c.createOrUpdateGeneratedConfigSecret(ctx, am, amRawConfiguration, additionalData, nil)
 		if err != nil {
 			return fmt.Errorf("create or update generated config secret failed: %w", err)
 		}
 
-		return nil
+		if key, ok := c.accessor.MetaNamespaceKey(am); ok {
+			// The routing tree is best-effort for user-managed configurations.
+			if cfg, err := alertmanagerConfigFromBytes(amRawConfiguration); err == nil {
+				c.routingTrees.Set(key, newRoutingTree(cfg, nil))
+			} else {
+				c.routingTrees.Delete(key)
+			}
+		}
+
+		return c.configResStatusCleanup(ctx, am)
+	}
+
+	spanCtx, span := operator.StartSpan(ctx, "select-resources")
+	sharedReceivers, err := c.selectSharedReceivers(spanCtx, am, version, store)
+	if err != nil {
+		operator.EndSpan(span, err)
+		return fmt.Errorf("failed to select AlertmanagerReceiver objects: %w", err)
 	}
 
-	amConfigs, err := c.selectAlertmanagerConfigs(ctx, am, version, store)
+	amConfigs, err := c.selectAlertmanagerConfigs(spanCtx, am, version, store, sharedReceivers)
 	if err != nil {
+		operator.EndSpan(span, err)
 		return fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
 	}
 
+	templates, err := c.selectTemplates(am)
+	operator.EndSpan(span, err)
+	if err != nil {
+		return fmt.Errorf("failed to select AlertmanagerTemplate objects: %w", err)
+	}
+
 	var (
 		additionalData map[string][]byte
 		cfgBuilder     = NewConfigBuilder(namespacedLogger, version, store, am)
 	)
+	cfgBuilder.sharedReceivers = sharedReceivers
 
 	if am.Spec.AlertmanagerConfiguration != nil {
 		// Load the base configuration from the referenced AlertmanagerConfig.
@@ -953,24 +1219,91 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		}
 	}
 
-	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
-		return fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+	if len(templates.ValidResources()) > 0 {
+		data := make(map[string][]byte, len(additionalData)+len(templates))
+		maps.Copy(data, additionalData)
+		addTemplates(cfgBuilder, data, templates.ValidResources())
+		additionalData = data
 	}
 
-	generatedConfig, err := cfgBuilder.MarshalJSON()
+	spanCtx, span = operator.StartSpan(ctx, "generate-config")
+	generatedConfig, err := c.generateConfiguration(spanCtx, cfgBuilder, amConfigs.ValidResources())
+	operator.EndSpan(span, err)
 	if err != nil {
-		return fmt.Errorf("failed to marshal configuration: %w", err)
+		return err
 	}
 
-	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, generatedConfig, additionalData)
-	if err != nil {
-		return fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
+	// The rollback error is reported once the status of the configuration
+	// resources has been updated.
+	rollbackErr := c.applyGeneratedConfig(ctx, am, generatedConfig, additionalData, amConfigs.ValidResources())
+	var cre *configRollbackError
+	if rollbackErr != nil && !errors.As(rollbackErr, &cre) {
+		return fmt.Errorf("failed to create or update the generated configuration secret: %w", rollbackErr)
+	}
+
+	if key, ok := c.accessor.MetaNamespaceKey(am); ok {
+		c.routingTrees.Set(key, cfgBuilder.routingTree())
+	}
+
+	if err := c.updateConfigResourcesStatus(ctx, am, amConfigs); err != nil {
+		return err
+	}
+
+	if err := c.updateTemplatesStatus(ctx, am, templates); err != nil {
+		return err
+	}
+
+	return rollbackErr
+}
+
+// updateConfigResourcesStatus updates the status of the selected
+// AlertmanagerConfig resources.
+func (c *Operator) updateConfigResourcesStatus(ctx context.Context, am *monitoringv1.Alertmanager, amConfigs prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig]) error {
+	if !c.configResourcesStatusEnabled {
+		return nil
+	}
+
+	var configResourceSyncer = prompkg.NewConfigResourceSyncer(am, c.dclient, c.accessor)
+
+	for key, configResource := range amConfigs {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update AlertmanagerConfig %s status: %w", key, err)
+		}
+	}
+
+	// Remove bindings from alertmanagerConfigs which reference the
+	// workload but aren't selected anymore.
+	if err := prompkg.CleanupBindings(ctx, c.alrtCfgInfs.ListAll, amConfigs, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for alertmanagerConfigs: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte) error {
+// configResStatusCleanup removes the alertmanager bindings from all the
+// AlertmanagerConfig and AlertmanagerTemplate resources.
+func (c *Operator) configResStatusCleanup(ctx context.Context, am *monitoringv1.Alertmanager) error {
+	if err := c.updateConfigResourcesStatus(ctx, am, prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig]{}); err != nil {
+		return err
+	}
+
+	return c.updateTemplatesStatus(ctx, am, prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerTemplate]{})
+}
+
+func (c *Operator) generateConfiguration(ctx context.Context, cfgBuilder *ConfigBuilder, amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) ([]byte, error) {
+	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
+		return nil, fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+	}
+
+	generatedConfig, err := cfgBuilder.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	return generatedConfig, nil
+}
+
+func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte, annotations map[string]string) error {
 	generatedConfigSecret := &v1.Secret{
 		Data: map[string][]byte{},
 	}
@@ -979,6 +1312,7 @@ func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *
 		generatedConfigSecret,
 		operator.WithLabels(c.config.Labels),
 		operator.WithAnnotations(c.config.Annotations),
+		operator.WithAnnotations(annotations),
 		operator.WithManagingOwner(am),
 		operator.WithName(generatedConfigSecretName(am.Name)),
 	)
@@ -992,7 +1326,9 @@ func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *
 	generatedConfigSecret.Data[alertmanagerConfigFileCompressed] = buf.Bytes()
 
 	sClient := c.kclient.CoreV1().Secrets(am.Namespace)
+	ctx, span := operator.StartSpan(ctx, "apply-config-secret")
 	err := k8sutil.CreateOrUpdateSecret(ctx, sClient, generatedConfigSecret)
+	operator.EndSpan(span, err)
 	if err != nil {
 		return fmt.Errorf("failed to update generated config secret: %w", err)
 	}
@@ -1000,7 +1336,7 @@ func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *
 	return nil
 }
 
-func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
+func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder, sharedReceivers map[string]*monitoringv1alpha1.AlertmanagerReceiver) (prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], error) {
 	namespaces := []string{}
 
 	// If 'AlertmanagerConfigNamespaceSelector' is nil, only check own namespace.
@@ -1045,6 +1381,12 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 				return
 			}
 
+			amConfig = amConfig.DeepCopy()
+			if err := k8sutil.AddTypeInformationToObject(amConfig); err != nil {
+				c.logger.Error("failed to set type information", "alertmanagerconfig", k, "err", err)
+				return
+			}
+
 			amConfigs[k] = amConfig
 		})
 		if err != nil {
@@ -1053,11 +1395,48 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 	}
 
 	var rejected int
-	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(amConfigs))
+	res := make(prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], len(amConfigs))
+
+	errs := make(map[string]error, len(amConfigs))
+	for namespaceAndName, amc := range amConfigs {
+		err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store)
+		if err == nil {
+			err = checkSharedReceiverReferences(amc.Spec.Route, sharedReceivers)
+		}
+		if err == nil {
+			err = checkAlertmanagerConfigSharing(amc, am.Spec.AlertmanagerConfigSharingNamespaces)
+		}
+		errs[namespaceAndName] = err
+	}
+
+	// The references to exported time intervals can only be verified once
+	// the valid objects are known. Rejecting an object removes its exported
+	// time intervals hence the loop.
+	for {
+		exported := exportedTimeIntervals(amConfigs, errs)
+
+		var changed bool
+		for namespaceAndName, amc := range amConfigs {
+			if errs[namespaceAndName] != nil {
+				continue
+			}
+
+			if err := checkTimeIntervalReferences(amc.Spec.Route, exported); err != nil {
+				errs[namespaceAndName] = err
+				changed = true
+			}
+		}
+
+		if !changed {
+			break
+		}
+	}
 
 	eventRecorder := c.newEventRecorder(am)
 	for namespaceAndName, amc := range amConfigs {
-		if err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store); err != nil {
+		err := errs[namespaceAndName]
+		res[namespaceAndName] = prompkg.NewTypedConfigurationResource(amc, err)
+		if err != nil {
 			rejected++
 			c.logger.Warn(
 				"skipping alertmanagerconfig",
@@ -1067,26 +1446,184 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 				"alertmanager", am.Name,
 			)
 			eventRecorder.Eventf(amc, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingAlertmanagerConfigResourcesAction, "AlertmanagerConfig %s was rejected due to invalid configuration: %v", amc.GetName(), err)
-			continue
 		}
-
-		res[namespaceAndName] = amc
 	}
 
 	amcKeys := []string{}
-	for k := range res {
+	for k := range res.ValidResources() {
 		amcKeys = append(amcKeys, k)
 	}
 	c.logger.Debug("selected AlertmanagerConfigs", "alertmanagerconfigs", strings.Join(amcKeys, ","), "namespace", am.Namespace, "prometheus", am.Name)
 
 	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
-		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, len(res))
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, len(res)-rejected)
 		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, rejected)
 	}
 
 	return res, nil
 }
 
+// checkAlertmanagerConfigSharing verifies that the AlertmanagerConfig object
+// declares cluster-wide inhibition rules and exported time intervals only if
+// its namespace is allowed to.
+func checkAlertmanagerConfigSharing(amc *monitoringv1alpha1.AlertmanagerConfig, allowedNamespaces []string) error {
+	if slices.Contains(allowedNamespaces, amc.Namespace) {
+		return nil
+	}
+
+	for i, ir := range amc.Spec.InhibitRules {
+		if ir.ClusterWide {
+			return fmt.Errorf("inhibitRules[%d]: cluster-wide inhibition rules aren't allowed for namespace %q", i, amc.Namespace)
+		}
+	}
+
+	for i, mti := range amc.Spec.MuteTimeIntervals {
+		if mti.Exported {
+			return fmt.Errorf("muteTimeIntervals[%d]: exported time intervals aren't allowed for namespace %q", i, amc.Namespace)
+		}
+	}
+
+	return nil
+}
+
+// exportedTimeIntervals returns the names of the time intervals exported by
+// the valid AlertmanagerConfig objects.
+func exportedTimeIntervals(amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig, errs map[string]error) map[string]struct{} {
+	exported := map[string]struct{}{}
+	for namespaceAndName, amc := range amConfigs {
+		if errs[namespaceAndName] != nil {
+			continue
+		}
+
+		crKey := types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name}
+		for _, mti := range amc.Spec.MuteTimeIntervals {
+			if mti.Exported {
+				exported[makeNamespacedString(mti.Name, crKey)] = struct{}{}
+			}
+		}
+	}
+
+	return exported
+}
+
+// checkTimeIntervalReferences verifies that the time intervals from other
+// AlertmanagerConfig objects referenced by the route and its children are
+// exported.
+func checkTimeIntervalReferences(route *monitoringv1alpha1.Route, exported map[string]struct{}) error {
+	if route == nil {
+		return nil
+	}
+
+	for _, ti := range slices.Concat(route.MuteTimeIntervals, route.ActiveTimeIntervals) {
+		if !validation.IsTimeIntervalReference(ti) {
+			continue
+		}
+
+		if _, found := exported[ti]; !found {
+			return fmt.Errorf("time interval %q not found or not exported", ti)
+		}
+	}
+
+	childRoutes, err := route.ChildRoutes()
+	if err != nil {
+		return err
+	}
+
+	for i := range childRoutes {
+		if err := checkTimeIntervalReferences(&childRoutes[i], exported); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// selectSharedReceivers returns the valid AlertmanagerReceiver objects from
+// the namespaces allowed by the Alertmanager, indexed by `<namespace>/<name>`.
+func (c *Operator) selectSharedReceivers(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder) (map[string]*monitoringv1alpha1.AlertmanagerReceiver, error) {
+	if c.rcvrInfs == nil || len(am.Spec.AlertmanagerReceiverNamespaces) == 0 {
+		return nil, nil
+	}
+
+	var receivers []*monitoringv1alpha1.AlertmanagerReceiver
+	for _, ns := range am.Spec.AlertmanagerReceiverNamespaces {
+		err := c.rcvrInfs.ListAllByNamespace(ns, labels.Everything(), func(obj any) {
+			receivers = append(receivers, obj.(*monitoringv1alpha1.AlertmanagerReceiver).DeepCopy())
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list AlertmanagerReceiver objects in namespace %s: %w", ns, err)
+		}
+	}
+
+	var (
+		rejected int
+		res      = make(map[string]*monitoringv1alpha1.AlertmanagerReceiver, len(receivers))
+	)
+	eventRecorder := c.newEventRecorder(am)
+	for _, r := range receivers {
+		if err := checkSharedReceiverResource(ctx, r, amVersion, store); err != nil {
+			rejected++
+			c.logger.Warn(
+				"skipping alertmanagerreceiver",
+				"error", err.Error(),
+				"alertmanagerreceiver", r.Name,
+				"namespace", r.Namespace,
+				"alertmanager", am.Name,
+			)
+			eventRecorder.Eventf(r, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingAlertmanagerConfigResourcesAction, "AlertmanagerReceiver %s was rejected due to invalid configuration: %v", r.Name, err)
+			continue
+		}
+
+		res[r.Namespace+"/"+r.Name] = r
+	}
+
+	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerReceiversKind, len(res))
+		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.AlertmanagerReceiversKind, rejected)
+	}
+
+	return res, nil
+}
+
+// checkSharedReceiverResource verifies that an AlertmanagerReceiver object is
+// valid for the given Alertmanager version and that the referenced secrets
+// exist in its namespace.
+func checkSharedReceiverResource(ctx context.Context, r *monitoringv1alpha1.AlertmanagerReceiver, amVersion semver.Version, store *assets.StoreBuilder) error {
+	if err := validationv1alpha1.ValidateAlertmanagerReceiver(r); err != nil {
+		return err
+	}
+
+	return checkReceiver(ctx, r.Spec, r.Namespace, store, amVersion)
+}
+
+// checkSharedReceiverReferences verifies that the AlertmanagerReceiver
+// objects referenced by the route and its children exist in the namespaces
+// allowed by the Alertmanager.
+func checkSharedReceiverReferences(route *monitoringv1alpha1.Route, sharedReceivers map[string]*monitoringv1alpha1.AlertmanagerReceiver) error {
+	if route == nil {
+		return nil
+	}
+
+	if route.SharedReceiver != "" {
+		if _, found := sharedReceivers[route.SharedReceiver]; !found {
+			return fmt.Errorf("AlertmanagerReceiver %q not found or its namespace isn't allowed by the Alertmanager", route.SharedReceiver)
+		}
+	}
+
+	childRoutes, err := route.ChildRoutes()
+	if err != nil {
+		return err
+	}
+
+	for i := range childRoutes {
+		if err := checkSharedReceiverReferences(&childRoutes[i], sharedReceivers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // checkAlertmanagerConfigResource verifies that an AlertmanagerConfig object is valid
 // for the given Alertmanager version and has no missing references to other objects.
 func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version, store *assets.StoreBuilder) error {
@@ -1169,80 +1706,100 @@ func checkHTTPConfig(hc *monitoringv1alpha1.HTTPConfig, amVersion semver.Version
 
 func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, store *assets.StoreBuilder, amVersion semver.Version) error {
 	for _, receiver := range amc.Spec.Receivers {
-		err := checkPagerDutyConfigs(ctx, receiver.PagerDutyConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
+		if err := checkReceiver(ctx, receiver, amc.GetNamespace(), store, amVersion); err != nil {
 			return err
 		}
+	}
 
-		err = checkOpsGenieConfigs(ctx, receiver.OpsGenieConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	return nil
+}
 
-		err = checkDiscordConfigs(ctx, receiver.DiscordConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+// checkReceiver verifies that the receiver is valid for the given
+// Alertmanager version and that the referenced secrets exist in the namespace.
+func checkReceiver(ctx context.Context, receiver monitoringv1alpha1.Receiver, namespace string, store *assets.StoreBuilder, amVersion semver.Version) error {
+	err := checkPagerDutyConfigs(ctx, receiver.PagerDutyConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkSlackConfigs(ctx, receiver.SlackConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkOpsGenieConfigs(ctx, receiver.OpsGenieConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkWebhookConfigs(ctx, receiver.WebhookConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkDiscordConfigs(ctx, receiver.DiscordConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkWechatConfigs(ctx, receiver.WeChatConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkSlackConfigs(ctx, receiver.SlackConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkWebexConfigs(ctx, receiver.WebexConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkWebhookConfigs(ctx, receiver.WebhookConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkEmailConfigs(ctx, receiver.EmailConfigs, amc.GetNamespace(), store)
-		if err != nil {
-			return err
-		}
+	err = checkWechatConfigs(ctx, receiver.WeChatConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkVictorOpsConfigs(ctx, receiver.VictorOpsConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkWebexConfigs(ctx, receiver.WebexConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkPushoverConfigs(ctx, receiver.PushoverConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkEmailConfigs(ctx, receiver.EmailConfigs, namespace, store)
+	if err != nil {
+		return err
+	}
 
-		err = checkSnsConfigs(ctx, receiver.SNSConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkVictorOpsConfigs(ctx, receiver.VictorOpsConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkTelegramConfigs(ctx, receiver.TelegramConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkPushoverConfigs(ctx, receiver.PushoverConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkMSTeamsConfigs(ctx, receiver.MSTeamsConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkSnsConfigs(ctx, receiver.SNSConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkMSTeamsV2Configs(ctx, receiver.MSTeamsV2Configs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkTelegramConfigs(ctx, receiver.TelegramConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
 
-		err = checkRocketChatConfigs(ctx, receiver.RocketChatConfigs, amc.GetNamespace(), store, amVersion)
-		if err != nil {
-			return err
-		}
+	err = checkMSTeamsConfigs(ctx, receiver.MSTeamsConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
+
+	err = checkMSTeamsV2Configs(ctx, receiver.MSTeamsV2Configs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
+
+	err = checkRocketChatConfigs(ctx, receiver.RocketChatConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
+
+	err = checkJiraConfigs(ctx, receiver.JiraConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
+	}
+
+	err = checkIncidentIOConfigs(ctx, receiver.IncidentIOConfigs, namespace, store, amVersion)
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -1404,6 +1961,90 @@ func checkRocketChatConfigs(
 	return nil
 }
 
+func checkJiraConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.JiraConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return fmt.Errorf(`'jiraConfigs' is available in Alertmanager >= 0.28.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+
+		if config.APIURL != nil {
+			if _, err := validation.ValidateURL(strings.TrimSpace(string(*config.APIURL))); err != nil {
+				return fmt.Errorf("failed to validate Jira API URL: %w", err)
+			}
+		}
+
+		if config.ReopenDuration != nil && *config.ReopenDuration != "" {
+			if _, err := model.ParseDuration(string(*config.ReopenDuration)); err != nil {
+				return fmt.Errorf("failed to parse Jira reopen duration: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkIncidentIOConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.IncidentIOConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.29.0")) {
+		return fmt.Errorf(`'incidentioConfigs' is available in Alertmanager >= 0.29.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+
+		if config.URLSecret != nil {
+			url, err := store.GetSecretKey(ctx, namespace, *config.URLSecret)
+			if err != nil {
+				return err
+			}
+			if err := validation.ValidateSecretURL(strings.TrimSpace(url)); err != nil {
+				return fmt.Errorf("failed to validate incident.io URL: %w", err)
+			}
+		}
+
+		if config.AlertSourceToken != nil {
+			if _, err := store.GetSecretKey(ctx, namespace, *config.AlertSourceToken); err != nil {
+				return fmt.Errorf("failed to retrieve incident.io alert source token: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func checkSlackConfigs(
 	ctx context.Context,
 	configs []monitoringv1alpha1.SlackConfig,
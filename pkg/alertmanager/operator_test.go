@@ -16,9 +16,11 @@ package alertmanager
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/prometheus/client_golang/prometheus"
@@ -288,6 +290,53 @@ func TestCheckAlertmanagerConfig(t *testing.T) {
 			},
 			ok: true,
 		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid-jira-receiver",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						JiraConfigs: []monitoringv1alpha1.JiraConfig{{
+							APIURL:    ptr.To(monitoringv1alpha1.URL("https://example.atlassian.net")),
+							Project:   "OPS",
+							IssueType: "Bug",
+						}},
+					}},
+				},
+			},
+			ok: true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "incidentio-receiver-unsupported-version",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						IncidentIOConfigs: []monitoringv1alpha1.IncidentIOConfig{{
+							URLSecret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+						}},
+					}},
+				},
+			},
+			ok: false,
+		},
 		{
 			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1374,6 +1423,9 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 				logger:           slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})),
 				metrics:          operator.NewMetrics(prometheus.NewRegistry()),
 				newEventRecorder: func(related runtime.Object) *operator.EventRecorder { return operator.NewFakeRecorder(1, related) },
+				routingTrees:     newRoutingTracker(),
+				configReloads:    newReloadTracker(),
+				now:              time.Now,
 			}
 
 			err := o.bootstrap(
@@ -1415,6 +1467,271 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 	}
 }
 
+func TestSelectAlertmanagerConfigs(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	newAlertmanagerConfig := func(name, receiver string) *monitoringv1alpha1.AlertmanagerConfig {
+		return &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "test",
+				Generation: 2,
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route:     &monitoringv1alpha1.Route{Receiver: receiver},
+				Receivers: []monitoringv1alpha1.Receiver{{Name: "recv"}},
+			},
+		}
+	}
+
+	c := fake.NewSimpleClientset()
+	o := &Operator{
+		kclient:          c,
+		mclient:          monitoringfake.NewSimpleClientset(newAlertmanagerConfig("valid", "recv"), newAlertmanagerConfig("invalid", "missing")),
+		ssarClient:       &alwaysAllowed{},
+		logger:           slog.New(slog.DiscardHandler),
+		metrics:          operator.NewMetrics(prometheus.NewRegistry()),
+		newEventRecorder: func(related runtime.Object) *operator.EventRecorder { return operator.NewFakeRecorder(2, related) },
+	}
+
+	err := o.bootstrap(
+		context.Background(),
+		operator.Config{
+			Namespaces: operator.Namespaces{
+				AlertmanagerConfigAllowList: map[string]struct{}{
+					v1.NamespaceAll: {},
+				},
+				AlertmanagerAllowList: map[string]struct{}{
+					v1.NamespaceAll: {},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	o.alrtCfgInfs.Start(ctx.Done())
+	require.Eventually(t, o.alrtCfgInfs.HasSynced, time.Minute, 10*time.Millisecond)
+
+	store := assets.NewStoreBuilder(c.CoreV1(), c.CoreV1())
+	amConfigs, err := o.selectAlertmanagerConfigs(context.Background(), am, semver.MustParse("0.28.0"), store, nil)
+	require.NoError(t, err)
+	require.Len(t, amConfigs, 2)
+
+	valid := amConfigs.ValidResources()
+	require.Len(t, valid, 1)
+	require.Contains(t, valid, "test/valid")
+
+	for k, tc := range map[string]struct {
+		status monitoringv1.ConditionStatus
+		reason string
+	}{
+		"test/valid":   {status: monitoringv1.ConditionTrue},
+		"test/invalid": {status: monitoringv1.ConditionFalse, reason: "InvalidConfiguration"},
+	} {
+		res := amConfigs[k]
+		require.Equal(t, monitoringv1alpha1.AlertmanagerConfigKind, res.Resource().Kind)
+
+		conditions := res.Conditions()
+		require.Len(t, conditions, 1)
+		require.Equal(t, monitoringv1.Accepted, conditions[0].Type)
+		require.Equal(t, tc.status, conditions[0].Status)
+		require.Equal(t, tc.reason, conditions[0].Reason)
+		require.Equal(t, int64(2), conditions[0].ObservedGeneration)
+	}
+}
+
+func TestCheckSharedReceiverReferences(t *testing.T) {
+	sharedReceivers := map[string]*monitoringv1alpha1.AlertmanagerReceiver{
+		"platform/oncall": {
+			ObjectMeta: metav1.ObjectMeta{Name: "oncall", Namespace: "platform"},
+			Spec:       monitoringv1alpha1.Receiver{Name: "oncall"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		route *monitoringv1alpha1.Route
+		ok    bool
+	}{
+		{
+			name: "nil route",
+			ok:   true,
+		},
+		{
+			name:  "allowed shared receiver",
+			route: &monitoringv1alpha1.Route{SharedReceiver: "platform/oncall"},
+			ok:    true,
+		},
+		{
+			name:  "missing shared receiver",
+			route: &monitoringv1alpha1.Route{SharedReceiver: "platform/other"},
+		},
+		{
+			name:  "shared receiver from a namespace not allowed",
+			route: &monitoringv1alpha1.Route{SharedReceiver: "team-a/oncall"},
+		},
+		{
+			name: "missing shared receiver in child route",
+			route: &monitoringv1alpha1.Route{
+				Receiver: "foo",
+				Routes: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"sharedReceiver":"platform/oncall"}`)},
+					{Raw: []byte(`{"sharedReceiver":"platform/other"}`)},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSharedReceiverReferences(tc.route, sharedReceivers)
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCheckAlertmanagerConfigSharing(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		namespace string
+		spec      monitoringv1alpha1.AlertmanagerConfigSpec
+		ok        bool
+	}{
+		{
+			name:      "no sharing",
+			namespace: "team-a",
+			spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				InhibitRules:      []monitoringv1alpha1.InhibitRule{{}},
+				MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{{Name: "weekend"}},
+			},
+			ok: true,
+		},
+		{
+			name:      "cluster-wide inhibit rule from allowed namespace",
+			namespace: "platform",
+			spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				InhibitRules: []monitoringv1alpha1.InhibitRule{{ClusterWide: true}},
+			},
+			ok: true,
+		},
+		{
+			name:      "exported time interval from allowed namespace",
+			namespace: "platform",
+			spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{{Name: "weekend", Exported: true}},
+			},
+			ok: true,
+		},
+		{
+			name:      "cluster-wide inhibit rule from other namespace",
+			namespace: "team-a",
+			spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				InhibitRules: []monitoringv1alpha1.InhibitRule{{}, {ClusterWide: true}},
+			},
+		},
+		{
+			name:      "exported time interval from other namespace",
+			namespace: "team-a",
+			spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{{Name: "weekend", Exported: true}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			amc := &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "amc", Namespace: tc.namespace},
+				Spec:       tc.spec,
+			}
+
+			err := checkAlertmanagerConfigSharing(amc, []string{"platform"})
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCheckTimeIntervalReferences(t *testing.T) {
+	amConfigs := map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"platform/shared": {
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "platform"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+					{Name: "business-hours", Exported: true},
+					{Name: "private"},
+				},
+			},
+		},
+		"platform/rejected": {
+			ObjectMeta: metav1.ObjectMeta{Name: "rejected", Namespace: "platform"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				MuteTimeIntervals: []monitoringv1alpha1.MuteTimeInterval{
+					{Name: "weekend", Exported: true},
+				},
+			},
+		},
+	}
+	exported := exportedTimeIntervals(amConfigs, map[string]error{"platform/rejected": errors.New("invalid")})
+	require.Equal(t, map[string]struct{}{"platform/shared/business-hours": {}}, exported)
+
+	for _, tc := range []struct {
+		name  string
+		route *monitoringv1alpha1.Route
+		ok    bool
+	}{
+		{
+			name:  "local time interval",
+			route: &monitoringv1alpha1.Route{Receiver: "foo", MuteTimeIntervals: []string{"local"}},
+			ok:    true,
+		},
+		{
+			name:  "exported time interval",
+			route: &monitoringv1alpha1.Route{Receiver: "foo", ActiveTimeIntervals: []string{"platform/shared/business-hours"}},
+			ok:    true,
+		},
+		{
+			name:  "time interval not exported",
+			route: &monitoringv1alpha1.Route{Receiver: "foo", MuteTimeIntervals: []string{"platform/shared/private"}},
+		},
+		{
+			name:  "time interval from rejected resource",
+			route: &monitoringv1alpha1.Route{Receiver: "foo", MuteTimeIntervals: []string{"platform/rejected/weekend"}},
+		},
+		{
+			name: "missing time interval in child route",
+			route: &monitoringv1alpha1.Route{
+				Receiver: "foo",
+				Routes: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"muteTimeIntervals":["platform/shared/missing"]}`)},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkTimeIntervalReferences(tc.route, exported)
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
 // alwaysAllowed implements SelfSubjectAccessReviewInterface.
 type alwaysAllowed struct{}
 
@@ -0,0 +1,114 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// defaultPeerServicePort is the name of the cluster port in the governing
+// Service of the Alertmanager pods.
+const defaultPeerServicePort = "tcp-mesh"
+
+// PeerResolver resolves the DNS SRV records used for peer discovery.
+// *net.Resolver implements this interface.
+type PeerResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// discoverPeers returns the sorted list of peer addresses discovered from
+// the peer discovery configuration of the Alertmanager object.
+func (c *Operator) discoverPeers(ctx context.Context, am *monitoringv1.Alertmanager) ([]string, error) {
+	var peers []string
+	for i, pd := range am.Spec.PeerDiscovery {
+		var (
+			discovered []string
+			err        error
+		)
+
+		switch {
+		case pd.Service != nil:
+			discovered, err = c.discoverServicePeers(ctx, am.Namespace, pd.Service)
+		case pd.DNSSRV != nil:
+			discovered, err = c.discoverDNSSRVPeers(ctx, *pd.DNSSRV)
+		default:
+			err = fmt.Errorf("either service or dnsSRV must be defined")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("peerDiscovery[%d]: %w", i, err)
+		}
+
+		peers = append(peers, discovered...)
+	}
+
+	slices.Sort(peers)
+	return slices.Compact(peers), nil
+}
+
+// discoverServicePeers returns the DNS name of the Service with the port of
+// the peers.
+//
+// Alertmanager resolves the DNS name to the addresses of all the peers and
+// resolves it again at runtime. Contrary to the addresses of the endpoints,
+// the name doesn't change when peers are restarted or rescheduled which would
+// otherwise roll out the Alertmanager pods.
+func (c *Operator) discoverServicePeers(ctx context.Context, namespace string, svc *monitoringv1.AlertmanagerPeerService) ([]string, error) {
+	namespace = ptr.Deref(svc.Namespace, namespace)
+	portName := ptr.Deref(svc.Port, defaultPeerServicePort)
+
+	s, err := c.kclient.CoreV1().Services(namespace).Get(ctx, svc.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s/%s: %w", namespace, svc.Name, err)
+	}
+
+	idx := slices.IndexFunc(s.Spec.Ports, func(p v1.ServicePort) bool { return p.Name == portName })
+	if idx < 0 {
+		return nil, fmt.Errorf("service %s/%s has no port named %q", namespace, svc.Name, portName)
+	}
+
+	host := fmt.Sprintf("%s.%s.svc", svc.Name, namespace)
+	if c.config.ClusterDomain != "" {
+		host = fmt.Sprintf("%s.%s.", host, c.config.ClusterDomain)
+	}
+
+	return []string{net.JoinHostPort(host, strconv.Itoa(int(s.Spec.Ports[idx].Port)))}, nil
+}
+
+// discoverDNSSRVPeers returns the targets of the DNS SRV record.
+func (c *Operator) discoverDNSSRVPeers(ctx context.Context, name string) ([]string, error) {
+	_, records, err := c.peerResolver.LookupSRV(ctx, "", "", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve DNS SRV record %q: %w", name, err)
+	}
+
+	peers := make([]string, 0, len(records))
+	for _, r := range records {
+		peers = append(peers, net.JoinHostPort(strings.TrimSuffix(r.Target, "."), strconv.Itoa(int(r.Port))))
+	}
+
+	return peers, nil
+}
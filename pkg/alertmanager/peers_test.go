@@ -0,0 +1,174 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+type fakePeerResolver map[string][]*net.SRV
+
+func (r fakePeerResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	records, found := r[name]
+	if !found {
+		return "", nil, errors.New("no such host")
+	}
+
+	return name, records, nil
+}
+
+func TestDiscoverPeers(t *testing.T) {
+	newService := func(ns, name, port string) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+			},
+			Spec: v1.ServiceSpec{
+				ClusterIP: v1.ClusterIPNone,
+				Ports: []v1.ServicePort{
+					{Name: port, Port: 9094},
+				},
+			},
+		}
+	}
+
+	o := &Operator{
+		kclient: fake.NewSimpleClientset(
+			newService("test", "peers", "tcp-mesh"),
+			newService("other", "remote", "cluster"),
+		),
+		peerResolver: fakePeerResolver{
+			"_tcp-mesh._tcp.alertmanager.example.com": {
+				{Target: "am-1.example.com.", Port: 9094},
+				{Target: "am-0.example.com.", Port: 9094},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		peerDiscovery []monitoringv1.AlertmanagerPeerDiscovery
+		expected      []string
+		err           bool
+	}{
+		{
+			name: "service in the same namespace",
+			peerDiscovery: []monitoringv1.AlertmanagerPeerDiscovery{
+				{Service: &monitoringv1.AlertmanagerPeerService{Name: "peers"}},
+			},
+			expected: []string{"peers.test.svc:9094"},
+		},
+		{
+			name: "service in another namespace with custom port",
+			peerDiscovery: []monitoringv1.AlertmanagerPeerDiscovery{
+				{Service: &monitoringv1.AlertmanagerPeerService{Name: "remote", Namespace: ptr.To("other"), Port: ptr.To("cluster")}},
+			},
+			expected: []string{"remote.other.svc:9094"},
+		},
+		{
+			name: "port not found",
+			peerDiscovery: []monitoringv1.AlertmanagerPeerDiscovery{
+				{Service: &monitoringv1.AlertmanagerPeerService{Name: "remote", Namespace: ptr.To("other")}},
+			},
+			err: true,
+		},
+		{
+			name: "service not found",
+			peerDiscovery: []monitoringv1.AlertmanagerPeerDiscovery{
+				{Service: &monitoringv1.AlertmanagerPeerService{Name: "unknown"}},
+			},
+			err: true,
+		},
+		{
+			name: "dns srv",
+			peerDiscovery: []monitoringv1.AlertmanagerPeerDiscovery{
+				{DNSSRV: ptr.To("_tcp-mesh._tcp.alertmanager.example.com")},
+			},
+			expected: []string{"am-0.example.com:9094", "am-1.example.com:9094"},
+		},
+		{
+			name: "multiple sources with duplicates",
+			peerDiscovery: []monitoringv1.AlertmanagerPeerDiscovery{
+				{DNSSRV: ptr.To("_tcp-mesh._tcp.alertmanager.example.com")},
+				{Service: &monitoringv1.AlertmanagerPeerService{Name: "peers"}},
+				{Service: &monitoringv1.AlertmanagerPeerService{Name: "peers", Namespace: ptr.To("test")}},
+			},
+			expected: []string{"am-0.example.com:9094", "am-1.example.com:9094", "peers.test.svc:9094"},
+		},
+		{
+			name: "dns srv resolution failure",
+			peerDiscovery: []monitoringv1.AlertmanagerPeerDiscovery{
+				{DNSSRV: ptr.To("_tcp-mesh._tcp.unknown.example.com")},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.AlertmanagerSpec{
+					PeerDiscovery: tc.peerDiscovery,
+				},
+			}
+
+			peers, err := o.discoverPeers(context.Background(), am)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, peers)
+		})
+	}
+}
+
+func TestDiscoverServicePeersWithClusterDomain(t *testing.T) {
+	o := &Operator{
+		kclient: fake.NewSimpleClientset(
+			&v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "peers",
+					Namespace: "test",
+				},
+				Spec: v1.ServiceSpec{
+					Ports: []v1.ServicePort{
+						{Name: "tcp-mesh", Port: 9094},
+					},
+				},
+			},
+		),
+		config: Config{ClusterDomain: "cluster.local"},
+	}
+
+	peers, err := o.discoverServicePeers(context.Background(), "test", &monitoringv1.AlertmanagerPeerService{Name: "peers"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"peers.test.svc.cluster.local.:9094"}, peers)
+}
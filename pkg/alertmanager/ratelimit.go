@@ -0,0 +1,197 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const (
+	// Default values of the root route in Alertmanager.
+	defaultGroupInterval  = 5 * time.Minute
+	defaultRepeatInterval = 4 * time.Hour
+)
+
+// receiverIntegrations returns whether the receiver has at least one
+// configuration for each integration name supported by
+// IntegrationRateLimit.
+func receiverIntegrations(in *monitoringv1alpha1.Receiver) map[string]bool {
+	return map[string]bool{
+		"discord":    len(in.DiscordConfigs) > 0,
+		"email":      len(in.EmailConfigs) > 0,
+		"incidentio": len(in.IncidentIOConfigs) > 0,
+		"jira":       len(in.JiraConfigs) > 0,
+		"msteams":    len(in.MSTeamsConfigs) > 0,
+		"msteamsv2":  len(in.MSTeamsV2Configs) > 0,
+		"opsgenie":   len(in.OpsGenieConfigs) > 0,
+		"pagerduty":  len(in.PagerDutyConfigs) > 0,
+		"pushover":   len(in.PushoverConfigs) > 0,
+		"rocketchat": len(in.RocketChatConfigs) > 0,
+		"slack":      len(in.SlackConfigs) > 0,
+		"sns":        len(in.SNSConfigs) > 0,
+		"telegram":   len(in.TelegramConfigs) > 0,
+		"victorops":  len(in.VictorOpsConfigs) > 0,
+		"webex":      len(in.WebexConfigs) > 0,
+		"webhook":    len(in.WebhookConfigs) > 0,
+		"wechat":     len(in.WeChatConfigs) > 0,
+	}
+}
+
+// minNotificationInterval returns the minimum interval between 2
+// notifications which satisfies the rate limit.
+func minNotificationInterval(rl *monitoringv1.NotificationRateLimit) (time.Duration, error) {
+	if rl == nil {
+		return 0, nil
+	}
+
+	if rl.MaxNotifications <= 0 {
+		return 0, fmt.Errorf("maxNotifications must be greater than 0")
+	}
+
+	interval, err := model.ParseDuration(string(rl.Interval))
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval: %w", err)
+	}
+
+	// Round up to the millisecond which is the smallest unit supported by
+	// the Alertmanager durations.
+	return (time.Duration(interval)/time.Duration(rl.MaxNotifications) + time.Millisecond - 1).Truncate(time.Millisecond), nil
+}
+
+// addReceiverRateLimit records the minimum notification interval of the
+// generated receiver named name. If withPlatformLimits is true, the limits
+// defined in the Alertmanager resource apply too and the strictest limit
+// wins.
+func (cb *ConfigBuilder) addReceiverRateLimit(name string, in *monitoringv1alpha1.Receiver, withPlatformLimits bool) error {
+	limits := []*monitoringv1.NotificationRateLimit{in.RateLimit}
+
+	if withPlatformLimits && cb.rateLimits != nil {
+		limits = append(limits, cb.rateLimits.Receiver)
+
+		integrations := receiverIntegrations(in)
+		for i := range cb.rateLimits.Integrations {
+			if integrations[cb.rateLimits.Integrations[i].Integration] {
+				limits = append(limits, &cb.rateLimits.Integrations[i].NotificationRateLimit)
+			}
+		}
+	}
+
+	var floor time.Duration
+	for _, rl := range limits {
+		d, err := minNotificationInterval(rl)
+		if err != nil {
+			return fmt.Errorf("receiver %q: rate limit: %w", in.Name, err)
+		}
+
+		floor = max(floor, d)
+	}
+
+	if floor > 0 {
+		cb.notificationFloors[name] = floor
+	}
+
+	return nil
+}
+
+// routeIntervals holds the effective group and repeat intervals of a route.
+type routeIntervals struct {
+	group  time.Duration
+	repeat time.Duration
+}
+
+// rootRouteIntervals returns the effective intervals of the root route.
+func rootRouteIntervals(r *route) (routeIntervals, error) {
+	return resolveRouteIntervals(r, routeIntervals{group: defaultGroupInterval, repeat: defaultRepeatInterval})
+}
+
+// resolveRouteIntervals returns the effective intervals of the route given
+// the intervals inherited from its parent.
+func resolveRouteIntervals(r *route, parent routeIntervals) (routeIntervals, error) {
+	ri := parent
+
+	if r.GroupInterval != "" {
+		d, err := model.ParseDuration(r.GroupInterval)
+		if err != nil {
+			return ri, fmt.Errorf("invalid group interval %q: %w", r.GroupInterval, err)
+		}
+		ri.group = time.Duration(d)
+	}
+
+	if r.RepeatInterval != "" {
+		d, err := model.ParseDuration(r.RepeatInterval)
+		if err != nil {
+			return ri, fmt.Errorf("invalid repeat interval %q: %w", r.RepeatInterval, err)
+		}
+		ri.repeat = time.Duration(d)
+	}
+
+	return ri, nil
+}
+
+// enforceRateLimits raises the group and repeat intervals of the route and
+// its children so that the notification rate of their receivers doesn't
+// exceed the recorded limits.
+//
+// original holds the intervals inherited from the parent route as defined by
+// the user while enforced holds the intervals inherited from the parent
+// route after enforcement. When a parent route has been modified, the
+// children which inherited the user-defined values get explicit values to
+// keep their own behavior (unless they're also rate-limited).
+func (cb *ConfigBuilder) enforceRateLimits(r *route, receiver string, original, enforced routeIntervals) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.Receiver != "" {
+		receiver = r.Receiver
+	}
+
+	own, err := resolveRouteIntervals(r, original)
+	if err != nil {
+		return err
+	}
+
+	floor := cb.notificationFloors[receiver]
+	want := routeIntervals{
+		group:  max(own.group, floor),
+		repeat: max(own.repeat, floor),
+	}
+
+	if want.group != own.group || (r.GroupInterval == "" && want.group != enforced.group) {
+		r.GroupInterval = model.Duration(want.group).String()
+	}
+
+	if want.repeat != own.repeat || (r.RepeatInterval == "" && want.repeat != enforced.repeat) {
+		r.RepeatInterval = model.Duration(want.repeat).String()
+	}
+
+	if want != own {
+		cb.logger.Debug("raising route intervals to enforce the receiver's rate limit", "receiver", receiver, "group_interval", r.GroupInterval, "repeat_interval", r.RepeatInterval)
+	}
+
+	for _, child := range r.Routes {
+		if err := cb.enforceRateLimits(child, receiver, own, want); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
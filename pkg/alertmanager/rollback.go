@@ -0,0 +1,591 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+const (
+	// configHashAnnotation is the hash of the configuration stored in the
+	// generated and last known good secrets.
+	configHashAnnotation = "operator.prometheus.io/config-hash"
+	// configUpdatedAtAnnotation is the time at which the configuration
+	// stored in the generated secret has changed for the last time.
+	configUpdatedAtAnnotation = "operator.prometheus.io/config-updated-at"
+	// configResourcesAnnotation lists the AlertmanagerConfig objects (with
+	// their generation) from which the configuration has been generated.
+	configResourcesAnnotation = "operator.prometheus.io/alertmanagerconfigs"
+	// rejectedConfigHashAnnotation is the hash of the generated
+	// configuration which failed to load.
+	rejectedConfigHashAnnotation = "operator.prometheus.io/rejected-config-hash"
+	// rejectedConfigResourcesAnnotation lists the AlertmanagerConfig
+	// objects from which the rejected configuration has been generated.
+	rejectedConfigResourcesAnnotation = "operator.prometheus.io/rejected-alertmanagerconfigs"
+
+	// configPropagationDelay is the time given to the kubelet and the
+	// config-reloader to propagate a new configuration before the operator
+	// trusts the reload status reported by the config-reloader.
+	configPropagationDelay = 5 * time.Minute
+	// configReloadCheckInterval is the interval at which the reload status
+	// is checked while the configuration hasn't been confirmed (e.g. it
+	// keeps failing to load without last known good configuration).
+	configReloadCheckInterval = time.Minute
+
+	rollingBackConfigurationAction = "RollingBackConfiguration"
+)
+
+// lastKnownGoodConfigSecretName returns the name of the secret holding the
+// last generated configuration which has been successfully loaded.
+func lastKnownGoodConfigSecretName(name string) string {
+	return generatedConfigSecretName(name) + "-last-known-good"
+}
+
+// ReloadStatus is the status of the last configuration reload reported by
+// the config-reloader sidecar.
+type ReloadStatus struct {
+	// Successful is false if the last reload failed.
+	Successful bool
+	// LastSuccess is the time of the last successful reload.
+	LastSuccess time.Time
+}
+
+// ReloadStatusClient retrieves the reload status from the config-reloader
+// sidecar of an Alertmanager pod.
+type ReloadStatusClient interface {
+	ReloadStatus(ctx context.Context, ep webconfig.Endpoint) (ReloadStatus, error)
+}
+
+// HTTPReloadStatusClient implements ReloadStatusClient by scraping the
+// metrics of the config-reloader.
+type HTTPReloadStatusClient struct{}
+
+// NewHTTPReloadStatusClient returns a ReloadStatusClient querying the
+// config-reloader over HTTP.
+func NewHTTPReloadStatusClient() *HTTPReloadStatusClient {
+	return &HTTPReloadStatusClient{}
+}
+
+// ReloadStatus implements the ReloadStatusClient interface.
+func (c *HTTPReloadStatusClient) ReloadStatus(ctx context.Context, ep webconfig.Endpoint) (ReloadStatus, error) {
+	u := ep.URL
+	u.Path = path.Join(ep.URL.Path, "/metrics")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return ReloadStatus{}, err
+	}
+
+	resp, err := ep.Client.Do(req)
+	if err != nil {
+		return ReloadStatus{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ReloadStatus{}, fmt.Errorf("GET %s: unexpected status code %d", u.String(), resp.StatusCode)
+	}
+
+	return parseReloadStatus(resp.Body)
+}
+
+// parseReloadStatus extracts the reload status from the config-reloader
+// metrics.
+func parseReloadStatus(r io.Reader) (ReloadStatus, error) {
+	parser := expfmt.NewTextParser(model.LegacyValidation)
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return ReloadStatus{}, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	gauge := func(name string) (float64, bool) {
+		mf, found := families[name]
+		if !found || len(mf.GetMetric()) == 0 || mf.GetMetric()[0].GetGauge() == nil {
+			return 0, false
+		}
+		return mf.GetMetric()[0].GetGauge().GetValue(), true
+	}
+
+	successful, found := gauge("reloader_last_reload_successful")
+	if !found {
+		return ReloadStatus{}, fmt.Errorf("metric %q not found", "reloader_last_reload_successful")
+	}
+
+	st := ReloadStatus{Successful: successful == 1}
+	if ts, found := gauge("reloader_last_reload_success_timestamp_seconds"); found && ts > 0 {
+		sec, frac := math.Modf(ts)
+		st.LastSuccess = time.Unix(int64(sec), int64(frac*1e9))
+	}
+
+	return st, nil
+}
+
+// reloadState is the aggregated reload status of the Alertmanager pods.
+type reloadState struct {
+	// failed is true if at least one pod failed to reload the configuration.
+	failed bool
+	// lastSuccess is the oldest successful reload time across the pods.
+	lastSuccess time.Time
+	// observedAt is the time at which the status has been retrieved.
+	observedAt time.Time
+}
+
+// reloadTracker keeps the last reload state observed for each Alertmanager
+// object and the time at which the reload status must be checked again.
+type reloadTracker struct {
+	mtx    sync.RWMutex
+	states map[string]reloadState
+	checks map[string]time.Time
+}
+
+func newReloadTracker() *reloadTracker {
+	return &reloadTracker{
+		states: map[string]reloadState{},
+		checks: map[string]time.Time{},
+	}
+}
+
+// Set records the reload state for the given `<namespace>/<name>` key and
+// returns the previous state.
+func (t *reloadTracker) Set(key string, st reloadState) (reloadState, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	prev, found := t.states[key]
+	t.states[key] = st
+
+	return prev, found
+}
+
+// Get returns the reload state for the given `<namespace>/<name>` key.
+func (t *reloadTracker) Get(key string) (reloadState, bool) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	st, found := t.states[key]
+	return st, found
+}
+
+// ScheduleCheck records that the reload status for the given
+// `<namespace>/<name>` key must be checked at the given time because the
+// configuration hasn't been confirmed yet.
+func (t *reloadTracker) ScheduleCheck(key string, at time.Time) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.checks[key] = at
+}
+
+// CancelCheck removes the scheduled check for the given `<namespace>/<name>`
+// key.
+func (t *reloadTracker) CancelCheck(key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.checks, key)
+}
+
+// NextCheck returns the time at which the reload status for the given
+// `<namespace>/<name>` key must be checked.
+func (t *reloadTracker) NextCheck(key string) (time.Time, bool) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	at, found := t.checks[key]
+	return at, found
+}
+
+// Delete removes the reload state for the given `<namespace>/<name>` key.
+func (t *reloadTracker) Delete(key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.states, key)
+	delete(t.checks, key)
+}
+
+// configRollbackError is returned when the operator serves the last known
+// good configuration instead of the generated one.
+type configRollbackError struct {
+	suspects []string
+}
+
+func (e *configRollbackError) Error() string {
+	msg := "the generated configuration failed to load, the last known good configuration has been restored"
+	if len(e.suspects) == 0 {
+		return msg
+	}
+
+	return fmt.Sprintf("%s (AlertmanagerConfig objects changed since then: %s)", msg, strings.Join(e.suspects, ", "))
+}
+
+// newConfigRollbackError returns an error naming the AlertmanagerConfig
+// objects which have been added or modified since the last known good
+// configuration.
+func newConfigRollbackError(rejected, lastKnownGood string) *configRollbackError {
+	good := map[string]struct{}{}
+	for _, r := range strings.Split(lastKnownGood, ",") {
+		good[r] = struct{}{}
+	}
+
+	var suspects []string
+	for _, r := range strings.Split(rejected, ",") {
+		if r == "" {
+			continue
+		}
+
+		if _, found := good[r]; !found {
+			name, _, _ := strings.Cut(r, "@")
+			suspects = append(suspects, name)
+		}
+	}
+
+	return &configRollbackError{suspects: suspects}
+}
+
+// usesGeneratedConfiguration returns true if the operator generates the
+// configuration of the Alertmanager.
+func usesGeneratedConfiguration(am *monitoringv1.Alertmanager) bool {
+	return am.Spec.AlertmanagerConfigSelector != nil || am.Spec.AlertmanagerConfiguration != nil
+}
+
+// reloadCheckEnabled returns true if the operator checks the reload status
+// of the Alertmanager pods.
+func (c *Operator) reloadCheckEnabled(am *monitoringv1.Alertmanager) bool {
+	return c.reloadStatusClient != nil && !am.Spec.ListenLocal && usesGeneratedConfiguration(am)
+}
+
+// checkConfigReload retrieves the reload status from the ready pods and
+// triggers a reconciliation when it changes or when the scheduled check of
+// the configuration is due.
+//
+// It returns an error if the reload status couldn't be retrieved from some
+// of the pods.
+func (c *Operator) checkConfigReload(ctx context.Context, key string, am *monitoringv1.Alertmanager, reporter *operator.StatefulSetReporter) error {
+	if !c.reloadCheckEnabled(am) {
+		return nil
+	}
+
+	var (
+		st       = reloadState{observedAt: c.now()}
+		observed bool
+		errs     []error
+	)
+	for _, p := range reporter.ReadyPods() {
+		pod := (*v1.Pod)(p)
+		port := reloaderWebPort(pod)
+		if pod.Status.PodIP == "" || port == 0 {
+			continue
+		}
+
+		rs, err := func() (ReloadStatus, error) {
+			ep, err := c.reloadStatusEndpoint(ctx, am, pod, port)
+			if err != nil {
+				return ReloadStatus{}, fmt.Errorf("failed to create the HTTP client: %w", err)
+			}
+			defer ep.Client.CloseIdleConnections()
+
+			return c.reloadStatusClient.ReloadStatus(ctx, ep)
+		}()
+		if err != nil {
+			c.logger.Warn("failed to retrieve the reload status", "err", err, "pod", pod.Name, "namespace", pod.Namespace)
+			errs = append(errs, fmt.Errorf("pod %s: %w", pod.Name, err))
+			continue
+		}
+
+		observed = true
+		if !rs.Successful {
+			st.failed = true
+		}
+		if st.lastSuccess.IsZero() || rs.LastSuccess.Before(st.lastSuccess) {
+			st.lastSuccess = rs.LastSuccess
+		}
+	}
+
+	if observed && c.updateReloadState(key, st) {
+		c.rr.EnqueueForReconciliation(am)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to retrieve the configuration reload status: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// updateReloadState records the reload state and returns true if the
+// Alertmanager object needs to be reconciled, either because the state has
+// changed or because the scheduled check of the configuration is due.
+func (c *Operator) updateReloadState(key string, st reloadState) bool {
+	prev, found := c.configReloads.Set(key, st)
+	if !found || prev.failed != st.failed || !prev.lastSuccess.Equal(st.lastSuccess) {
+		return true
+	}
+
+	at, scheduled := c.configReloads.NextCheck(key)
+	return scheduled && !st.observedAt.Before(at)
+}
+
+// reloadStatusEndpoint returns the endpoint of the config-reloader's web
+// server for the given pod. The config-reloader serves with the web
+// configuration of Alertmanager hence the HTTP client is configured from the
+// web TLS configuration and the operator's client configuration of the
+// Alertmanager.
+func (c *Operator) reloadStatusEndpoint(ctx context.Context, am *monitoringv1.Alertmanager, pod *v1.Pod, port int32) (webconfig.Endpoint, error) {
+	var (
+		tlsConfig    *monitoringv1.WebTLSConfig
+		clientConfig *monitoringv1.WebClientConfig
+	)
+	if am.Spec.Web != nil {
+		tlsConfig = am.Spec.Web.TLSConfig
+		clientConfig = am.Spec.Web.OperatorClientConfig
+	}
+
+	return webconfig.PodEndpoint(ctx, c.kclient, pod, port, tlsConfig, clientConfig)
+}
+
+// reloaderWebPort returns the port of the config-reloader's web server (0
+// if not found).
+func reloaderWebPort(pod *v1.Pod) int32 {
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == "reloader-web" {
+				return p.ContainerPort
+			}
+		}
+	}
+
+	return 0
+}
+
+// configHash returns the hash of the configuration and its additional data.
+func configHash(conf []byte, additionalData map[string][]byte) string {
+	h := sha256.New()
+	h.Write(conf)
+	for _, k := range sortutil.SortedKeys(additionalData) {
+		h.Write([]byte(k))
+		h.Write(additionalData[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// configResources returns the list of AlertmanagerConfig objects with their
+// generation (e.g. `<namespace>/<name>@<generation>`).
+func configResources(amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) string {
+	resources := make([]string, 0, len(amConfigs))
+	for _, k := range sortutil.SortedKeys(amConfigs) {
+		resources = append(resources, fmt.Sprintf("%s@%d", k, amConfigs[k].Generation))
+	}
+
+	return strings.Join(resources, ",")
+}
+
+// applyGeneratedConfig writes the generated configuration into the
+// generated config secret unless it has already been rejected by
+// Alertmanager.
+//
+// When the config-reloader reports that the configuration currently stored
+// in the secret failed to load, the secret is restored from the last known
+// good configuration and the function returns a *configRollbackError.
+func (c *Operator) applyGeneratedConfig(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte, amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) error {
+	sClient := c.kclient.CoreV1().Secrets(am.Namespace)
+
+	getSecret := func(name string) (*v1.Secret, error) {
+		s, err := sClient.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return s, err
+	}
+
+	current, err := getSecret(generatedConfigSecretName(am.Name))
+	if err != nil {
+		return fmt.Errorf("failed to get the generated config secret: %w", err)
+	}
+
+	lastKnownGood, err := getSecret(lastKnownGoodConfigSecretName(am.Name))
+	if err != nil {
+		return fmt.Errorf("failed to get the last known good config secret: %w", err)
+	}
+
+	key, _ := c.accessor.MetaNamespaceKey(am)
+	if st, found := c.configReloads.Get(key); found && current != nil && current.Annotations[configHashAnnotation] != "" {
+		currentHash := current.Annotations[configHashAnnotation]
+		updatedAt, _ := time.Parse(time.RFC3339, current.Annotations[configUpdatedAtAnnotation])
+		settled := st.observedAt.After(updatedAt.Add(configPropagationDelay))
+
+		var lastKnownGoodHash string
+		if lastKnownGood != nil {
+			lastKnownGoodHash = lastKnownGood.Annotations[configHashAnnotation]
+		}
+
+		switch {
+		case st.failed && settled && lastKnownGood != nil && lastKnownGoodHash != currentHash:
+			c.configReloads.CancelCheck(key)
+			return c.rollbackGeneratedConfig(ctx, am, current, lastKnownGood)
+
+		case !st.failed && settled && st.lastSuccess.After(updatedAt) && lastKnownGoodHash != currentHash:
+			if err := c.saveLastKnownGoodConfig(ctx, am, current); err != nil {
+				return err
+			}
+			lastKnownGood = current
+		}
+	}
+
+	var (
+		hash      = configHash(conf, additionalData)
+		updatedAt = c.now().UTC().Format(time.RFC3339)
+	)
+	if current != nil {
+		if current.Annotations[rejectedConfigHashAnnotation] == hash {
+			// The configuration has already been rejected: keep serving the
+			// last known good configuration until it changes.
+			c.configReloads.CancelCheck(key)
+			var good string
+			if lastKnownGood != nil {
+				good = lastKnownGood.Annotations[configResourcesAnnotation]
+			}
+			return newConfigRollbackError(current.Annotations[rejectedConfigResourcesAnnotation], good)
+		}
+
+		if current.Annotations[configHashAnnotation] == hash && current.Annotations[configUpdatedAtAnnotation] != "" {
+			updatedAt = current.Annotations[configUpdatedAtAnnotation]
+		}
+	}
+
+	if err := c.createOrUpdateGeneratedConfigSecret(ctx, am, conf, additionalData, map[string]string{
+		configHashAnnotation:              hash,
+		configUpdatedAtAnnotation:         updatedAt,
+		configResourcesAnnotation:         configResources(amConfigs),
+		rejectedConfigHashAnnotation:      "",
+		rejectedConfigResourcesAnnotation: "",
+	}); err != nil {
+		return err
+	}
+
+	c.scheduleConfigCheck(key, am, hash, updatedAt, lastKnownGood)
+
+	return nil
+}
+
+// scheduleConfigCheck schedules a check of the reload status until the
+// configuration with the given hash becomes the last known good
+// configuration. The first check happens once the configuration has
+// propagated to the pods, the next ones every configReloadCheckInterval.
+func (c *Operator) scheduleConfigCheck(key string, am *monitoringv1.Alertmanager, hash string, updatedAt string, lastKnownGood *v1.Secret) {
+	if !c.reloadCheckEnabled(am) || (lastKnownGood != nil && lastKnownGood.Annotations[configHashAnnotation] == hash) {
+		c.configReloads.CancelCheck(key)
+		return
+	}
+
+	now := c.now()
+	t, _ := time.Parse(time.RFC3339, updatedAt)
+	at := t.Add(configPropagationDelay + time.Second)
+	if at.Before(now) {
+		at = now.Add(configReloadCheckInterval)
+	}
+
+	c.configReloads.ScheduleCheck(key, at)
+}
+
+// saveLastKnownGoodConfig copies the generated config secret into the last
+// known good config secret.
+func (c *Operator) saveLastKnownGoodConfig(ctx context.Context, am *monitoringv1.Alertmanager, generated *v1.Secret) error {
+	s := &v1.Secret{
+		Data: maps.Clone(generated.Data),
+	}
+
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(c.config.Labels),
+		operator.WithAnnotations(c.config.Annotations),
+		operator.WithAnnotations(map[string]string{
+			configHashAnnotation:      generated.Annotations[configHashAnnotation],
+			configResourcesAnnotation: generated.Annotations[configResourcesAnnotation],
+		}),
+		operator.WithManagingOwner(am),
+		operator.WithName(lastKnownGoodConfigSecretName(am.Name)),
+	)
+
+	if err := k8sutil.CreateOrUpdateSecret(ctx, c.kclient.CoreV1().Secrets(am.Namespace), s); err != nil {
+		return fmt.Errorf("failed to update the last known good config secret: %w", err)
+	}
+
+	return nil
+}
+
+// rollbackGeneratedConfig restores the generated config secret from the last
+// known good configuration.
+func (c *Operator) rollbackGeneratedConfig(ctx context.Context, am *monitoringv1.Alertmanager, current *v1.Secret, lastKnownGood *v1.Secret) error {
+	rollbackErr := newConfigRollbackError(current.Annotations[configResourcesAnnotation], lastKnownGood.Annotations[configResourcesAnnotation])
+
+	s := &v1.Secret{
+		Data: maps.Clone(lastKnownGood.Data),
+	}
+
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(c.config.Labels),
+		operator.WithAnnotations(c.config.Annotations),
+		operator.WithAnnotations(map[string]string{
+			configHashAnnotation:              lastKnownGood.Annotations[configHashAnnotation],
+			configUpdatedAtAnnotation:         c.now().UTC().Format(time.RFC3339),
+			configResourcesAnnotation:         lastKnownGood.Annotations[configResourcesAnnotation],
+			rejectedConfigHashAnnotation:      current.Annotations[configHashAnnotation],
+			rejectedConfigResourcesAnnotation: current.Annotations[configResourcesAnnotation],
+		}),
+		operator.WithManagingOwner(am),
+		operator.WithName(generatedConfigSecretName(am.Name)),
+	)
+
+	if err := k8sutil.CreateOrUpdateSecret(ctx, c.kclient.CoreV1().Secrets(am.Namespace), s); err != nil {
+		return fmt.Errorf("failed to restore the last known good configuration: %w", err)
+	}
+
+	c.logger.Warn("generated configuration failed to load, restored the last known good configuration",
+		"alertmanager", am.Name,
+		"namespace", am.Namespace,
+		"err", rollbackErr,
+	)
+	c.newEventRecorder(am).Eventf(am, v1.EventTypeWarning, operator.InvalidConfigurationEvent, rollingBackConfigurationAction, "%v", rollbackErr)
+
+	return rollbackErr
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestParseReloadStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		metrics  string
+		expected ReloadStatus
+		err      bool
+	}{
+		{
+			name: "successful",
+			metrics: `# TYPE reloader_last_reload_successful gauge
+reloader_last_reload_successful 1
+# TYPE reloader_last_reload_success_timestamp_seconds gauge
+reloader_last_reload_success_timestamp_seconds 1.7e+09
+`,
+			expected: ReloadStatus{Successful: true, LastSuccess: time.Unix(1700000000, 0)},
+		},
+		{
+			name: "failed",
+			metrics: `# TYPE reloader_last_reload_successful gauge
+reloader_last_reload_successful 0
+# TYPE reloader_last_reload_success_timestamp_seconds gauge
+reloader_last_reload_success_timestamp_seconds 0
+`,
+			expected: ReloadStatus{},
+		},
+		{
+			name: "missing metric",
+			metrics: `# TYPE foo gauge
+foo 1
+`,
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			st, err := parseReloadStatus(strings.NewReader(tc.metrics))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.Successful, st.Successful)
+			require.True(t, tc.expected.LastSuccess.Equal(st.LastSuccess))
+		})
+	}
+}
+
+func TestApplyGeneratedConfig(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	kclient := fake.NewSimpleClientset()
+	logger := slog.New(slog.DiscardHandler)
+	o := &Operator{
+		kclient:          kclient,
+		logger:           logger,
+		accessor:         operator.NewAccessor(logger),
+		newEventRecorder: func(related runtime.Object) *operator.EventRecorder { return operator.NewFakeRecorder(1, related) },
+		configReloads:    newReloadTracker(),
+		now:              time.Now,
+	}
+
+	newAlertmanagerConfig := func(name string, generation int64) *monitoringv1alpha1.AlertmanagerConfig {
+		return &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "test",
+				Generation: generation,
+			},
+		}
+	}
+
+	getSecret := func(name string) *v1.Secret {
+		s, err := kclient.CoreV1().Secrets("test").Get(context.Background(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		return s
+	}
+
+	ctx := context.Background()
+	later := time.Now().Add(2 * configPropagationDelay)
+
+	// Initial configuration.
+	good := map[string]*monitoringv1alpha1.AlertmanagerConfig{"test/a": newAlertmanagerConfig("a", 1)}
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("good"), nil, good))
+	goodData := getSecret(generatedConfigSecretName(am.Name)).Data
+
+	// The configuration has been loaded: it becomes the last known good
+	// configuration when the next configuration is applied.
+	o.configReloads.Set("test/test", reloadState{lastSuccess: later, observedAt: later})
+	bad := map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"test/a": newAlertmanagerConfig("a", 1),
+		"test/b": newAlertmanagerConfig("b", 3),
+	}
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("bad"), nil, bad))
+	require.Equal(t, goodData, getSecret(lastKnownGoodConfigSecretName(am.Name)).Data)
+	require.NotEqual(t, goodData, getSecret(generatedConfigSecretName(am.Name)).Data)
+
+	// The configuration fails to load: the last known good configuration is
+	// restored.
+	o.configReloads.Set("test/test", reloadState{failed: true, lastSuccess: later, observedAt: later})
+	err := o.applyGeneratedConfig(ctx, am, []byte("bad"), nil, bad)
+	var cre *configRollbackError
+	require.True(t, errors.As(err, &cre), "expected a rollback error but got %v", err)
+	require.Equal(t, []string{"test/b"}, cre.suspects)
+	require.Equal(t, goodData, getSecret(generatedConfigSecretName(am.Name)).Data)
+
+	// The rejected configuration isn't applied again.
+	o.configReloads.Set("test/test", reloadState{lastSuccess: later, observedAt: later})
+	err = o.applyGeneratedConfig(ctx, am, []byte("bad"), nil, bad)
+	require.True(t, errors.As(err, &cre), "expected a rollback error but got %v", err)
+	require.Equal(t, []string{"test/b"}, cre.suspects)
+	require.Equal(t, goodData, getSecret(generatedConfigSecretName(am.Name)).Data)
+
+	// A new configuration is applied.
+	fixed := map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"test/a": newAlertmanagerConfig("a", 1),
+		"test/b": newAlertmanagerConfig("b", 4),
+	}
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("fixed"), nil, fixed))
+	s := getSecret(generatedConfigSecretName(am.Name))
+	require.NotEqual(t, goodData, s.Data)
+	require.Empty(t, s.Annotations[rejectedConfigHashAnnotation])
+	require.Equal(t, "test/a@1,test/b@4", s.Annotations[configResourcesAnnotation])
+}
+
+func TestConfigRollbackAfterPropagationDelay(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	var (
+		kclient = fake.NewSimpleClientset()
+		logger  = slog.New(slog.DiscardHandler)
+		now     = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	o := &Operator{
+		kclient:            kclient,
+		logger:             logger,
+		accessor:           operator.NewAccessor(logger),
+		newEventRecorder:   func(related runtime.Object) *operator.EventRecorder { return operator.NewFakeRecorder(1, related) },
+		reloadStatusClient: NewHTTPReloadStatusClient(),
+		configReloads:      newReloadTracker(),
+		now:                func() time.Time { return now },
+	}
+
+	getSecretData := func(name string) map[string][]byte {
+		s, err := kclient.CoreV1().Secrets("test").Get(context.Background(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		return s.Data
+	}
+
+	ctx := context.Background()
+	amConfigs := map[string]*monitoringv1alpha1.AlertmanagerConfig{}
+
+	// The first configuration is loaded successfully: it becomes the last
+	// known good configuration at the first check following the propagation
+	// delay and no more check is scheduled.
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("good"), nil, amConfigs))
+	at, found := o.configReloads.NextCheck("test/test")
+	require.True(t, found)
+	require.Equal(t, now.Add(configPropagationDelay+time.Second), at)
+
+	lastSuccess := now.Add(10 * time.Second)
+	now = now.Add(time.Minute)
+	require.True(t, o.updateReloadState("test/test", reloadState{lastSuccess: lastSuccess, observedAt: now}))
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("good"), nil, amConfigs))
+
+	now = at
+	require.True(t, o.updateReloadState("test/test", reloadState{lastSuccess: lastSuccess, observedAt: now}))
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("good"), nil, amConfigs))
+	goodData := getSecretData(lastKnownGoodConfigSecretName(am.Name))
+	_, found = o.configReloads.NextCheck("test/test")
+	require.False(t, found)
+
+	// The next configuration fails to load.
+	now = now.Add(10 * time.Minute)
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("bad"), nil, amConfigs))
+	at, found = o.configReloads.NextCheck("test/test")
+	require.True(t, found)
+
+	now = now.Add(time.Minute)
+	require.True(t, o.updateReloadState("test/test", reloadState{failed: true, lastSuccess: lastSuccess, observedAt: now}))
+	require.NoError(t, o.applyGeneratedConfig(ctx, am, []byte("bad"), nil, amConfigs))
+	require.NotEqual(t, goodData, getSecretData(generatedConfigSecretName(am.Name)))
+
+	// The reload status doesn't change: no reconciliation is needed until the
+	// scheduled check.
+	now = now.Add(time.Minute)
+	require.False(t, o.updateReloadState("test/test", reloadState{failed: true, lastSuccess: lastSuccess, observedAt: now}))
+
+	// Once the propagation delay has passed, the unchanged reload status
+	// triggers the reconciliation which restores the last known good
+	// configuration.
+	now = at
+	require.True(t, o.updateReloadState("test/test", reloadState{failed: true, lastSuccess: lastSuccess, observedAt: now}))
+	err := o.applyGeneratedConfig(ctx, am, []byte("bad"), nil, amConfigs)
+	var cre *configRollbackError
+	require.True(t, errors.As(err, &cre), "expected a rollback error but got %v", err)
+	require.Equal(t, goodData, getSecretData(generatedConfigSecretName(am.Name)))
+	_, found = o.configReloads.NextCheck("test/test")
+	require.False(t, found)
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/types"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+)
+
+// RouteTestResult is the result of routing a label set through the
+// generated Alertmanager configuration.
+type RouteTestResult struct {
+	// Labels is the label set which has been tested.
+	Labels model.LabelSet `json:"labels"`
+	// Receivers is the list of receivers receiving the alert, in order.
+	Receivers []string `json:"receivers"`
+	// Routes is the list of routes matching the alert, in order.
+	Routes []MatchedRoute `json:"routes"`
+}
+
+// MatchedRoute is a route of the generated configuration which matches the
+// label set.
+type MatchedRoute struct {
+	// Receiver is the receiver of the route (possibly inherited from the
+	// parent route).
+	Receiver string `json:"receiver"`
+	// Path is the list of routes from the root route to the matched route.
+	Path []RouteStep `json:"path"`
+	// AlertmanagerConfig is the AlertmanagerConfig resource from which the
+	// route has been generated. It is nil if the route comes from the
+	// configuration secret.
+	AlertmanagerConfig *RouteSource `json:"alertmanagerConfig,omitempty"`
+}
+
+// RouteStep is a route on the path to the matched route.
+type RouteStep struct {
+	Receiver string   `json:"receiver,omitempty"`
+	Matchers []string `json:"matchers,omitempty"`
+	Continue bool     `json:"continue,omitempty"`
+}
+
+// RouteSource references the AlertmanagerConfig resource from which a route
+// has been generated.
+type RouteSource struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// routingTree is the routing tree of a generated Alertmanager configuration.
+type routingTree struct {
+	root *route
+	// origins maps the routes generated from AlertmanagerConfig resources to
+	// their source.
+	origins map[*route]types.NamespacedName
+}
+
+// newRoutingTree returns the routing tree of the configuration.
+// The configuration mustn't be modified after the call.
+func newRoutingTree(cfg *alertmanagerConfig, origins map[*route]types.NamespacedName) *routingTree {
+	return &routingTree{
+		root:    cfg.Route,
+		origins: origins,
+	}
+}
+
+// Test returns the routes matching the label set, following the same logic
+// as Alertmanager (and `amtool config routes test`).
+func (rt *routingTree) Test(lset model.LabelSet) (*RouteTestResult, error) {
+	res := &RouteTestResult{
+		Labels:    lset,
+		Receivers: []string{},
+		Routes:    []MatchedRoute{},
+	}
+
+	if rt.root == nil {
+		return res, nil
+	}
+
+	// The root route matches all alerts.
+	matches, err := rt.match(rt.root, lset, nil, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]struct{}{}
+	for _, m := range matches {
+		res.Routes = append(res.Routes, m)
+		if _, found := seen[m.Receiver]; found {
+			continue
+		}
+		seen[m.Receiver] = struct{}{}
+		res.Receivers = append(res.Receivers, m.Receiver)
+	}
+
+	return res, nil
+}
+
+func (rt *routingTree) match(r *route, lset model.LabelSet, path []RouteStep, receiver string, source *RouteSource) ([]MatchedRoute, error) {
+	if r.Receiver != "" {
+		receiver = r.Receiver
+	}
+
+	if origin, found := rt.origins[r]; found {
+		source = &RouteSource{Namespace: origin.Namespace, Name: origin.Name}
+	}
+
+	matchers, err := routeMatchers(r)
+	if err != nil {
+		return nil, err
+	}
+
+	step := RouteStep{
+		Receiver: r.Receiver,
+		Continue: r.Continue,
+	}
+	for _, m := range matchers {
+		step.Matchers = append(step.Matchers, m.String())
+	}
+	path = append(path[:len(path):len(path)], step)
+
+	var all []MatchedRoute
+	for _, child := range r.Routes {
+		childMatchers, err := routeMatchers(child)
+		if err != nil {
+			return nil, err
+		}
+
+		if !childMatchers.Matches(lset) {
+			continue
+		}
+
+		matches, err := rt.match(child, lset, path, receiver, source)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, matches...)
+		if !child.Continue {
+			break
+		}
+	}
+
+	if len(all) == 0 {
+		all = append(all, MatchedRoute{
+			Receiver:           receiver,
+			Path:               path,
+			AlertmanagerConfig: source,
+		})
+	}
+
+	return all, nil
+}
+
+// routeMatchers returns the matchers of the route from the `match`,
+// `match_re` and `matchers` fields.
+func routeMatchers(r *route) (labels.Matchers, error) {
+	var matchers labels.Matchers
+
+	for _, k := range sortutil.SortedKeys(r.Match) {
+		m, err := labels.NewMatcher(labels.MatchEqual, k, r.Match[k])
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	for _, k := range sortutil.SortedKeys(r.MatchRE) {
+		m, err := labels.NewMatcher(labels.MatchRegexp, k, r.MatchRE[k])
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	for _, s := range r.Matchers {
+		m, err := labels.ParseMatcher(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matcher %q: %w", s, err)
+		}
+		matchers = append(matchers, m)
+	}
+
+	return matchers, nil
+}
+
+// routingTracker keeps the routing tree of the last configuration generated
+// for each Alertmanager object.
+type routingTracker struct {
+	mtx   sync.RWMutex
+	trees map[string]*routingTree
+}
+
+func newRoutingTracker() *routingTracker {
+	return &routingTracker{
+		trees: map[string]*routingTree{},
+	}
+}
+
+// Set records the routing tree for the given `<namespace>/<name>` key.
+func (t *routingTracker) Set(key string, tree *routingTree) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.trees[key] = tree
+}
+
+// Delete removes the routing tree for the given `<namespace>/<name>` key.
+func (t *routingTracker) Delete(key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.trees, key)
+}
+
+// Get returns the routing tree for the given `<namespace>/<name>` key (nil
+// if it doesn't exist).
+func (t *routingTracker) Get(key string) *routingTree {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.trees[key]
+}
+
+// ServeHTTP implements the http.Handler interface. It expects the
+// `namespace` and `name` path values and the label set as query parameters
+// (e.g. `?alertname=Watchdog&severity=none`).
+func (t *routingTracker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ns, name := r.PathValue("namespace"), r.PathValue("name")
+
+	tree := t.Get(fmt.Sprintf("%s/%s", ns, name))
+	if tree == nil {
+		http.Error(w, fmt.Sprintf("no configuration found for %s/%s", ns, name), http.StatusNotFound)
+		return
+	}
+
+	lset := model.LabelSet{}
+	for k, v := range r.URL.Query() {
+		ln := model.LabelName(k)
+		if !ln.IsValid() {
+			http.Error(w, fmt.Sprintf("invalid label name %q", k), http.StatusBadRequest)
+			return
+		}
+		lset[ln] = model.LabelValue(v[0])
+	}
+
+	res, err := tree.Test(lset)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
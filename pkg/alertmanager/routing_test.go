@@ -0,0 +1,168 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+func newTestRoutingTree(t *testing.T) *routingTree {
+	t.Helper()
+
+	kclient := fake.NewSimpleClientset()
+	cb := NewConfigBuilder(
+		newNopLogger(t),
+		semver.MustParse("0.28.0"),
+		assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1()),
+		&monitoringv1.Alertmanager{ObjectMeta: metav1.ObjectMeta{Namespace: "monitoring", Name: "main"}},
+	)
+	require.NoError(t, cb.InitializeFromRawConfiguration([]byte(`
+route:
+  receiver: default
+  routes:
+  - receiver: watchdog
+    match:
+      alertname: Watchdog
+receivers:
+- name: default
+- name: watchdog
+`)))
+
+	require.NoError(t, cb.AddAlertmanagerConfigs(context.Background(), map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"team-a/amc": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "amc"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "slack",
+					Routes: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"receiver":"pager","matchers":[{"name":"severity","value":"critical"}],"continue":true}`)},
+						{Raw: []byte(`{"matchers":[{"name":"severity","value":"critical|warning","matchType":"=~"}]}`)},
+					},
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{Name: "slack"}, {Name: "pager"}},
+			},
+		},
+		"team-b/amc": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "amc"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "email",
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{Name: "email"}},
+			},
+		},
+	}))
+
+	return cb.routingTree()
+}
+
+func TestRoutingTreeTest(t *testing.T) {
+	rt := newTestRoutingTree(t)
+
+	for _, tc := range []struct {
+		name      string
+		lset      model.LabelSet
+		receivers []string
+		sources   []*RouteSource
+	}{
+		{
+			name:      "no match",
+			lset:      model.LabelSet{"alertname": "Foo", "namespace": "team-c"},
+			receivers: []string{"default"},
+			sources:   []*RouteSource{nil},
+		},
+		{
+			name:      "route from the configuration secret",
+			lset:      model.LabelSet{"alertname": "Watchdog", "namespace": "team-c"},
+			receivers: []string{"watchdog"},
+			sources:   []*RouteSource{nil},
+		},
+		{
+			name:      "AlertmanagerConfig route",
+			lset:      model.LabelSet{"alertname": "Foo", "namespace": "team-b"},
+			receivers: []string{"team-b/amc/email"},
+			sources:   []*RouteSource{{Namespace: "team-b", Name: "amc"}},
+		},
+		{
+			name:      "AlertmanagerConfig child routes with continue",
+			lset:      model.LabelSet{"alertname": "Foo", "namespace": "team-a", "severity": "critical"},
+			receivers: []string{"team-a/amc/pager", "team-a/amc/slack"},
+			sources:   []*RouteSource{{Namespace: "team-a", Name: "amc"}, {Namespace: "team-a", Name: "amc"}},
+		},
+		{
+			name:      "AlertmanagerConfig child route with inherited receiver",
+			lset:      model.LabelSet{"alertname": "Foo", "namespace": "team-a", "severity": "warning"},
+			receivers: []string{"team-a/amc/slack"},
+			sources:   []*RouteSource{{Namespace: "team-a", Name: "amc"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := rt.Test(tc.lset)
+			require.NoError(t, err)
+
+			receivers := make([]string, 0, len(res.Routes))
+			sources := make([]*RouteSource, 0, len(res.Routes))
+			for _, r := range res.Routes {
+				receivers = append(receivers, r.Receiver)
+				sources = append(sources, r.AlertmanagerConfig)
+			}
+
+			require.Equal(t, tc.receivers, receivers)
+			require.Equal(t, tc.sources, sources)
+		})
+	}
+}
+
+func TestRoutingTracker(t *testing.T) {
+	tracker := newRoutingTracker()
+	tracker.Set("monitoring/main", newTestRoutingTree(t))
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v1/alertmanagers/{namespace}/{name}/routes/test", tracker)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/alertmanagers/monitoring/main/routes/test?namespace=team-a&severity=critical", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got RouteTestResult
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	require.Equal(t, []string{"team-a/amc/pager", "team-a/amc/slack"}, got.Receivers)
+	require.Len(t, got.Routes[0].Path, 3)
+	require.Equal(t, []string{`severity="critical"`}, got.Routes[0].Path[2].Matchers)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/alertmanagers/monitoring/main/routes/test?0invalid=foo", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	tracker.Delete("monitoring/main")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/alertmanagers/monitoring/main/routes/test", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
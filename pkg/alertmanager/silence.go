@@ -0,0 +1,866 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+// Alertmanager doesn't notify about changes of the silences (e.g. a silence
+// expired from the UI or lost after a restart without persistent storage)
+// hence the controller checks the silences periodically.
+const silenceSyncInterval = time.Minute
+
+// errAlertmanagerUnreachable is returned when the Alertmanager API can't be
+// reached (e.g. no pod is ready).
+var errAlertmanagerUnreachable = errors.New("alertmanager unreachable")
+
+// SilenceClient manages the silences of an Alertmanager pod through the
+// Alertmanager v2 API.
+type SilenceClient interface {
+	// GetSilence returns the silence identified by id or nil if it doesn't
+	// exist.
+	GetSilence(ctx context.Context, ep webconfig.Endpoint, id string) (*models.GettableSilence, error)
+	// PostSilence creates the silence (or updates it when the ID is set)
+	// and returns its ID.
+	PostSilence(ctx context.Context, ep webconfig.Endpoint, s *models.PostableSilence) (string, error)
+	// ExpireSilence expires the silence identified by id.
+	ExpireSilence(ctx context.Context, ep webconfig.Endpoint, id string) error
+}
+
+// HTTPSilenceClient implements SilenceClient over HTTP.
+type HTTPSilenceClient struct{}
+
+// NewHTTPSilenceClient returns a SilenceClient querying the Alertmanager pods
+// over HTTP.
+func NewHTTPSilenceClient() *HTTPSilenceClient {
+	return &HTTPSilenceClient{}
+}
+
+// do sends the request to the Alertmanager API and decodes the response into
+// out (if not nil). It returns the HTTP status code of the response.
+//
+// Errors happening before a response is received wrap
+// errAlertmanagerUnreachable.
+func (c *HTTPSilenceClient) do(ctx context.Context, method string, ep webconfig.Endpoint, p string, in any, out any) (int, error) {
+	u := ep.URL
+	u.Path = path.Join(ep.URL.Path, "/api/v2", p)
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return 0, err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return 0, err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := ep.Client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errAlertmanagerUnreachable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("%s %s: unexpected status code %d: %s", method, u.String(), resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return resp.StatusCode, nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("%s %s: failed to decode response: %w", method, u.String(), err)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// GetSilence implements the SilenceClient interface.
+func (c *HTTPSilenceClient) GetSilence(ctx context.Context, ep webconfig.Endpoint, id string) (*models.GettableSilence, error) {
+	var s models.GettableSilence
+	code, err := c.do(ctx, http.MethodGet, ep, "/silence/"+url.PathEscape(id), nil, &s)
+	if code == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// PostSilence implements the SilenceClient interface.
+func (c *HTTPSilenceClient) PostSilence(ctx context.Context, ep webconfig.Endpoint, s *models.PostableSilence) (string, error) {
+	var resp struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if _, err := c.do(ctx, http.MethodPost, ep, "/silences", s, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.SilenceID, nil
+}
+
+// ExpireSilence implements the SilenceClient interface.
+func (c *HTTPSilenceClient) ExpireSilence(ctx context.Context, ep webconfig.Endpoint, id string) error {
+	code, err := c.do(ctx, http.MethodDelete, ep, "/silence/"+url.PathEscape(id), nil, nil)
+	if code == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
+
+// WithSilences tells that the controller should reconcile the
+// AlertmanagerSilence objects.
+func WithSilences(client SilenceClient) ControllerOption {
+	return func(o *Operator) {
+		o.silenceClient = client
+	}
+}
+
+// silenceController creates, updates and expires the silences defined by the
+// AlertmanagerSilence objects on the Alertmanager instances selecting them.
+type silenceController struct {
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+	client  SilenceClient
+
+	logger   *slog.Logger
+	accessor *operator.Accessor
+
+	silenceInfs *informers.ForResource
+	alrtInfs    *informers.ForResource
+	ssetInfs    *informers.ForResource
+	nsInf       cache.SharedIndexInformer
+
+	rr      *operator.ResourceReconciler
+	metrics *operator.Metrics
+
+	now func() time.Time
+}
+
+func newSilenceController(c *Operator, config operator.Config, r prometheus.Registerer) (*silenceController, error) {
+	sc := &silenceController{
+		kclient:  c.kclient,
+		mclient:  c.mclient,
+		client:   c.silenceClient,
+		logger:   c.logger.With("controller", "alertmanagersilence"),
+		accessor: c.accessor,
+		alrtInfs: c.alrtInfs,
+		ssetInfs: c.ssetInfs,
+		nsInf:    c.nsAlrtCfgInf,
+		metrics:  operator.NewMetrics(r),
+		now:      time.Now,
+	}
+
+	var err error
+	sc.silenceInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			config.Namespaces.AlertmanagerConfigAllowList,
+			config.Namespaces.DenyList,
+			c.mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerSilenceName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating alertmanagersilence informers: %w", err)
+	}
+
+	sc.rr = operator.NewResourceReconciler(
+		sc.logger,
+		sc,
+		sc.silenceInfs,
+		sc.metrics,
+		monitoringv1alpha1.AlertmanagerSilencesKind,
+		r,
+		c.controllerID,
+	)
+
+	return sc, nil
+}
+
+// run starts the controller. It must be called after the Alertmanager,
+// StatefulSet and namespace informers are synced.
+func (sc *silenceController) run(ctx context.Context) error {
+	go sc.rr.Run(ctx)
+	defer sc.rr.Stop()
+
+	go sc.silenceInfs.Start(ctx.Done())
+	for _, inf := range sc.silenceInfs.GetInformers() {
+		if !operator.WaitForNamedCacheSync(ctx, "alertmanagersilence", sc.logger.With("informer", "AlertmanagerSilence"), inf.Informer()) {
+			return errors.New("failed to sync cache for AlertmanagerSilence informer")
+		}
+	}
+
+	sc.silenceInfs.AddEventHandler(sc.rr)
+	sc.alrtInfs.AddEventHandler(operator.NewEventHandler(
+		sc.logger,
+		sc.accessor,
+		sc.metrics,
+		monitoringv1.AlertmanagersKind,
+		sc.enqueueForNamespace,
+		operator.WithFilter(
+			operator.AnyFilter(
+				operator.GenerationChanged,
+				operator.LabelsChanged,
+				availableReplicasChanged,
+			),
+		),
+	))
+
+	// A label change on a namespace may modify the selection of the
+	// silences.
+	_, _ = sc.nsInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur any) {
+			if !reflect.DeepEqual(old.(*v1.Namespace).Labels, cur.(*v1.Namespace).Labels) {
+				sc.enqueueAll()
+			}
+		},
+	})
+
+	sc.metrics.Ready().Set(1)
+
+	ticker := time.NewTicker(silenceSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			sc.enqueueAll()
+		}
+	}
+}
+
+// availableReplicasChanged returns true when the number of available
+// Alertmanager pods has changed (e.g. the first pod became ready).
+//
+// It always returns true for creation and deletion events.
+func availableReplicasChanged(ep operator.EventPayload) bool {
+	if ep.EventType != operator.EventTypeOnUpdate {
+		return true
+	}
+
+	oldAm, ok := ep.Old.(*monitoringv1.Alertmanager)
+	if !ok {
+		return false
+	}
+
+	curAm, ok := ep.Current.(*monitoringv1.Alertmanager)
+	if !ok {
+		return false
+	}
+
+	return oldAm.Status.AvailableReplicas != curAm.Status.AvailableReplicas
+}
+
+// enqueueForNamespace enqueues all the AlertmanagerSilence objects because a
+// change of the Alertmanager object may modify the selection of the
+// silences.
+func (sc *silenceController) enqueueForNamespace(_ string) {
+	sc.enqueueAll()
+}
+
+func (sc *silenceController) enqueueAll() {
+	err := sc.silenceInfs.ListAll(labels.Everything(), func(obj any) {
+		sc.rr.EnqueueForReconciliation(obj.(*monitoringv1alpha1.AlertmanagerSilence))
+	})
+	if err != nil {
+		sc.logger.Error("listing all AlertmanagerSilence instances from cache failed", "err", err)
+	}
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+// The status is updated by the Sync() method.
+func (sc *silenceController) UpdateStatus(_ context.Context, _ string) error {
+	return nil
+}
+
+// Sync implements the operator.Syncer interface.
+func (sc *silenceController) Sync(ctx context.Context, key string) error {
+	s, err := operator.GetObjectFromKey[*monitoringv1alpha1.AlertmanagerSilence](sc.silenceInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if s == nil {
+		return nil
+	}
+
+	deleting := sc.rr.DeletionInProgress(s)
+	if deleting && !k8sutil.HasSilenceExpirationFinalizer(s) {
+		return nil
+	}
+
+	operator.SetSpanObject(ctx, s)
+	logger := sc.logger.With("key", key)
+	logger.Info("sync alertmanagersilence")
+
+	if !deleting {
+		if err := sc.patchFinalizer(ctx, s, k8sutil.FinalizerAddPatch); err != nil {
+			return fmt.Errorf("failed to add %q finalizer: %w", k8sutil.SilenceExpirationFinalizerName, err)
+		}
+	}
+
+	// When the object is being deleted, no Alertmanager is selected which
+	// expires all the silences.
+	var selected []*monitoringv1.Alertmanager
+	if !deleting {
+		selected, err = sc.selectAlertmanagers(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	previous := make(map[string]monitoringv1alpha1.SilenceAlertmanagerStatus, len(s.Status.Alertmanagers))
+	for _, st := range s.Status.Alertmanagers {
+		previous[st.Namespace+"/"+st.Name] = st
+	}
+
+	var (
+		statuses []monitoringv1alpha1.SilenceAlertmanagerStatus
+		errs     []error
+	)
+	for _, am := range selected {
+		k := am.Namespace + "/" + am.Name
+		st, found := previous[k]
+		if !found {
+			st = monitoringv1alpha1.SilenceAlertmanagerStatus{Namespace: am.Namespace, Name: am.Name}
+		}
+		delete(previous, k)
+
+		st, err := sc.syncAlertmanager(ctx, s, am, st)
+		if err != nil {
+			logger.Warn("failed to synchronize the silence", "alertmanager", k, "err", err)
+			errs = append(errs, fmt.Errorf("alertmanager %s: %w", k, err))
+		}
+		statuses = append(statuses, st)
+	}
+
+	// Expire the silences created on the Alertmanager instances which don't
+	// select the object anymore.
+	for _, k := range slices.Sorted(maps.Keys(previous)) {
+		st := previous[k]
+		if err := sc.expireSilence(ctx, st); err != nil {
+			logger.Warn("failed to expire the silence", "alertmanager", k, "err", err)
+			errs = append(errs, fmt.Errorf("alertmanager %s: %w", k, err))
+
+			st.Message = fmt.Sprintf("failed to expire the silence: %s", err)
+			statuses = append(statuses, st)
+		}
+	}
+
+	slices.SortFunc(statuses, func(a, b monitoringv1alpha1.SilenceAlertmanagerStatus) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	if err := sc.applyStatus(ctx, s, statuses); err != nil {
+		errs = append(errs, err)
+	}
+
+	// When the Alertmanager pods can't be reached (e.g. no pod is ready),
+	// the finalizer is removed anyway rather than blocking the deletion
+	// forever. The silences expire on their own at their end time.
+	if deleting && len(errs) > 0 && !slices.ContainsFunc(errs, func(err error) bool { return !errors.Is(err, errAlertmanagerUnreachable) }) {
+		logger.Warn("removing the finalizer without expiring the silences", "err", errors.Join(errs...))
+		errs = nil
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if deleting {
+		if err := sc.patchFinalizer(ctx, s, k8sutil.FinalizerDeletePatch); err != nil {
+			return fmt.Errorf("failed to remove %q finalizer: %w", k8sutil.SilenceExpirationFinalizerName, err)
+		}
+	}
+
+	return nil
+}
+
+func (sc *silenceController) patchFinalizer(ctx context.Context, s *monitoringv1alpha1.AlertmanagerSilence, patchFn func([]string, string) ([]byte, error)) error {
+	patch, err := patchFn(s.Finalizers, k8sutil.SilenceExpirationFinalizerName)
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	_, err = sc.mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace).Patch(
+		ctx,
+		s.Name,
+		types.JSONPatchType,
+		patch,
+		metav1.PatchOptions{FieldManager: operator.PrometheusOperatorFieldManager},
+	)
+	return err
+}
+
+func (sc *silenceController) applyStatus(ctx context.Context, s *monitoringv1alpha1.AlertmanagerSilence, statuses []monitoringv1alpha1.SilenceAlertmanagerStatus) error {
+	if equality.Semantic.DeepEqual(s.Status.Alertmanagers, statuses) {
+		return nil
+	}
+
+	status := monitoringv1alpha1ac.AlertmanagerSilenceStatus()
+	for _, st := range statuses {
+		ac := monitoringv1alpha1ac.SilenceAlertmanagerStatus().
+			WithNamespace(st.Namespace).
+			WithName(st.Name).
+			WithSilenceID(st.SilenceID).
+			WithState(st.State).
+			WithObservedGeneration(st.ObservedGeneration).
+			WithMessage(st.Message)
+		if st.LastSyncTime != nil {
+			ac.WithLastSyncTime(*st.LastSyncTime)
+		}
+		status.WithAlertmanagers(ac)
+	}
+
+	_, err := sc.mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace).ApplyStatus(
+		ctx,
+		monitoringv1alpha1ac.AlertmanagerSilence(s.Name, s.Namespace).WithStatus(status),
+		metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+// selectAlertmanagers returns the Alertmanager objects selecting the silence.
+func (sc *silenceController) selectAlertmanagers(s *monitoringv1alpha1.AlertmanagerSilence) ([]*monitoringv1.Alertmanager, error) {
+	var ns *v1.Namespace
+	obj, exists, err := sc.nsInf.GetStore().GetByKey(s.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %q: %w", s.Namespace, err)
+	}
+	if exists {
+		ns = obj.(*v1.Namespace)
+	}
+
+	var ams []*monitoringv1.Alertmanager
+	err = sc.alrtInfs.ListAll(labels.Everything(), func(obj any) {
+		am := obj.(*monitoringv1.Alertmanager)
+
+		ok, err := selectsSilence(am, ns, s)
+		if err != nil {
+			sc.logger.Error("failed to check the silence selectors",
+				"err", err,
+				"alertmanager", am.Namespace+"/"+am.Name,
+			)
+			return
+		}
+
+		if ok {
+			ams = append(ams, am.DeepCopy())
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Alertmanager objects: %w", err)
+	}
+
+	slices.SortFunc(ams, func(a, b *monitoringv1.Alertmanager) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	return ams, nil
+}
+
+// selectsSilence returns true if the Alertmanager selects the silence.
+// If 'SilenceNamespaceSelector' is nil, only the Alertmanager's namespace is
+// checked.
+func selectsSilence(am *monitoringv1.Alertmanager, ns *v1.Namespace, s *monitoringv1alpha1.AlertmanagerSilence) (bool, error) {
+	if am.Spec.SilenceSelector == nil {
+		return false, nil
+	}
+
+	if am.Spec.SilenceNamespaceSelector == nil {
+		if am.Namespace != s.Namespace {
+			return false, nil
+		}
+	} else {
+		if ns == nil {
+			return false, nil
+		}
+
+		nsSelector, err := metav1.LabelSelectorAsSelector(am.Spec.SilenceNamespaceSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid silenceNamespaceSelector: %w", err)
+		}
+
+		if !nsSelector.Matches(labels.Set(ns.Labels)) {
+			return false, nil
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.SilenceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid silenceSelector: %w", err)
+	}
+
+	return selector.Matches(labels.Set(s.Labels)), nil
+}
+
+// syncAlertmanager synchronizes the silence with the given Alertmanager and
+// returns the updated status.
+func (sc *silenceController) syncAlertmanager(ctx context.Context, s *monitoringv1alpha1.AlertmanagerSilence, am *monitoringv1.Alertmanager, st monitoringv1alpha1.SilenceAlertmanagerStatus) (monitoringv1alpha1.SilenceAlertmanagerStatus, error) {
+	st.ObservedGeneration = s.Generation
+	st.Message = ""
+
+	now := sc.now()
+	desired, err := makePostableSilence(s, am, now)
+	if err != nil {
+		// The object needs to be fixed, there's no point in retrying. The
+		// silence created from the previous version of the object doesn't
+		// match the spec anymore and it is expired.
+		st.Message = fmt.Sprintf("invalid silence: %s", err)
+		if err := sc.expireSilence(ctx, st); err != nil {
+			st.Message = fmt.Sprintf("%s, failed to expire the previous silence: %s", st.Message, err)
+			return st, err
+		}
+
+		if st.SilenceID != "" {
+			st.State = monitoringv1alpha1.SilenceExpired
+		}
+		return st, nil
+	}
+
+	ep, err := sc.alertmanagerEndpoint(ctx, am)
+	if err != nil {
+		st.Message = err.Error()
+		return st, err
+	}
+	defer ep.Client.CloseIdleConnections()
+
+	return sc.syncSilence(ctx, ep, s, desired, st, now)
+}
+
+// syncSilence creates, updates or expires the silence on the Alertmanager
+// pod.
+func (sc *silenceController) syncSilence(ctx context.Context, ep webconfig.Endpoint, s *monitoringv1alpha1.AlertmanagerSilence, desired *models.PostableSilence, st monitoringv1alpha1.SilenceAlertmanagerStatus, now time.Time) (monitoringv1alpha1.SilenceAlertmanagerStatus, error) {
+	var (
+		current *models.GettableSilence
+		err     error
+	)
+	if st.SilenceID != "" {
+		current, err = sc.client.GetSilence(ctx, ep, st.SilenceID)
+		if err != nil {
+			st.Message = fmt.Sprintf("failed to get the silence: %s", err)
+			return st, err
+		}
+	}
+
+	active := current != nil && silenceState(current) != monitoringv1alpha1.SilenceExpired
+
+	if !s.Spec.EndsAt.After(now) {
+		if active {
+			if err := sc.client.ExpireSilence(ctx, ep, st.SilenceID); err != nil {
+				st.Message = fmt.Sprintf("failed to expire the silence: %s", err)
+				return st, err
+			}
+			st.LastSyncTime = ptr.To(metav1.NewTime(now))
+		}
+
+		st.State = monitoringv1alpha1.SilenceExpired
+		return st, nil
+	}
+
+	if active {
+		// Alertmanager can only update an active silence in-place if its
+		// start time doesn't change. It also resets the start time of the
+		// silences starting in the past to the creation time.
+		if s.Spec.StartsAt == nil || !s.Spec.StartsAt.After(now) {
+			desired.StartsAt = current.StartsAt
+		}
+
+		if silenceUpToDate(current, desired) {
+			st.State = silenceState(current)
+			return st, nil
+		}
+
+		desired.ID = st.SilenceID
+	}
+
+	id, err := sc.client.PostSilence(ctx, ep, desired)
+	if err != nil {
+		st.Message = fmt.Sprintf("failed to create the silence: %s", err)
+		return st, err
+	}
+
+	st.SilenceID = id
+	st.State = monitoringv1alpha1.SilenceActive
+	if time.Time(*desired.StartsAt).After(now) {
+		st.State = monitoringv1alpha1.SilencePending
+	}
+	st.LastSyncTime = ptr.To(metav1.NewTime(now))
+
+	return st, nil
+}
+
+// expireSilence expires the silence referenced by the status on the
+// Alertmanager instance. Nothing happens if the Alertmanager object doesn't
+// exist anymore or if it has no replica.
+func (sc *silenceController) expireSilence(ctx context.Context, st monitoringv1alpha1.SilenceAlertmanagerStatus) error {
+	if st.SilenceID == "" || st.State == monitoringv1alpha1.SilenceExpired {
+		return nil
+	}
+
+	am, err := operator.GetObjectFromKey[*monitoringv1.Alertmanager](sc.alrtInfs, st.Namespace+"/"+st.Name)
+	if err != nil {
+		return err
+	}
+
+	if am == nil || ptr.Deref(am.Spec.Replicas, 1) == 0 {
+		return nil
+	}
+
+	ep, err := sc.alertmanagerEndpoint(ctx, am)
+	if err != nil {
+		return err
+	}
+	defer ep.Client.CloseIdleConnections()
+
+	current, err := sc.client.GetSilence(ctx, ep, st.SilenceID)
+	if err != nil {
+		return err
+	}
+
+	if current == nil || silenceState(current) == monitoringv1alpha1.SilenceExpired {
+		return nil
+	}
+
+	return sc.client.ExpireSilence(ctx, ep, st.SilenceID)
+}
+
+// alertmanagerEndpoint returns the endpoint of the Alertmanager API for one
+// of the ready pods. The silences are propagated to the other pods by the
+// Alertmanager cluster.
+//
+// When the web server is configured with TLS, the server's certificate must
+// be valid for the pod's DNS name (`<pod>.<governing service>.<namespace>.svc`)
+// unless the server name is set in `spec.web.operatorClientConfig`.
+func (sc *silenceController) alertmanagerEndpoint(ctx context.Context, am *monitoringv1.Alertmanager) (webconfig.Endpoint, error) {
+	if am.Spec.ListenLocal {
+		return webconfig.Endpoint{}, fmt.Errorf("%w: alertmanager listens on localhost only", errAlertmanagerUnreachable)
+	}
+
+	obj, err := sc.ssetInfs.Get(alertmanagerKeyToStatefulSetKey(am.Namespace + "/" + am.Name))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return webconfig.Endpoint{}, fmt.Errorf("%w: %w", errAlertmanagerUnreachable, err)
+		}
+		return webconfig.Endpoint{}, fmt.Errorf("failed to retrieve StatefulSet: %w", err)
+	}
+
+	reporter, err := operator.NewStatefulSetReporter(ctx, sc.kclient, obj.(*appsv1.StatefulSet))
+	if err != nil {
+		return webconfig.Endpoint{}, err
+	}
+
+	var (
+		tlsConfig    *monitoringv1.WebTLSConfig
+		clientConfig *monitoringv1.WebClientConfig
+	)
+	if am.Spec.Web != nil {
+		tlsConfig = am.Spec.Web.TLSConfig
+		clientConfig = am.Spec.Web.OperatorClientConfig
+	}
+
+	routePrefix := "/"
+	if am.Spec.RoutePrefix != "" {
+		routePrefix = am.Spec.RoutePrefix
+	}
+
+	for _, p := range reporter.ReadyPods() {
+		pod := (*v1.Pod)(p)
+		if pod.Status.PodIP == "" {
+			continue
+		}
+
+		ep, err := webconfig.PodEndpoint(ctx, sc.kclient, pod, alertmanagerWebPort, tlsConfig, clientConfig)
+		if err != nil {
+			return webconfig.Endpoint{}, fmt.Errorf("failed to create the HTTP client: %w", err)
+		}
+		ep.URL.Path = routePrefix
+
+		return ep, nil
+	}
+
+	return webconfig.Endpoint{}, fmt.Errorf("%w: no ready alertmanager pod", errAlertmanagerUnreachable)
+}
+
+// makePostableSilence converts the AlertmanagerSilence object to the
+// Alertmanager API model. Depending on the matcher strategy of the
+// Alertmanager, a matcher on the namespace label is added.
+func makePostableSilence(s *monitoringv1alpha1.AlertmanagerSilence, am *monitoringv1.Alertmanager, now time.Time) (*models.PostableSilence, error) {
+	if err := s.Spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	matchers := make(models.Matchers, 0, len(s.Spec.Matchers)+1)
+	for _, m := range s.Spec.Matchers {
+		matchType := m.MatchType
+		if matchType == "" {
+			matchType = monitoringv1alpha1.MatchEqual
+			if m.Regex {
+				matchType = monitoringv1alpha1.MatchRegexp
+			}
+		}
+
+		matchers = append(matchers, &models.Matcher{
+			Name:    ptr.To(m.Name),
+			Value:   ptr.To(m.Value),
+			IsEqual: ptr.To(matchType == monitoringv1alpha1.MatchEqual || matchType == monitoringv1alpha1.MatchRegexp),
+			IsRegex: ptr.To(matchType == monitoringv1alpha1.MatchRegexp || matchType == monitoringv1alpha1.MatchNotRegexp),
+		})
+	}
+
+	switch am.Spec.AlertmanagerConfigMatcherStrategy.Type {
+	case monitoringv1.NoneConfigMatcherStrategyType:
+	case monitoringv1.OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType:
+		if s.Namespace == am.Namespace {
+			break
+		}
+		fallthrough
+	default:
+		matchers = append(matchers, &models.Matcher{
+			Name:    ptr.To("namespace"),
+			Value:   ptr.To(s.Namespace),
+			IsEqual: ptr.To(true),
+			IsRegex: ptr.To(false),
+		})
+	}
+
+	startsAt := now
+	if s.Spec.StartsAt != nil {
+		startsAt = s.Spec.StartsAt.Time
+	}
+
+	return &models.PostableSilence{
+		Silence: models.Silence{
+			Matchers:  matchers,
+			StartsAt:  ptr.To(strfmt.DateTime(startsAt)),
+			EndsAt:    ptr.To(strfmt.DateTime(s.Spec.EndsAt.Time)),
+			Comment:   ptr.To(s.Spec.Comment),
+			CreatedBy: ptr.To(s.Spec.CreatedBy),
+		},
+	}, nil
+}
+
+// silenceUpToDate returns true if the Alertmanager silence matches the
+// desired silence.
+func silenceUpToDate(current *models.GettableSilence, desired *models.PostableSilence) bool {
+	if ptr.Deref(current.Comment, "") != ptr.Deref(desired.Comment, "") ||
+		ptr.Deref(current.CreatedBy, "") != ptr.Deref(desired.CreatedBy, "") {
+		return false
+	}
+
+	for _, t := range []struct {
+		current, desired *strfmt.DateTime
+	}{
+		{current.StartsAt, desired.StartsAt},
+		{current.EndsAt, desired.EndsAt},
+	} {
+		if t.current == nil || !time.Time(*t.current).Equal(time.Time(*t.desired)) {
+			return false
+		}
+	}
+
+	return slices.Equal(matchersKeys(current.Matchers), matchersKeys(desired.Matchers))
+}
+
+func matchersKeys(matchers models.Matchers) []string {
+	keys := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		keys = append(keys, fmt.Sprintf("%q %t %t %q",
+			ptr.Deref(m.Name, ""),
+			ptr.Deref(m.IsEqual, true),
+			ptr.Deref(m.IsRegex, false),
+			ptr.Deref(m.Value, ""),
+		))
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func silenceState(s *models.GettableSilence) monitoringv1alpha1.SilenceState {
+	if s.Status == nil {
+		return ""
+	}
+
+	switch ptr.Deref(s.Status.State, "") {
+	case models.SilenceStatusStateActive:
+		return monitoringv1alpha1.SilenceActive
+	case models.SilenceStatusStatePending:
+		return monitoringv1alpha1.SilencePending
+	default:
+		return monitoringv1alpha1.SilenceExpired
+	}
+}
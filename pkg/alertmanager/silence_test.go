@@ -0,0 +1,340 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+// fakeSilenceClient stores the silences in memory.
+type fakeSilenceClient struct {
+	silences map[string]*models.GettableSilence
+	posted   int
+	expired  int
+	now      time.Time
+}
+
+func newFakeSilenceClient(now time.Time) *fakeSilenceClient {
+	return &fakeSilenceClient{
+		silences: map[string]*models.GettableSilence{},
+		now:      now,
+	}
+}
+
+func (f *fakeSilenceClient) GetSilence(_ context.Context, _ webconfig.Endpoint, id string) (*models.GettableSilence, error) {
+	return f.silences[id], nil
+}
+
+func (f *fakeSilenceClient) PostSilence(_ context.Context, _ webconfig.Endpoint, s *models.PostableSilence) (string, error) {
+	f.posted++
+
+	id := s.ID
+	if id == "" {
+		id = fmt.Sprintf("silence-%d", f.posted)
+	}
+
+	state := models.SilenceStatusStateActive
+	if time.Time(*s.StartsAt).After(f.now) {
+		state = models.SilenceStatusStatePending
+	}
+
+	f.silences[id] = &models.GettableSilence{
+		ID:      ptr.To(id),
+		Status:  &models.SilenceStatus{State: ptr.To(state)},
+		Silence: s.Silence,
+	}
+
+	return id, nil
+}
+
+func (f *fakeSilenceClient) ExpireSilence(_ context.Context, _ webconfig.Endpoint, id string) error {
+	f.expired++
+	f.silences[id].Status.State = ptr.To(models.SilenceStatusStateExpired)
+	return nil
+}
+
+func newSilence(namespace string, endsAt time.Time) *monitoringv1alpha1.AlertmanagerSilence {
+	return &monitoringv1alpha1.AlertmanagerSilence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "maintenance",
+			Namespace:  namespace,
+			Generation: 1,
+			Labels:     map[string]string{"team": "a"},
+		},
+		Spec: monitoringv1alpha1.AlertmanagerSilenceSpec{
+			Matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watchdog"},
+				{Name: "job", Value: "node.*", MatchType: monitoringv1alpha1.MatchRegexp},
+				{Name: "env", Value: "prod", MatchType: monitoringv1alpha1.MatchNotEqual},
+				{Name: "instance", Value: "foo.*", Regex: true},
+			},
+			EndsAt:    metav1.NewTime(endsAt),
+			Comment:   "maintenance",
+			CreatedBy: "ops",
+		},
+	}
+}
+
+func TestMakePostableSilence(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name             string
+		strategy         monitoringv1.AlertmanagerConfigMatcherStrategyType
+		silenceNamespace string
+		namespaceMatcher bool
+	}{
+		{
+			name:             "default strategy",
+			silenceNamespace: "default",
+			namespaceMatcher: true,
+		},
+		{
+			name:             "none strategy",
+			strategy:         monitoringv1.NoneConfigMatcherStrategyType,
+			silenceNamespace: "other",
+		},
+		{
+			name:             "except for alertmanager namespace with same namespace",
+			strategy:         monitoringv1.OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType,
+			silenceNamespace: "default",
+		},
+		{
+			name:             "except for alertmanager namespace with other namespace",
+			strategy:         monitoringv1.OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType,
+			silenceNamespace: "other",
+			namespaceMatcher: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+				Spec: monitoringv1.AlertmanagerSpec{
+					AlertmanagerConfigMatcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{Type: tc.strategy},
+				},
+			}
+
+			ps, err := makePostableSilence(newSilence(tc.silenceNamespace, now.Add(time.Hour)), am, now)
+			require.NoError(t, err)
+
+			expected := []string{
+				`"alertname" true false "Watchdog"`,
+				`"job" true true "node.*"`,
+				`"env" false false "prod"`,
+				`"instance" true true "foo.*"`,
+			}
+			if tc.namespaceMatcher {
+				expected = append(expected, fmt.Sprintf(`"namespace" true false %q`, tc.silenceNamespace))
+			}
+			require.ElementsMatch(t, expected, matchersKeys(ps.Matchers))
+
+			require.Equal(t, now, time.Time(*ps.StartsAt))
+			require.Equal(t, now.Add(time.Hour), time.Time(*ps.EndsAt))
+			require.Equal(t, "maintenance", *ps.Comment)
+			require.Equal(t, "ops", *ps.CreatedBy)
+		})
+	}
+}
+
+func TestMakePostableSilenceInvalid(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s := newSilence("default", now)
+	s.Spec.StartsAt = ptr.To(metav1.NewTime(now.Add(time.Hour)))
+
+	_, err := makePostableSilence(s, &monitoringv1.Alertmanager{}, now)
+	require.Error(t, err)
+
+	s = newSilence("default", now.Add(time.Hour))
+	s.Spec.Matchers[0].Value = "("
+	s.Spec.Matchers[0].MatchType = monitoringv1alpha1.MatchRegexp
+
+	_, err = makePostableSilence(s, &monitoringv1.Alertmanager{}, now)
+	require.Error(t, err)
+}
+
+func TestSelectsSilence(t *testing.T) {
+	ns := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "other",
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name              string
+		selector          *metav1.LabelSelector
+		namespaceSelector *metav1.LabelSelector
+		silenceNamespace  string
+		expected          bool
+	}{
+		{
+			name:             "nil selector",
+			silenceNamespace: "default",
+		},
+		{
+			name:             "same namespace",
+			selector:         &metav1.LabelSelector{},
+			silenceNamespace: "default",
+			expected:         true,
+		},
+		{
+			name:             "other namespace without namespace selector",
+			selector:         &metav1.LabelSelector{},
+			silenceNamespace: "other",
+		},
+		{
+			name:              "matching namespace selector",
+			selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			namespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			silenceNamespace:  "other",
+			expected:          true,
+		},
+		{
+			name:              "non-matching namespace selector",
+			selector:          &metav1.LabelSelector{},
+			namespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			silenceNamespace:  "other",
+		},
+		{
+			name:             "non-matching selector",
+			selector:         &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+			silenceNamespace: "default",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+				Spec: monitoringv1.AlertmanagerSpec{
+					SilenceSelector:          tc.selector,
+					SilenceNamespaceSelector: tc.namespaceSelector,
+				},
+			}
+
+			ok, err := selectsSilence(am, ns, newSilence(tc.silenceNamespace, time.Now()))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ok)
+		})
+	}
+}
+
+func TestSyncSilence(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeSilenceClient(now)
+	sc := &silenceController{client: fc}
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"},
+	}
+
+	sync := func(s *monitoringv1alpha1.AlertmanagerSilence, st monitoringv1alpha1.SilenceAlertmanagerStatus, now time.Time) monitoringv1alpha1.SilenceAlertmanagerStatus {
+		t.Helper()
+
+		desired, err := makePostableSilence(s, am, now)
+		require.NoError(t, err)
+
+		st, err = sc.syncSilence(context.Background(), webconfig.Endpoint{}, s, desired, st, now)
+		require.NoError(t, err)
+
+		return st
+	}
+
+	// Create the silence.
+	s := newSilence("default", now.Add(time.Hour))
+	st := sync(s, monitoringv1alpha1.SilenceAlertmanagerStatus{Namespace: "default", Name: "main"}, now)
+	require.Equal(t, "silence-1", st.SilenceID)
+	require.Equal(t, monitoringv1alpha1.SilenceActive, st.State)
+	require.Equal(t, 1, fc.posted)
+
+	// Nothing changes.
+	st = sync(s, st, now.Add(time.Minute))
+	require.Equal(t, "silence-1", st.SilenceID)
+	require.Equal(t, 1, fc.posted)
+
+	// Extend the silence.
+	s.Spec.EndsAt = metav1.NewTime(now.Add(2 * time.Hour))
+	st = sync(s, st, now.Add(2*time.Minute))
+	require.Equal(t, "silence-1", st.SilenceID)
+	require.Equal(t, 2, fc.posted)
+	require.Equal(t, now, time.Time(*fc.silences["silence-1"].StartsAt))
+	require.Equal(t, now.Add(2*time.Hour), time.Time(*fc.silences["silence-1"].EndsAt))
+
+	// The silence has been expired outside of the operator.
+	fc.silences["silence-1"].Status.State = ptr.To(models.SilenceStatusStateExpired)
+	st = sync(s, st, now.Add(3*time.Minute))
+	require.Equal(t, "silence-3", st.SilenceID)
+	require.Equal(t, monitoringv1alpha1.SilenceActive, st.State)
+
+	// End the silence.
+	s.Spec.EndsAt = metav1.NewTime(now.Add(4 * time.Minute))
+	st = sync(s, st, now.Add(5*time.Minute))
+	require.Equal(t, "silence-3", st.SilenceID)
+	require.Equal(t, monitoringv1alpha1.SilenceExpired, st.State)
+	require.Equal(t, 1, fc.expired)
+	require.Equal(t, 3, fc.posted)
+}
+
+func TestSyncSilencePending(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fc := newFakeSilenceClient(now)
+	sc := &silenceController{client: fc}
+
+	s := newSilence("default", now.Add(2*time.Hour))
+	s.Spec.StartsAt = ptr.To(metav1.NewTime(now.Add(time.Hour)))
+
+	desired, err := makePostableSilence(s, &monitoringv1.Alertmanager{}, now)
+	require.NoError(t, err)
+
+	st, err := sc.syncSilence(context.Background(), webconfig.Endpoint{}, s, desired, monitoringv1alpha1.SilenceAlertmanagerStatus{}, now)
+	require.NoError(t, err)
+	require.Equal(t, monitoringv1alpha1.SilencePending, st.State)
+	require.Equal(t, strfmt.DateTime(now.Add(time.Hour)), *fc.silences[st.SilenceID].StartsAt)
+}
+
+func TestHTTPSilenceClientUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	ep := webconfig.Endpoint{URL: *u, Client: srv.Client()}
+
+	// The API returns an error.
+	_, err = NewHTTPSilenceClient().GetSilence(context.Background(), ep, "1")
+	require.Error(t, err)
+	require.False(t, errors.Is(err, errAlertmanagerUnreachable))
+
+	// The server doesn't respond.
+	srv.Close()
+	_, err = NewHTTPSilenceClient().GetSilence(context.Background(), ep, "1")
+	require.ErrorIs(t, err, errAlertmanagerUnreachable)
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/prometheus/alertmanager/template"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const selectingAlertmanagerTemplateResourcesAction = "SelectingAlertmanagerTemplateResources"
+
+// WithTemplates tells that the controller should watch the
+// AlertmanagerTemplate objects.
+func WithTemplates() ControllerOption {
+	return func(o *Operator) {
+		o.templatesEnabled = true
+	}
+}
+
+// validateTemplate returns an error if the template can't be parsed by
+// Alertmanager.
+func validateTemplate(text string) error {
+	tmpl, err := template.New()
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Parse(strings.NewReader(text))
+}
+
+// templateSecretKey returns the key of the template in the generated
+// configuration secret.
+func templateSecretKey(t *monitoringv1alpha1.AlertmanagerTemplate) string {
+	return fmt.Sprintf("template_%s_%s.tmpl", t.Namespace, t.Name)
+}
+
+// addTemplates adds the valid templates to the secret's data and references
+// them from the configuration.
+func addTemplates(cfgBuilder *ConfigBuilder, data map[string][]byte, templates map[string]*monitoringv1alpha1.AlertmanagerTemplate) {
+	// Sort the templates to generate a stable configuration.
+	for _, k := range sortutil.SortedKeys(templates) {
+		key := templateSecretKey(templates[k])
+		data[key] = []byte(templates[k].Spec.Template)
+		cfgBuilder.cfg.Templates = append(cfgBuilder.cfg.Templates, path.Join(alertmanagerConfigDir, key))
+	}
+}
+
+// selectTemplates returns the AlertmanagerTemplate objects selected by the
+// Alertmanager with their validation status.
+func (c *Operator) selectTemplates(am *monitoringv1.Alertmanager) (prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerTemplate], error) {
+	res := prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerTemplate]{}
+	if c.tmplInfs == nil || am.Spec.TemplateSelector == nil {
+		return res, nil
+	}
+
+	namespaces := []string{}
+
+	// If 'TemplateNamespaceSelector' is nil, only check own namespace.
+	if am.Spec.TemplateNamespaceSelector == nil {
+		namespaces = append(namespaces, am.Namespace)
+	} else {
+		nsSelector, err := metav1.LabelSelectorAsSelector(am.Spec.TemplateNamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid templateNamespaceSelector: %w", err)
+		}
+
+		err = cache.ListAll(c.nsAlrtCfgInf.GetStore(), nsSelector, func(obj any) {
+			namespaces = append(namespaces, obj.(*v1.Namespace).Name)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.TemplateSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid templateSelector: %w", err)
+	}
+
+	var (
+		rejected      int
+		eventRecorder = c.newEventRecorder(am)
+	)
+	for _, ns := range namespaces {
+		err := c.tmplInfs.ListAllByNamespace(ns, selector, func(obj any) {
+			k, ok := c.accessor.MetaNamespaceKey(obj)
+			if !ok {
+				return
+			}
+
+			t := obj.(*monitoringv1alpha1.AlertmanagerTemplate).DeepCopy()
+			if err := k8sutil.AddTypeInformationToObject(t); err != nil {
+				c.logger.Error("failed to set type information", "alertmanagertemplate", k, "err", err)
+				return
+			}
+
+			err := validateTemplate(t.Spec.Template)
+			if err != nil {
+				rejected++
+				c.logger.Warn(
+					"skipping alertmanagertemplate",
+					"error", err.Error(),
+					"alertmanagertemplate", k,
+					"namespace", am.Namespace,
+					"alertmanager", am.Name,
+				)
+				eventRecorder.Eventf(t, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingAlertmanagerTemplateResourcesAction, "AlertmanagerTemplate %s was rejected due to invalid template: %v", t.GetName(), err)
+			}
+
+			res[k] = prompkg.NewTypedConfigurationResource(t, err)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alertmanager templates in namespace %s: %w", ns, err)
+		}
+	}
+
+	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerTemplatesKind, len(res)-rejected)
+		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.AlertmanagerTemplatesKind, rejected)
+	}
+
+	return res, nil
+}
+
+// updateTemplatesStatus updates the status of the selected
+// AlertmanagerTemplate resources.
+func (c *Operator) updateTemplatesStatus(ctx context.Context, am *monitoringv1.Alertmanager, templates prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerTemplate]) error {
+	// Contrary to the other configuration resources, the status is updated
+	// regardless of the StatusForConfigurationResources feature gate since
+	// it's the only place where the parse errors are reported.
+	if c.tmplInfs == nil {
+		return nil
+	}
+
+	var configResourceSyncer = prompkg.NewConfigResourceSyncer(am, c.dclient, c.accessor)
+
+	for key, configResource := range templates {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update AlertmanagerTemplate %s status: %w", key, err)
+		}
+	}
+
+	// Remove bindings from alertmanagerTemplates which reference the
+	// workload but aren't selected anymore.
+	if err := prompkg.CleanupBindings(ctx, c.tmplInfs.ListAll, templates, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for alertmanagerTemplates: %w", err)
+	}
+
+	return nil
+}
+
+// enqueueForTemplates enqueues all Alertmanager objects selecting
+// AlertmanagerTemplate objects.
+func (c *Operator) enqueueForTemplates(_ string) {
+	err := c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if am.Spec.TemplateSelector != nil {
+			c.rr.EnqueueForReconciliation(am)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
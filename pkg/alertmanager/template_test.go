@@ -0,0 +1,271 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		template string
+		ok       bool
+	}{
+		{
+			name:     "valid",
+			template: `{{ define "slack.text" }}{{ range .Alerts }}{{ .Annotations.summary | toUpper }}{{ end }}{{ end }}`,
+			ok:       true,
+		},
+		{
+			name:     "reference to a default template",
+			template: `{{ define "slack.title" }}{{ template "__subject" . }}{{ end }}`,
+			ok:       true,
+		},
+		{
+			name:     "alertmanager functions",
+			template: `{{ define "x" }}{{ .CommonLabels.SortedPairs.Values | join "," | safeHtml }}{{ reReplaceAll "a" "b" .GroupKey }}{{ end }}`,
+			ok:       true,
+		},
+		{
+			name:     "unclosed action",
+			template: `{{ define "slack.text" }}{{ .Status }`,
+		},
+		{
+			name:     "missing end",
+			template: `{{ define "slack.text" }}{{ if .Alerts }}foo{{ end }}`,
+		},
+		{
+			name:     "unknown function",
+			template: `{{ define "slack.text" }}{{ .Status | humanize }}{{ end }}`,
+		},
+		{
+			name:     "function not supported by Alertmanager",
+			template: `{{ define "slack.text" }}{{ .CommonLabels | toJson }}{{ end }}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTemplate(tc.template)
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSelectTemplates(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			TemplateSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"group": "a"},
+			},
+		},
+	}
+
+	newTemplate := func(ns, name, group, text string) *monitoringv1alpha1.AlertmanagerTemplate {
+		return &monitoringv1alpha1.AlertmanagerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  ns,
+				Generation: 2,
+				Labels:     map[string]string{"group": group},
+			},
+			Spec: monitoringv1alpha1.AlertmanagerTemplateSpec{
+				Template: text,
+			},
+		}
+	}
+
+	c := fake.NewSimpleClientset()
+	o := &Operator{
+		kclient: c,
+		mclient: monitoringfake.NewSimpleClientset(
+			newTemplate("test", "valid", "a", `{{ define "a" }}a{{ end }}`),
+			newTemplate("test", "invalid", "a", `{{ define "a" }}`),
+			newTemplate("test", "not-selected", "b", `{{ define "b" }}b{{ end }}`),
+			newTemplate("other", "other-namespace", "a", `{{ define "c" }}c{{ end }}`),
+		),
+		ssarClient:       &alwaysAllowed{},
+		logger:           slog.New(slog.DiscardHandler),
+		metrics:          operator.NewMetrics(prometheus.NewRegistry()),
+		newEventRecorder: func(related runtime.Object) *operator.EventRecorder { return operator.NewFakeRecorder(1, related) },
+		templatesEnabled: true,
+	}
+
+	err := o.bootstrap(
+		context.Background(),
+		operator.Config{
+			Namespaces: operator.Namespaces{
+				AlertmanagerConfigAllowList: map[string]struct{}{
+					v1.NamespaceAll: {},
+				},
+				AlertmanagerAllowList: map[string]struct{}{
+					v1.NamespaceAll: {},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	o.tmplInfs.Start(ctx.Done())
+	require.Eventually(t, o.tmplInfs.HasSynced, time.Minute, 10*time.Millisecond)
+
+	templates, err := o.selectTemplates(am)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+
+	valid := templates.ValidResources()
+	require.Len(t, valid, 1)
+	require.Contains(t, valid, "test/valid")
+
+	for k, tc := range map[string]struct {
+		status monitoringv1.ConditionStatus
+		reason string
+	}{
+		"test/valid":   {status: monitoringv1.ConditionTrue},
+		"test/invalid": {status: monitoringv1.ConditionFalse, reason: "InvalidConfiguration"},
+	} {
+		res := templates[k]
+		require.Equal(t, monitoringv1alpha1.AlertmanagerTemplatesKind, res.Resource().Kind)
+
+		conditions := res.Conditions()
+		require.Len(t, conditions, 1)
+		require.Equal(t, monitoringv1.Accepted, conditions[0].Type)
+		require.Equal(t, tc.status, conditions[0].Status)
+		require.Equal(t, tc.reason, conditions[0].Reason)
+		require.Equal(t, int64(2), conditions[0].ObservedGeneration)
+	}
+
+	cb := NewConfigBuilder(
+		newNopLogger(t),
+		semver.MustParse("0.28.0"),
+		assets.NewStoreBuilder(c.CoreV1(), c.CoreV1()),
+		am,
+	)
+	require.NoError(t, cb.InitializeFromRawConfiguration([]byte(`{route: {receiver: empty}, receivers: [{name: empty}]}`)))
+
+	data := map[string][]byte{}
+	addTemplates(cb, data, valid)
+	require.Equal(t, map[string][]byte{"template_test_valid.tmpl": []byte(`{{ define "a" }}a{{ end }}`)}, data)
+	require.Equal(t, []string{"/etc/alertmanager/config/template_test_valid.tmpl"}, cb.cfg.Templates)
+}
+
+func TestUpdateTemplatesStatusWithoutFeatureGate(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.AlertmanagersKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+	}
+
+	tmpl := &monitoringv1alpha1.AlertmanagerTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.AlertmanagerTemplatesKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "invalid",
+			Namespace:  "test",
+			Generation: 1,
+		},
+		Spec: monitoringv1alpha1.AlertmanagerTemplateSpec{
+			Template: `{{ define "a" }}`,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, monitoringv1alpha1.AddToScheme(scheme))
+
+	logger := slog.New(slog.DiscardHandler)
+	o := &Operator{
+		kclient:          fake.NewSimpleClientset(),
+		mclient:          monitoringfake.NewSimpleClientset(tmpl),
+		dclient:          dynamicfake.NewSimpleDynamicClient(scheme, tmpl),
+		ssarClient:       &alwaysAllowed{},
+		logger:           logger,
+		accessor:         operator.NewAccessor(logger),
+		metrics:          operator.NewMetrics(prometheus.NewRegistry()),
+		templatesEnabled: true,
+	}
+
+	err := o.bootstrap(
+		context.Background(),
+		operator.Config{
+			Namespaces: operator.Namespaces{
+				AlertmanagerConfigAllowList: map[string]struct{}{
+					v1.NamespaceAll: {},
+				},
+				AlertmanagerAllowList: map[string]struct{}{
+					v1.NamespaceAll: {},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.False(t, o.configResourcesStatusEnabled)
+
+	templates := prompkg.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerTemplate]{
+		"test/invalid": prompkg.NewTypedConfigurationResource(tmpl, validateTemplate(tmpl.Spec.Template)),
+	}
+	require.NoError(t, o.updateTemplatesStatus(context.Background(), am, templates))
+
+	obj, err := o.dclient.Resource(monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerTemplateName)).
+		Namespace("test").
+		Get(context.Background(), "invalid", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	bindings, found, err := unstructured.NestedSlice(obj.Object, "status", "bindings")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Len(t, bindings, 1)
+
+	conditions := bindings[0].(map[string]any)["conditions"].([]any)
+	require.Len(t, conditions, 1)
+	require.Equal(t, "False", conditions[0].(map[string]any)["status"])
+	require.NotEmpty(t, conditions[0].(map[string]any)["message"])
+}
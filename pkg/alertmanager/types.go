@@ -119,6 +119,7 @@ type receiver struct {
 	MSTeamsV2Configs  []*msTeamsV2Config  `yaml:"msteamsv2_configs,omitempty"`
 	JiraConfigs       []*jiraConfig       `yaml:"jira_configs,omitempty"`
 	RocketChatConfigs []*rocketChatConfig `yaml:"rocketchat_configs,omitempty"`
+	IncidentIOConfigs []*incidentioConfig `yaml:"incidentio_configs,omitempty"`
 }
 
 type webhookConfig struct {
@@ -445,6 +446,17 @@ type jiraConfig struct {
 	Fields            map[string]any    `yaml:"fields,omitempty"`
 }
 
+type incidentioConfig struct {
+	SendResolved         *bool             `yaml:"send_resolved,omitempty"`
+	HTTPConfig           *httpClientConfig `yaml:"http_config,omitempty"`
+	URL                  string            `yaml:"url,omitempty"`
+	URLFile              string            `yaml:"url_file,omitempty"`
+	AlertSourceToken     string            `yaml:"alert_source_token,omitempty"`
+	AlertSourceTokenFile string            `yaml:"alert_source_token_file,omitempty"`
+	MaxAlerts            int32             `yaml:"max_alerts,omitempty"`
+	Timeout              *model.Duration   `yaml:"timeout,omitempty"`
+}
+
 type rocketchatAttachmentField struct {
 	Short *bool  `yaml:"short"`
 	Title string `yaml:"title,omitempty"`
@@ -45,6 +45,17 @@ func ValidateAlertmanagerConfig(amc *monitoringv1alpha1.AlertmanagerConfig) erro
 	return validateRoute(amc.Spec.Route, receivers, muteTimeIntervals, true)
 }
 
+// ValidateAlertmanagerReceiver checks that the given resource complies with
+// the semantics of the Alertmanager configuration.
+func ValidateAlertmanagerReceiver(r *monitoringv1alpha1.AlertmanagerReceiver) error {
+	if r.Spec.Name != r.Name {
+		return fmt.Errorf("receiver name %q must be equal to the object's name", r.Spec.Name)
+	}
+
+	_, err := validateReceivers([]monitoringv1alpha1.Receiver{r.Spec})
+	return err
+}
+
 func validateReceivers(receivers []monitoringv1alpha1.Receiver) (map[string]struct{}, error) {
 	var err error
 	receiverNames := make(map[string]struct{})
@@ -115,6 +126,14 @@ func validateReceivers(receivers []monitoringv1alpha1.Receiver) (map[string]stru
 			return nil, fmt.Errorf("failed to validate 'msteamsv2Config' - receiver %s: %w", receiver.Name, err)
 		}
 
+		if err := validateJiraConfigs(receiver.JiraConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'jiraConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateIncidentIOConfigs(receiver.IncidentIOConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'incidentioConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
 	}
 
 	return receiverNames, nil
@@ -379,6 +398,42 @@ func validateMSTeamsV2Configs(configs []monitoringv1alpha1.MSTeamsV2Config) erro
 	return nil
 }
 
+func validateJiraConfigs(configs []monitoringv1alpha1.JiraConfig) error {
+	for _, config := range configs {
+		if config.APIURL != nil && *config.APIURL != "" {
+			if _, err := validation.ValidateURL(string(*config.APIURL)); err != nil {
+				return fmt.Errorf("invalid 'apiURL': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateIncidentIOConfigs(configs []monitoringv1alpha1.IncidentIOConfig) error {
+	for _, config := range configs {
+		if config.URL == nil && config.URLSecret == nil {
+			return errors.New("one of 'url' or 'urlSecret' must be specified")
+		}
+		if config.URL != nil && config.URLSecret != nil {
+			return errors.New("at most one of 'url' or 'urlSecret' must be specified")
+		}
+		if config.URL != nil {
+			if _, err := validation.ValidateURL(string(*config.URL)); err != nil {
+				return fmt.Errorf("invalid 'url': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateRoute verifies that the given route and all its children are
 // semantically valid.  because of the self-referential issues mentioned in
 // https://github.com/kubernetes/kubernetes/issues/62872 it is not currently
@@ -388,7 +443,15 @@ func validateRoute(r *monitoringv1alpha1.Route, receivers, muteTimeIntervals map
 		return nil
 	}
 
-	if r.Receiver == "" {
+	if r.SharedReceiver != "" {
+		if r.Receiver != "" {
+			return errors.New("'receiver' and 'sharedReceiver' are mutually exclusive")
+		}
+
+		if _, _, err := validation.ParseSharedReceiverReference(r.SharedReceiver); err != nil {
+			return err
+		}
+	} else if r.Receiver == "" {
 		if topLevelRoute {
 			return errors.New("root route must define a receiver")
 		}
@@ -412,13 +475,13 @@ func validateRoute(r *monitoringv1alpha1.Route, receivers, muteTimeIntervals map
 	}
 
 	for _, namedMuteTimeInterval := range r.MuteTimeIntervals {
-		if _, found := muteTimeIntervals[namedMuteTimeInterval]; !found {
+		if _, found := muteTimeIntervals[namedMuteTimeInterval]; !found && !validation.IsTimeIntervalReference(namedMuteTimeInterval) {
 			return fmt.Errorf("mute time interval %q not found", namedMuteTimeInterval)
 		}
 	}
 
 	for _, namedActiveTimeInterval := range r.ActiveTimeIntervals {
-		if _, found := muteTimeIntervals[namedActiveTimeInterval]; !found {
+		if _, found := muteTimeIntervals[namedActiveTimeInterval]; !found && !validation.IsTimeIntervalReference(namedActiveTimeInterval) {
 			return fmt.Errorf("time interval %q not found", namedActiveTimeInterval)
 		}
 	}
@@ -0,0 +1,150 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestValidateAlertmanagerConfigSharedReceiver(t *testing.T) {
+	testCases := []struct {
+		name      string
+		in        *monitoringv1alpha1.AlertmanagerConfig
+		expectErr bool
+	}{
+		{
+			name: "Test fail to validate routes with both receiver and sharedReceiver",
+			in: &monitoringv1alpha1.AlertmanagerConfig{
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1alpha1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1alpha1.Route{
+						Receiver:       "same",
+						SharedReceiver: "platform/oncall",
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate route with sharedReceiver without namespace",
+			in: &monitoringv1alpha1.AlertmanagerConfig{
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						SharedReceiver: "oncall",
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate child route with invalid sharedReceiver",
+			in: &monitoringv1alpha1.AlertmanagerConfig{
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						SharedReceiver: "platform/oncall",
+						Routes: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"sharedReceiver":"platform/team/oncall"}`)},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test validate route with sharedReceiver",
+			in: &monitoringv1alpha1.AlertmanagerConfig{
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						SharedReceiver: "platform/oncall",
+						Routes: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"sharedReceiver":"platform/tickets"}`)},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAlertmanagerConfig(tc.in)
+			if tc.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+
+			if err != nil {
+				if tc.expectErr {
+					return
+				}
+				t.Errorf("got error but expected none -%s", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateAlertmanagerReceiver(t *testing.T) {
+	testCases := []struct {
+		name      string
+		in        *monitoringv1alpha1.AlertmanagerReceiver
+		expectErr bool
+	}{
+		{
+			name: "Test fail to validate receiver with a name different from the object's name",
+			in: &monitoringv1alpha1.AlertmanagerReceiver{
+				ObjectMeta: metav1.ObjectMeta{Name: "oncall", Namespace: "platform"},
+				Spec: monitoringv1alpha1.Receiver{
+					Name: "other",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test happy path",
+			in: &monitoringv1alpha1.AlertmanagerReceiver{
+				ObjectMeta: metav1.ObjectMeta{Name: "oncall", Namespace: "platform"},
+				Spec: monitoringv1alpha1.Receiver{
+					Name: "oncall",
+				},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAlertmanagerReceiver(tc.in)
+			if tc.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+
+			if err != nil {
+				if tc.expectErr {
+					return
+				}
+				t.Errorf("got error but expected none -%s", err.Error())
+			}
+		})
+	}
+}
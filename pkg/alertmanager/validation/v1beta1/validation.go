@@ -115,6 +115,14 @@ func validateReceivers(receivers []monitoringv1beta1.Receiver) (map[string]struc
 			return nil, fmt.Errorf("failed to validate 'msteamsv2Config' - receiver %s: %w", receiver.Name, err)
 		}
 
+		if err := validateJiraConfigs(receiver.JiraConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'jiraConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateIncidentIOConfigs(receiver.IncidentIOConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'incidentioConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
 	}
 
 	return receiverNames, nil
@@ -381,6 +389,42 @@ func validateMSTeamsV2Configs(configs []monitoringv1beta1.MSTeamsV2Config) error
 	return nil
 }
 
+func validateJiraConfigs(configs []monitoringv1beta1.JiraConfig) error {
+	for _, config := range configs {
+		if config.APIURL != nil && *config.APIURL != "" {
+			if _, err := validation.ValidateURL(string(*config.APIURL)); err != nil {
+				return fmt.Errorf("invalid 'apiURL': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateIncidentIOConfigs(configs []monitoringv1beta1.IncidentIOConfig) error {
+	for _, config := range configs {
+		if config.URL == nil && config.URLSecret == nil {
+			return errors.New("one of 'url' or 'urlSecret' must be specified")
+		}
+		if config.URL != nil && config.URLSecret != nil {
+			return errors.New("at most one of 'url' or 'urlSecret' must be specified")
+		}
+		if config.URL != nil {
+			if _, err := validation.ValidateURL(string(*config.URL)); err != nil {
+				return fmt.Errorf("invalid 'url': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateRoute verifies that the given route and all its children are
 // semantically valid.  because of the self-referential issues mentioned in
 // https://github.com/kubernetes/kubernetes/issues/62872 it is not currently
@@ -390,7 +434,15 @@ func validateRoute(r *monitoringv1beta1.Route, receivers, timeIntervals map[stri
 		return nil
 	}
 
-	if r.Receiver == "" {
+	if r.SharedReceiver != "" {
+		if r.Receiver != "" {
+			return errors.New("'receiver' and 'sharedReceiver' are mutually exclusive")
+		}
+
+		if _, _, err := validation.ParseSharedReceiverReference(r.SharedReceiver); err != nil {
+			return err
+		}
+	} else if r.Receiver == "" {
 		if topLevelRoute {
 			return fmt.Errorf("root route must define a receiver")
 		}
@@ -414,13 +466,13 @@ func validateRoute(r *monitoringv1beta1.Route, receivers, timeIntervals map[stri
 	}
 
 	for _, namedTimeInterval := range r.MuteTimeIntervals {
-		if _, found := timeIntervals[namedTimeInterval]; !found {
+		if _, found := timeIntervals[namedTimeInterval]; !found && !validation.IsTimeIntervalReference(namedTimeInterval) {
 			return fmt.Errorf("time interval %q not found", namedTimeInterval)
 		}
 	}
 
 	for _, namedTimeInterval := range r.ActiveTimeIntervals {
-		if _, found := timeIntervals[namedTimeInterval]; !found {
+		if _, found := timeIntervals[namedTimeInterval]; !found && !validation.IsTimeIntervalReference(namedTimeInterval) {
 			return fmt.Errorf("time interval %q not found", namedTimeInterval)
 		}
 	}
@@ -120,6 +120,73 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Test fail to validate jira config - invalid API URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+						{
+							Name: "different",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									APIURL:    ptr.To(monitoringv1beta1.URL("http://%><invalid.com")),
+									Project:   "OPS",
+									IssueType: "Bug",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate incidentio config - missing required fields",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+						{
+							Name: "different",
+							IncidentIOConfigs: []monitoringv1beta1.IncidentIOConfig{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate incidentio config - both url and urlSecret",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+						{
+							Name: "different",
+							IncidentIOConfigs: []monitoringv1beta1.IncidentIOConfig{
+								{
+									URL: ptr.To(monitoringv1beta1.URL("https://api.incident.io/v2/alert_events/http/ID")),
+									URLSecret: &monitoringv1beta1.SecretKeySelector{
+										Name: "incidentio",
+										Key:  "url",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "Test fail to validate wechat config - invalid URL",
 			in: &monitoringv1beta1.AlertmanagerConfig{
@@ -412,6 +479,45 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Test fail to validate routes with both receiver and sharedReceiver",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "same",
+						},
+					},
+					Route: &monitoringv1beta1.Route{
+						Receiver:       "same",
+						SharedReceiver: "platform/oncall",
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate route with sharedReceiver without namespace",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Route: &monitoringv1beta1.Route{
+						SharedReceiver: "oncall",
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test validate route with sharedReceiver",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Route: &monitoringv1beta1.Route{
+						SharedReceiver: "platform/oncall",
+					},
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "Test happy path",
 			in: &monitoringv1beta1.AlertmanagerConfig{
@@ -17,6 +17,7 @@ package validation
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/prometheus/alertmanager/config"
 )
@@ -47,3 +48,31 @@ func ValidateSecretURL(url string) error {
 
 	return nil
 }
+
+// IsTimeIntervalReference returns true if the name references a time interval
+// exported by an AlertmanagerConfig object (`<namespace>/<alertmanagerconfig>/<name>`).
+func IsTimeIntervalReference(name string) bool {
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 {
+		return false
+	}
+
+	for _, p := range parts {
+		if p == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseSharedReceiverReference returns the namespace and name of the
+// AlertmanagerReceiver object referenced as `<namespace>/<name>`.
+func ParseSharedReceiverReference(ref string) (string, string, error) {
+	namespace, name, found := strings.Cut(ref, "/")
+	if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("invalid shared receiver reference %q: expected <namespace>/<name>", ref)
+	}
+
+	return namespace, name, nil
+}
@@ -102,3 +102,41 @@ func TestValidateSecretUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTimeIntervalReference(t *testing.T) {
+	for in, expected := range map[string]bool{
+		"weekend":                       false,
+		"platform/weekend":              false,
+		"platform/shared/weekend":       true,
+		"platform//weekend":             false,
+		"/shared/weekend":               false,
+		"platform/shared/weekend/extra": false,
+	} {
+		t.Run(in, func(t *testing.T) {
+			require.Equal(t, expected, IsTimeIntervalReference(in))
+		})
+	}
+}
+
+func TestParseSharedReceiverReference(t *testing.T) {
+	for in, expectErr := range map[string]bool{
+		"platform/oncall":      false,
+		"oncall":               true,
+		"platform/":            true,
+		"/oncall":              true,
+		"platform/team/oncall": true,
+		"platform//oncall":     true,
+	} {
+		t.Run(in, func(t *testing.T) {
+			namespace, name, err := ParseSharedReceiverReference(in)
+			if expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "platform", namespace)
+			require.Equal(t, "oncall", name)
+		})
+	}
+}
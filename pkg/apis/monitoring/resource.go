@@ -31,6 +31,9 @@ const (
 	AlertmanagerConfigsKind = "AlertmanagerConfig"
 	AlertmanagerConfigName  = "alertmanagerconfigs"
 
+	AlertmanagerTemplatesKind = "AlertmanagerTemplate"
+	AlertmanagerTemplateName  = "alertmanagertemplates"
+
 	ServiceMonitorsKind = "ServiceMonitor"
 	ServiceMonitorName  = "servicemonitors"
 
@@ -51,29 +54,31 @@ const (
 )
 
 var resourceToKindMap = map[string]string{
-	PrometheusName:         PrometheusesKind,
-	PrometheusAgentName:    PrometheusAgentsKind,
-	AlertmanagerName:       AlertmanagersKind,
-	AlertmanagerConfigName: AlertmanagerConfigsKind,
-	ServiceMonitorName:     ServiceMonitorsKind,
-	PodMonitorName:         PodMonitorsKind,
-	PrometheusRuleName:     PrometheusRuleKind,
-	ProbeName:              ProbesKind,
-	ScrapeConfigName:       ScrapeConfigsKind,
-	ThanosRulerName:        ThanosRulersKind,
+	PrometheusName:           PrometheusesKind,
+	PrometheusAgentName:      PrometheusAgentsKind,
+	AlertmanagerName:         AlertmanagersKind,
+	AlertmanagerConfigName:   AlertmanagerConfigsKind,
+	AlertmanagerTemplateName: AlertmanagerTemplatesKind,
+	ServiceMonitorName:       ServiceMonitorsKind,
+	PodMonitorName:           PodMonitorsKind,
+	PrometheusRuleName:       PrometheusRuleKind,
+	ProbeName:                ProbesKind,
+	ScrapeConfigName:         ScrapeConfigsKind,
+	ThanosRulerName:          ThanosRulersKind,
 }
 
 var kindToResource = map[string]string{
-	PrometheusesKind:        PrometheusName,
-	PrometheusAgentsKind:    PrometheusAgentName,
-	AlertmanagersKind:       AlertmanagerName,
-	AlertmanagerConfigsKind: AlertmanagerConfigName,
-	ServiceMonitorsKind:     ServiceMonitorName,
-	PodMonitorsKind:         PodMonitorName,
-	PrometheusRuleKind:      PrometheusRuleName,
-	ProbesKind:              ProbeName,
-	ScrapeConfigsKind:       ScrapeConfigName,
-	ThanosRulersKind:        ThanosRulerName,
+	PrometheusesKind:          PrometheusName,
+	PrometheusAgentsKind:      PrometheusAgentName,
+	AlertmanagersKind:         AlertmanagerName,
+	AlertmanagerConfigsKind:   AlertmanagerConfigName,
+	AlertmanagerTemplatesKind: AlertmanagerTemplateName,
+	ServiceMonitorsKind:       ServiceMonitorName,
+	PodMonitorsKind:           PodMonitorName,
+	PrometheusRuleKind:        PrometheusRuleName,
+	ProbesKind:                ProbeName,
+	ScrapeConfigsKind:         ScrapeConfigName,
+	ThanosRulersKind:          ThanosRulerName,
 }
 
 // KindToResource returns the resource name corresponding to the given kind.
@@ -273,6 +273,19 @@ type AlertmanagerSpec struct {
 	// additionalPeers allows injecting a set of additional Alertmanagers to peer with to form a highly available cluster.
 	// +optional
 	AdditionalPeers []string `json:"additionalPeers,omitempty"`
+	// peerDiscovery defines how the operator discovers additional
+	// Alertmanagers to peer with (for instance running in another namespace
+	// or another cluster). The discovered addresses are added to the
+	// `--cluster.peer` arguments, in addition to `additionalPeers`. The
+	// `clusterTLS` configuration applies to all the peers.
+	//
+	// A Service is added as its DNS name which Alertmanager resolves at
+	// runtime. The DNS SRV records are resolved by the operator on every
+	// reconciliation (at least every 5 minutes) and the pods are rolled out
+	// when the list of targets changes.
+	// +listType=atomic
+	// +optional
+	PeerDiscovery []AlertmanagerPeerDiscovery `json:"peerDiscovery,omitempty"`
 	// clusterAdvertiseAddress defines the explicit address to advertise in cluster.
 	// Needs to be provided for non RFC1918 [1] (public) addresses.
 	// [1] RFC1918: https://tools.ietf.org/html/rfc1918
@@ -313,6 +326,66 @@ type AlertmanagerSpec struct {
 	// +optional
 	AlertmanagerConfigMatcherStrategy AlertmanagerConfigMatcherStrategy `json:"alertmanagerConfigMatcherStrategy,omitempty"`
 
+	// alertmanagerConfigRateLimits defines the maximum notification rates
+	// for the receivers defined by AlertmanagerConfig and
+	// AlertmanagerReceiver objects.
+	//
+	// The limits are enforced by raising the `group_interval` and
+	// `repeat_interval` values of the generated routes. A receiver can
+	// declare a stricter limit but not a looser one.
+	// +optional
+	AlertmanagerConfigRateLimits *AlertmanagerConfigRateLimits `json:"alertmanagerConfigRateLimits,omitempty"`
+
+	// alertmanagerConfigSharingNamespaces defines the namespaces whose
+	// AlertmanagerConfig objects are allowed to declare cluster-wide
+	// inhibition rules (`clusterWide: true`) and to export time intervals
+	// (`exported: true`) which can be referenced by the AlertmanagerConfig
+	// objects from other namespaces.
+	//
+	// AlertmanagerConfig objects from other namespaces using these features
+	// are rejected. If empty, no namespace is allowed.
+	// +listType=set
+	// +optional
+	AlertmanagerConfigSharingNamespaces []string `json:"alertmanagerConfigSharingNamespaces,omitempty"`
+
+	// alertmanagerReceiverNamespaces defines the namespaces of the
+	// AlertmanagerReceiver objects which can be referenced by the routes of
+	// the AlertmanagerConfig resources. If empty, no AlertmanagerReceiver
+	// object can be referenced.
+	//
+	// The secrets referenced by the AlertmanagerReceiver objects are read
+	// from the receiver's namespace.
+	// +listType=set
+	// +optional
+	AlertmanagerReceiverNamespaces []string `json:"alertmanagerReceiverNamespaces,omitempty"`
+
+	// silenceSelector defines the AlertmanagerSilence objects to be
+	// created as silences on the Alertmanager instances. If nil, no
+	// AlertmanagerSilence object is selected.
+	//
+	// The `alertmanagerConfigMatcherStrategy` field also applies to the
+	// matchers of the silences.
+	// +optional
+	SilenceSelector *metav1.LabelSelector `json:"silenceSelector,omitempty"`
+	// silenceNamespaceSelector defines the namespaces to be selected for
+	// AlertmanagerSilence discovery. If nil, only check own namespace.
+	// +optional
+	SilenceNamespaceSelector *metav1.LabelSelector `json:"silenceNamespaceSelector,omitempty"`
+
+	// templateSelector defines the AlertmanagerTemplate objects to be
+	// added to the Alertmanager configuration. If nil, no
+	// AlertmanagerTemplate object is selected.
+	//
+	// The templates are only added when the configuration is generated by
+	// the operator (e.g. `alertmanagerConfigSelector` or
+	// `alertmanagerConfiguration` is defined).
+	// +optional
+	TemplateSelector *metav1.LabelSelector `json:"templateSelector,omitempty"`
+	// templateNamespaceSelector defines the namespaces to be selected for
+	// AlertmanagerTemplate discovery. If nil, only check own namespace.
+	// +optional
+	TemplateNamespaceSelector *metav1.LabelSelector `json:"templateNamespaceSelector,omitempty"`
+
 	// minReadySeconds defines the minimum number of seconds for which a newly created pod should be ready
 	// without any of its container crashing for it to be considered available.
 	//
@@ -388,6 +461,55 @@ type AlertmanagerSpec struct {
 	HostUsers *bool `json:"hostUsers,omitempty"`
 }
 
+// NotificationRateLimit defines the maximum number of notifications sent for
+// an alert group during a time interval.
+//
+// Alertmanager doesn't support rate limiting natively: the limit is enforced
+// by setting the `group_interval` and `repeat_interval` values of the routes
+// to at least `interval / maxNotifications`.
+// +k8s:openapi-gen=true
+type NotificationRateLimit struct {
+	// maxNotifications defines the maximum number of notifications per
+	// alert group during the interval.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxNotifications int32 `json:"maxNotifications"`
+	// interval defines the time interval over which the notifications are
+	// counted.
+	// +required
+	Interval Duration `json:"interval"`
+}
+
+// IntegrationRateLimit defines the maximum notification rate for the
+// receivers using a given integration.
+// +k8s:openapi-gen=true
+type IntegrationRateLimit struct {
+	// integration defines the name of the integration (e.g. `webhook`
+	// for `webhookConfigs`).
+	// +kubebuilder:validation:Enum=discord;email;incidentio;jira;msteams;msteamsv2;opsgenie;pagerduty;pushover;rocketchat;slack;sns;telegram;victorops;webex;webhook;wechat
+	// +required
+	Integration string `json:"integration"`
+
+	NotificationRateLimit `json:",inline"`
+}
+
+// AlertmanagerConfigRateLimits defines the maximum notification rates for
+// the receivers defined by AlertmanagerConfig objects.
+// +k8s:openapi-gen=true
+type AlertmanagerConfigRateLimits struct {
+	// receiver defines the maximum notification rate applying to all
+	// receivers.
+	// +optional
+	Receiver *NotificationRateLimit `json:"receiver,omitempty"`
+	// integrations defines the maximum notification rates applying to the
+	// receivers which have at least one configuration of the given
+	// integration.
+	// +listType=map
+	// +listMapKey=integration
+	// +optional
+	Integrations []IntegrationRateLimit `json:"integrations,omitempty"`
+}
+
 type AlertmanagerConfigMatcherStrategy struct {
 	// type defines the strategy used by
 	// AlertmanagerConfig objects to match alerts in the routes and inhibition
@@ -420,6 +542,49 @@ const (
 	NoneConfigMatcherStrategyType AlertmanagerConfigMatcherStrategyType = "None"
 )
 
+// AlertmanagerPeerDiscovery defines a source of Alertmanager peers.
+// Exactly one of `service` or `dnsSRV` must be defined.
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.service) != has(self.dnsSRV)",message="exactly one of service or dnsSRV must be defined"
+type AlertmanagerPeerDiscovery struct {
+	// service defines a Kubernetes Service exposing the peers. The peer is
+	// the DNS name of the Service (`<name>.<namespace>.svc`) with the port
+	// of the Service, which Alertmanager resolves to the addresses of the
+	// peers. The Service may be in another namespace and may have no
+	// selector (e.g. with EndpointSlices pointing to another cluster). For a
+	// headless Service, the port must be equal to the target port.
+	// +optional
+	Service *AlertmanagerPeerService `json:"service,omitempty"`
+	// dnsSRV defines the name of a DNS SRV record (e.g.
+	// `_tcp-mesh._tcp.alertmanager.example.com`). The peers are the targets
+	// and ports of the record, resolved by the operator.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	DNSSRV *string `json:"dnsSRV,omitempty"`
+}
+
+// AlertmanagerPeerService references the Service exposing the cluster port
+// of Alertmanager peers.
+// +k8s:openapi-gen=true
+type AlertmanagerPeerService struct {
+	// name defines the name of the Service.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// namespace defines the namespace of the Service.
+	// If empty, it defaults to the namespace of the Alertmanager object.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// port defines the name of the Service's port exposing the cluster
+	// port of the peers.
+	// If empty, it defaults to `tcp-mesh` (the name used by the governing
+	// Service of the Alertmanager pods).
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Port *string `json:"port,omitempty"`
+}
+
 // AlertmanagerConfiguration defines the Alertmanager configuration.
 // +k8s:openapi-gen=true
 type AlertmanagerConfiguration struct {
@@ -553,6 +718,11 @@ type AlertmanagerWebSpec struct {
 	// `--web.timeout` flag.
 	// +optional
 	Timeout *uint32 `json:"timeout,omitempty"`
+	// operatorClientConfig defines the configuration used by the operator to
+	// call the Alertmanager API (e.g. to manage the silences selected by
+	// `silenceSelector`).
+	// +optional
+	OperatorClientConfig *WebClientConfig `json:"operatorClientConfig,omitempty"`
 }
 
 // AlertmanagerLimitsSpec defines the limits command line flags when starting Alertmanager.
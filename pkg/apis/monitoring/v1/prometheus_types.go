@@ -284,6 +284,41 @@ type CommonPrometheusFields struct {
 	// +optional
 	Shards *int32 `json:"shards,omitempty"`
 
+	// shardingStrategy defines how the targets are distributed across shards.
+	//
+	// * `HashMod` (default): the target is assigned to the shard equal to the
+	// hash of the sharding label modulo the number of shards. Changing the
+	// number of shards reassigns almost all targets.
+	// * `Rendezvous`: the hash of the sharding label is mapped to one of 256
+	// slots and each slot is assigned to a shard using rendezvous hashing.
+	// Changing the number of shards from N to N+1 (or the reverse) only
+	// reassigns about 1/(N+1) of the targets. The number of shards can't be
+	// greater than 256.
+	//
+	// Changing the strategy reassigns almost all targets.
+	//
+	// The sharding strategy doesn't apply to the custom sharding
+	// implementations which define a `hashmod` relabeling rule in
+	// ScrapeConfig resources or additional scrape configurations.
+	// +optional
+	ShardingStrategy *ShardingStrategy `json:"shardingStrategy,omitempty"`
+
+	// shardAutoscaling defines the policy to adjust automatically the number
+	// of shards based on the load of the Prometheus pods.
+	//
+	// When defined, the operator periodically reads the number of head series
+	// and the memory usage of the shards' pods and computes the number of
+	// shards needed to meet the targets. The number of shards decided by the
+	// operator is stored in the `operator.prometheus.io/autoscaled-shards`
+	// annotation and `spec.shards` is only used as the initial value.
+	//
+	// Scaling down shards follows the `shardRetentionPolicy` field.
+	//
+	// It shouldn't be used in conjunction with an external autoscaler (such
+	// as the HorizontalPodAutoscaler) acting on the scale subresource.
+	// +optional
+	ShardAutoscaling *ShardAutoscaling `json:"shardAutoscaling,omitempty"`
+
 	// replicaExternalLabelName defines the name of Prometheus external label used to denote the replica name.
 	// The external label will _not_ be added when the field is set to the
 	// empty string (`""`).
@@ -1036,6 +1071,18 @@ const (
 	ProcessSignalReloadStrategyType ReloadStrategyType = "ProcessSignal"
 )
 
+// +kubebuilder:validation:Enum=HashMod;Rendezvous
+type ShardingStrategy string
+
+const (
+	// HashModShardingStrategy assigns the targets to shards using the
+	// `hashmod` relabeling action with the number of shards as the modulus.
+	HashModShardingStrategy ShardingStrategy = "HashMod"
+	// RendezvousShardingStrategy assigns the targets to shards using
+	// rendezvous hashing over a fixed number of slots.
+	RendezvousShardingStrategy ShardingStrategy = "Rendezvous"
+)
+
 // +kubebuilder:validation:Enum=Endpoints;EndpointSlice
 type ServiceDiscoveryRole string
 
@@ -1296,6 +1343,42 @@ type RetainConfig struct {
 	RetentionPeriod Duration `json:"retentionPeriod"`
 }
 
+// ShardAutoscaling defines the policy for the automatic scaling of the
+// Prometheus shards.
+// +kubebuilder:validation:XValidation:rule="self.minShards <= self.maxShards",message="minShards must be less than or equal to maxShards"
+// +kubebuilder:validation:XValidation:rule="has(self.targetHeadSeries) || has(self.targetMemory)",message="at least one of targetHeadSeries or targetMemory must be defined"
+type ShardAutoscaling struct {
+	// minShards defines the minimum number of shards.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MinShards int32 `json:"minShards"`
+
+	// maxShards defines the maximum number of shards.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxShards int32 `json:"maxShards"`
+
+	// targetHeadSeries defines the target number of head series per shard.
+	// For PrometheusAgent resources, the number of active series is used
+	// instead.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetHeadSeries *int64 `json:"targetHeadSeries,omitempty"`
+
+	// targetMemory defines the target memory usage (resident set size) per
+	// shard.
+	// +optional
+	TargetMemory *resource.Quantity `json:"targetMemory,omitempty"`
+
+	// scaleDownStabilizationWindow defines the duration during which the
+	// past recommendations are considered before scaling down. The operator
+	// uses the highest recommendation within the window to prevent flapping.
+	//
+	// Default: "5m"
+	// +optional
+	ScaleDownStabilizationWindow *Duration `json:"scaleDownStabilizationWindow,omitempty"`
+}
+
 type ShardRetentionPolicy struct {
 	// whenScaled defines the retention policy when the Prometheus shards are scaled down.
 	// * `Delete`, the operator will delete the pods from the scaled-down shard(s).
@@ -2306,6 +2389,33 @@ type ShardStatus struct {
 	// unavailableReplicas defines the Total number of unavailable pods targeted by this shard.
 	// +required
 	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// headSeries defines the number of head series (active series for
+	// PrometheusAgent) of the shard observed by the shard autoscaler during
+	// its last evaluation.
+	// +optional
+	HeadSeries *int64 `json:"headSeries,omitempty"`
+	// memoryUsage defines the memory usage of the shard observed by the shard
+	// autoscaler during its last evaluation.
+	// +optional
+	MemoryUsage *resource.Quantity `json:"memoryUsage,omitempty"`
+	// lastScalingDecision defines the last change of the number of shards
+	// decided by the shard autoscaler.
+	// +optional
+	LastScalingDecision *ShardScalingDecision `json:"lastScalingDecision,omitempty"`
+}
+
+// ShardScalingDecision records a change of the number of shards decided by
+// the shard autoscaler.
+type ShardScalingDecision struct {
+	// previousShards defines the number of shards before the decision.
+	// +required
+	PreviousShards int32 `json:"previousShards"`
+	// shards defines the number of shards after the decision.
+	// +required
+	Shards int32 `json:"shards"`
+	// time defines when the decision was taken.
+	// +required
+	Time metav1.Time `json:"time"`
 }
 
 type TSDBSpec struct {
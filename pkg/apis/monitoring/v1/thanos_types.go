@@ -114,9 +114,33 @@ type ThanosRulerSpec struct {
 	Paused bool `json:"paused,omitempty"`
 
 	// replicas defines the number of thanos ruler instances to deploy.
+	//
+	// When `spec.shards` is greater than 1, it defines the number of replicas
+	// of each shard.
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// shards defines the number of shards to distribute the rule groups onto.
+	//
+	// The operator assigns each rule group to exactly one shard by hashing
+	// the namespace and name of the PrometheusRule object together with the
+	// group name. Each shard is deployed as a separate StatefulSet with its
+	// own set of rule ConfigMaps. When the number of shards is greater than
+	// 1, the `thanos_ruler_shard` external label identifies the shard which
+	// produced the alerts and the recorded series.
+	//
+	// `spec.replicas` multiplied by `spec.shards` is the total number of Pods
+	// created.
+	//
+	// When not defined, the operator assumes only one shard.
+	//
+	// Note that changing the number of shards reassigns rule groups to
+	// different shards which resets the state of their alerts (e.g. pending
+	// alerts).
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	Shards *int32 `json:"shards,omitempty"`
+
 	// nodeSelector defines which Nodes the Pods are scheduled on.
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
@@ -175,6 +199,9 @@ type ThanosRulerSpec struct {
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 
 	// storage defines the specification of how storage shall be used.
+	//
+	// In stateless mode (see `remoteWrite`), the volume claim template is
+	// ignored.
 	// +optional
 	Storage *StorageSpec `json:"storage,omitempty"`
 
@@ -194,6 +221,8 @@ type ThanosRulerSpec struct {
 	//
 	// `objectStorageConfigFile` takes precedence over this field.
 	//
+	// It is ignored in stateless mode (see `remoteWrite`).
+	//
 	// +optional
 	ObjectStorageConfig *v1.SecretKeySelector `json:"objectStorageConfig,omitempty"`
 	// objectStorageConfigFile defines the path of the object storage configuration file.
@@ -204,6 +233,8 @@ type ThanosRulerSpec struct {
 	//
 	// This field takes precedence over `objectStorageConfig`.
 	//
+	// It is ignored in stateless mode (see `remoteWrite`).
+	//
 	// +optional
 	ObjectStorageConfigFile *string `json:"objectStorageConfigFile,omitempty"`
 
@@ -234,6 +265,19 @@ type ThanosRulerSpec struct {
 	// +optional
 	QueryConfig *v1.SecretKeySelector `json:"queryConfig,omitempty"`
 
+	// queryDiscovery defines how to discover the Thanos Query endpoints
+	// from Kubernetes Services.
+	//
+	// The operator resolves the Services into a file stored in the
+	// `thanos-ruler-<name>-config` Secret which is reloaded by Thanos Ruler:
+	// adding or removing endpoints doesn't restart the pods. The discovered
+	// endpoints are queried in addition to `queryEndpoints`.
+	//
+	// It can't be used with `queryConfig`.
+	//
+	// +optional
+	QueryDiscovery *ThanosRulerQueryDiscovery `json:"queryDiscovery,omitempty"`
+
 	// alertmanagersUrl defines the list of Alertmanager endpoints to send alerts to.
 	//
 	// For Thanos >= v0.10.0, it is recommended to use `alertmanagersConfig` instead.
@@ -484,7 +528,11 @@ type ThanosRulerSpec struct {
 
 	// remoteWrite defines the list of remote write configurations.
 	//
-	// When the list isn't empty, the ruler is configured with stateless mode.
+	// When the list isn't empty, the ruler is configured with stateless mode:
+	// the operator deploys the ruler as a Deployment instead of a
+	// StatefulSet. The object storage configuration is ignored and
+	// the data directory isn't backed by a persistent volume claim (only
+	// `emptyDir` and `ephemeral` storage are honored).
 	//
 	// It requires Thanos >= 0.24.0.
 	//
@@ -533,6 +581,46 @@ type ThanosRulerWebSpec struct {
 	WebConfigFileFields `json:",inline"`
 }
 
+// ThanosRulerQueryDiscovery defines the Services exposing the Thanos Query
+// HTTP API which are used by Thanos Ruler to evaluate the rules.
+// +k8s:openapi-gen=true
+type ThanosRulerQueryDiscovery struct {
+	// services defines a list of Services referenced by name.
+	// +optional
+	Services []ThanosRulerQueryService `json:"services,omitempty"`
+	// serviceSelector defines the label selector of the Services.
+	// If nil, no Service is selected by labels.
+	// +optional
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
+	// serviceNamespaceSelector defines the namespaces in which the Services
+	// matching `serviceSelector` are looked up.
+	// If nil, only the namespace of the ThanosRuler object is selected.
+	// +optional
+	ServiceNamespaceSelector *metav1.LabelSelector `json:"serviceNamespaceSelector,omitempty"`
+	// port defines the name of the Service's port exposing the Thanos Query
+	// HTTP API.
+	// If empty, it defaults to `web` (the name used by the Service of the
+	// ThanosQuery objects).
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Port *string `json:"port,omitempty"`
+}
+
+// ThanosRulerQueryService references a Service exposing the Thanos Query
+// HTTP API.
+// +k8s:openapi-gen=true
+type ThanosRulerQueryService struct {
+	// name defines the name of the Service.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// namespace defines the namespace of the Service.
+	// If empty, it defaults to the namespace of the ThanosRuler object.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
+
 // ThanosRulerStatus is the most recent observed status of the ThanosRuler. Read-only.
 // More info:
 // https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
@@ -562,6 +650,14 @@ type ThanosRulerStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// shardStatuses defines the list has one entry per shard. Each entry provides a summary of the shard status.
+	// +listType=map
+	// +listMapKey=shardID
+	// +optional
+	ShardStatuses []ShardStatus `json:"shardStatuses,omitempty"`
+	// shards defines the most recently observed number of shards.
+	// +optional
+	Shards int32 `json:"shards,omitempty"`
 }
 
 func (tr *ThanosRuler) ExpectedReplicas() int {
@@ -351,6 +351,52 @@ type WebConfigFileFields struct {
 	HTTPConfig *WebHTTPConfig `json:"httpConfig,omitempty"`
 }
 
+// WebClientConfig defines the configuration used by the operator to connect
+// to the web server of the managed pods (e.g. to call their HTTP API).
+// +k8s:openapi-gen=true
+type WebClientConfig struct {
+	// basicAuth defines the credentials for basic authentication.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+	// authorization defines the credentials sent in the Authorization
+	// header.
+	//
+	// Cannot be set at the same time as `basicAuth`.
+	// +optional
+	Authorization *SafeAuthorization `json:"authorization,omitempty"`
+	// tlsConfig defines the TLS configuration used when the web server is
+	// configured with TLS. It is required when the web server verifies client
+	// certificates.
+	//
+	// When the CA isn't defined, the server's certificate is verified against
+	// the certificate of the web server's TLS configuration.
+	// When the server name isn't defined, it defaults to the DNS name of the
+	// pod (`<pod>.<governing service>.<namespace>.svc`).
+	// +optional
+	TLSConfig *SafeTLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// Validate semantically validates the given WebClientConfig.
+func (c *WebClientConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.BasicAuth != nil && c.Authorization != nil {
+		return errors.New("basicAuth and authorization can't be set at the same time")
+	}
+
+	if err := c.Authorization.Validate(); err != nil {
+		return fmt.Errorf("authorization: %w", err)
+	}
+
+	if err := c.TLSConfig.Validate(); err != nil {
+		return fmt.Errorf("tlsConfig: %w", err)
+	}
+
+	return nil
+}
+
 // WebHTTPConfig defines HTTP parameters for web server.
 // +k8s:openapi-gen=true
 type WebHTTPConfig struct {
@@ -112,6 +112,31 @@ func (in *AlertmanagerConfigMatcherStrategy) DeepCopy() *AlertmanagerConfigMatch
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerConfigRateLimits) DeepCopyInto(out *AlertmanagerConfigRateLimits) {
+	*out = *in
+	if in.Receiver != nil {
+		in, out := &in.Receiver, &out.Receiver
+		*out = new(NotificationRateLimit)
+		**out = **in
+	}
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]IntegrationRateLimit, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigRateLimits.
+func (in *AlertmanagerConfigRateLimits) DeepCopy() *AlertmanagerConfigRateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerConfigRateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerConfiguration) DeepCopyInto(out *AlertmanagerConfiguration) {
 	*out = *in
@@ -334,6 +359,56 @@ func (in *AlertmanagerList) DeepCopy() *AlertmanagerList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerPeerDiscovery) DeepCopyInto(out *AlertmanagerPeerDiscovery) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(AlertmanagerPeerService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSSRV != nil {
+		in, out := &in.DNSSRV, &out.DNSSRV
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerPeerDiscovery.
+func (in *AlertmanagerPeerDiscovery) DeepCopy() *AlertmanagerPeerDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerPeerDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerPeerService) DeepCopyInto(out *AlertmanagerPeerService) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerPeerService.
+func (in *AlertmanagerPeerService) DeepCopy() *AlertmanagerPeerService {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerPeerService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 	*out = *in
@@ -462,6 +537,13 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PeerDiscovery != nil {
+		in, out := &in.PeerDiscovery, &out.PeerDiscovery
+		*out = make([]AlertmanagerPeerDiscovery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ClusterLabel != nil {
 		in, out := &in.ClusterLabel, &out.ClusterLabel
 		*out = new(string)
@@ -478,6 +560,41 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.AlertmanagerConfigMatcherStrategy = in.AlertmanagerConfigMatcherStrategy
+	if in.AlertmanagerConfigRateLimits != nil {
+		in, out := &in.AlertmanagerConfigRateLimits, &out.AlertmanagerConfigRateLimits
+		*out = new(AlertmanagerConfigRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertmanagerConfigSharingNamespaces != nil {
+		in, out := &in.AlertmanagerConfigSharingNamespaces, &out.AlertmanagerConfigSharingNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertmanagerReceiverNamespaces != nil {
+		in, out := &in.AlertmanagerReceiverNamespaces, &out.AlertmanagerReceiverNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SilenceSelector != nil {
+		in, out := &in.SilenceSelector, &out.SilenceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SilenceNamespaceSelector != nil {
+		in, out := &in.SilenceNamespaceSelector, &out.SilenceNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateSelector != nil {
+		in, out := &in.TemplateSelector, &out.TemplateSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateNamespaceSelector != nil {
+		in, out := &in.TemplateNamespaceSelector, &out.TemplateNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
 		*out = new(int32)
@@ -583,6 +700,11 @@ func (in *AlertmanagerWebSpec) DeepCopyInto(out *AlertmanagerWebSpec) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.OperatorClientConfig != nil {
+		in, out := &in.OperatorClientConfig, &out.OperatorClientConfig
+		*out = new(WebClientConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerWebSpec.
@@ -834,6 +956,16 @@ func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ShardingStrategy != nil {
+		in, out := &in.ShardingStrategy, &out.ShardingStrategy
+		*out = new(ShardingStrategy)
+		**out = **in
+	}
+	if in.ShardAutoscaling != nil {
+		in, out := &in.ShardAutoscaling, &out.ShardAutoscaling
+		*out = new(ShardAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReplicaExternalLabelName != nil {
 		in, out := &in.ReplicaExternalLabelName, &out.ReplicaExternalLabelName
 		*out = new(string)
@@ -1739,6 +1871,22 @@ func (in *HostPort) DeepCopy() *HostPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationRateLimit) DeepCopyInto(out *IntegrationRateLimit) {
+	*out = *in
+	out.NotificationRateLimit = in.NotificationRateLimit
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationRateLimit.
+func (in *IntegrationRateLimit) DeepCopy() *IntegrationRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedIdentity) DeepCopyInto(out *ManagedIdentity) {
 	*out = *in
@@ -1829,6 +1977,21 @@ func (in *NativeHistogramConfig) DeepCopy() *NativeHistogramConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRateLimit) DeepCopyInto(out *NotificationRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRateLimit.
+func (in *NotificationRateLimit) DeepCopy() *NotificationRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2) DeepCopyInto(out *OAuth2) {
 	*out = *in
@@ -2696,7 +2859,9 @@ func (in *PrometheusStatus) DeepCopyInto(out *PrometheusStatus) {
 	if in.ShardStatuses != nil {
 		in, out := &in.ShardStatuses, &out.ShardStatuses
 		*out = make([]ShardStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -3540,6 +3705,36 @@ func (in *ServiceMonitorSpec) DeepCopy() *ServiceMonitorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardAutoscaling) DeepCopyInto(out *ShardAutoscaling) {
+	*out = *in
+	if in.TargetHeadSeries != nil {
+		in, out := &in.TargetHeadSeries, &out.TargetHeadSeries
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetMemory != nil {
+		in, out := &in.TargetMemory, &out.TargetMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ScaleDownStabilizationWindow != nil {
+		in, out := &in.ScaleDownStabilizationWindow, &out.ScaleDownStabilizationWindow
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardAutoscaling.
+func (in *ShardAutoscaling) DeepCopy() *ShardAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardRetentionPolicy) DeepCopyInto(out *ShardRetentionPolicy) {
 	*out = *in
@@ -3565,9 +3760,40 @@ func (in *ShardRetentionPolicy) DeepCopy() *ShardRetentionPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardScalingDecision) DeepCopyInto(out *ShardScalingDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardScalingDecision.
+func (in *ShardScalingDecision) DeepCopy() *ShardScalingDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardScalingDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
 	*out = *in
+	if in.HeadSeries != nil {
+		in, out := &in.HeadSeries, &out.HeadSeries
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemoryUsage != nil {
+		in, out := &in.MemoryUsage, &out.MemoryUsage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastScalingDecision != nil {
+		in, out := &in.LastScalingDecision, &out.LastScalingDecision
+		*out = new(ShardScalingDecision)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardStatus.
@@ -3710,6 +3936,63 @@ func (in *ThanosRulerList) DeepCopy() *ThanosRulerList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosRulerQueryDiscovery) DeepCopyInto(out *ThanosRulerQueryDiscovery) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ThanosRulerQueryService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceNamespaceSelector != nil {
+		in, out := &in.ServiceNamespaceSelector, &out.ServiceNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRulerQueryDiscovery.
+func (in *ThanosRulerQueryDiscovery) DeepCopy() *ThanosRulerQueryDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosRulerQueryDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosRulerQueryService) DeepCopyInto(out *ThanosRulerQueryService) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRulerQueryService.
+func (in *ThanosRulerQueryService) DeepCopy() *ThanosRulerQueryService {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosRulerQueryService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThanosRulerSpec) DeepCopyInto(out *ThanosRulerSpec) {
 	*out = *in
@@ -3733,6 +4016,11 @@ func (in *ThanosRulerSpec) DeepCopyInto(out *ThanosRulerSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = new(int32)
+		**out = **in
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -3824,6 +4112,11 @@ func (in *ThanosRulerSpec) DeepCopyInto(out *ThanosRulerSpec) {
 		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueryDiscovery != nil {
+		in, out := &in.QueryDiscovery, &out.QueryDiscovery
+		*out = new(ThanosRulerQueryDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AlertManagersURL != nil {
 		in, out := &in.AlertManagersURL, &out.AlertManagersURL
 		*out = make([]string, len(*in))
@@ -3991,6 +4284,13 @@ func (in *ThanosRulerStatus) DeepCopyInto(out *ThanosRulerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ShardStatuses != nil {
+		in, out := &in.ShardStatuses, &out.ShardStatuses
+		*out = make([]ShardStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRulerStatus.
@@ -4113,6 +4413,36 @@ func (in *TopologySpreadConstraint) DeepCopy() *TopologySpreadConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebClientConfig) DeepCopyInto(out *WebClientConfig) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebClientConfig.
+func (in *WebClientConfig) DeepCopy() *WebClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebConfigFileFields) DeepCopyInto(out *WebConfigFileFields) {
 	*out = *in
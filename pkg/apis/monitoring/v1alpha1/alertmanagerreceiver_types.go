@@ -0,0 +1,77 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	AlertmanagerReceiversKind   = "AlertmanagerReceiver"
+	AlertmanagerReceiverName    = "alertmanagerreceivers"
+	AlertmanagerReceiverKindKey = "alertmanagerreceiver"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="amrcv"
+// +kubebuilder:storageversion
+// +kubebuilder:validation:XValidation:rule="self.spec.name == self.metadata.name",message="spec.name must be equal to metadata.name"
+
+// AlertmanagerReceiver defines a receiver which can be shared by the
+// AlertmanagerConfig resources of other namespaces.
+//
+// The routes of the AlertmanagerConfig resources reference the receiver as
+// `<namespace>/<name>` with the `sharedReceiver` field. The receiver is added
+// only once to the Alertmanager configuration, provided that its namespace is
+// listed in the `alertmanagerReceiverNamespaces` field of the Alertmanager
+// resource.
+//
+// The secrets referenced by the receiver are read from the receiver's
+// namespace.
+type AlertmanagerReceiver struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the receiver's configuration. The `name` field must be
+	// equal to the object's name.
+	// +required
+	Spec Receiver `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerReceiver) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerReceiverList is a list of AlertmanagerReceivers.
+// +k8s:openapi-gen=true
+type AlertmanagerReceiverList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of AlertmanagerReceivers
+	Items []AlertmanagerReceiver `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerReceiverList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	AlertmanagerSilencesKind   = "AlertmanagerSilence"
+	AlertmanagerSilenceName    = "alertmanagersilences"
+	AlertmanagerSilenceKindKey = "alertmanagersilence"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="amsilence"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Starts At",type="date",JSONPath=".spec.startsAt"
+// +kubebuilder:printcolumn:name="Ends At",type="date",JSONPath=".spec.endsAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AlertmanagerSilence defines a silence which the operator creates on the
+// Alertmanager instances selecting the object.
+//
+// The operator creates, updates and expires the silence through the
+// Alertmanager v2 API and reports the silence IDs and states in the status
+// subresource. When the object is deleted, the silences are expired.
+type AlertmanagerSilence struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of AlertmanagerSilenceSpec.
+	// +required
+	Spec AlertmanagerSilenceSpec `json:"spec"`
+	// status defines the state of the silence for each Alertmanager
+	// selecting the object. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertmanagerSilenceStatus `json:"status,omitempty,omitzero"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerSilence) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerSilenceList is a list of AlertmanagerSilences.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of AlertmanagerSilences
+	Items []AlertmanagerSilence `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerSilenceList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerSilenceSpec is a specification of the desired silence.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceSpec struct {
+	// matchers defines the label matchers of the alerts being silenced.
+	//
+	// Depending on the `alertmanagerConfigMatcherStrategy` of the
+	// Alertmanager resource, the operator adds a matcher on the `namespace`
+	// label equal to the namespace of the object.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Matchers []Matcher `json:"matchers"`
+	// startsAt defines the time from which the silence is active.
+	// When not defined, the silence is active as soon as it's created.
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+	// endsAt defines the time when the silence expires.
+	// +required
+	EndsAt metav1.Time `json:"endsAt"`
+	// comment defines a description of the silence (e.g. the reason of the
+	// maintenance window).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Comment string `json:"comment"`
+	// createdBy defines the author of the silence.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	CreatedBy string `json:"createdBy"`
+}
+
+// Validate returns an error if the silence specification is invalid.
+func (s *AlertmanagerSilenceSpec) Validate() error {
+	if len(s.Matchers) == 0 {
+		return errors.New("at least one matcher is required")
+	}
+
+	for i, m := range s.Matchers {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("matcher[%d]: %w", i, err)
+		}
+
+		if m.Regex || m.MatchType == MatchRegexp || m.MatchType == MatchNotRegexp {
+			if _, err := regexp.Compile(m.Value); err != nil {
+				return fmt.Errorf("matcher[%d]: invalid regular expression %q: %w", i, m.Value, err)
+			}
+		}
+	}
+
+	if s.StartsAt != nil && !s.StartsAt.Before(&s.EndsAt) {
+		return errors.New("'startsAt' must be before 'endsAt'")
+	}
+
+	return nil
+}
+
+// SilenceState is the state of a silence in Alertmanager.
+// +kubebuilder:validation:Enum=Pending;Active;Expired
+type SilenceState string
+
+const (
+	// SilencePending means that the silence's start time is in the future.
+	SilencePending SilenceState = "Pending"
+	// SilenceActive means that the silence mutes the matching alerts.
+	SilenceActive SilenceState = "Active"
+	// SilenceExpired means that the silence's end time is in the past.
+	SilenceExpired SilenceState = "Expired"
+)
+
+// AlertmanagerSilenceStatus is the most recent observed status of the
+// silence.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceStatus struct {
+	// alertmanagers defines the state of the silence for each Alertmanager
+	// resource selecting the object.
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	// +optional
+	Alertmanagers []SilenceAlertmanagerStatus `json:"alertmanagers,omitempty"`
+}
+
+// SilenceAlertmanagerStatus is the state of the silence for a given
+// Alertmanager resource.
+//
+// The operator creates the silence on one of the ready pods and relies on the
+// Alertmanager cluster to propagate it to the other replicas.
+// +k8s:openapi-gen=true
+type SilenceAlertmanagerStatus struct {
+	// namespace defines the namespace of the Alertmanager resource.
+	// +required
+	Namespace string `json:"namespace"`
+	// name defines the name of the Alertmanager resource.
+	// +required
+	Name string `json:"name"`
+	// silenceID defines the identifier of the silence in Alertmanager.
+	// +optional
+	SilenceID string `json:"silenceID,omitempty"`
+	// state defines the state of the silence.
+	// +optional
+	State SilenceState `json:"state,omitempty"`
+	// observedGeneration defines the .metadata.generation of the object
+	// which was last synchronized with Alertmanager.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// lastSyncTime defines the last time the operator created, updated or
+	// expired the silence in Alertmanager.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// message defines the reason why the silence couldn't be synchronized
+	// with Alertmanager.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	AlertmanagerTemplatesKind   = "AlertmanagerTemplate"
+	AlertmanagerTemplateName    = "alertmanagertemplates"
+	AlertmanagerTemplateKindKey = "alertmanagertemplate"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="amtmpl"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+
+// AlertmanagerTemplate defines notification templates for Alertmanager.
+//
+// The operator parses the templates of the objects selected by an
+// Alertmanager resource, adds the valid ones to the generated configuration
+// and reports the parse errors in the status subresource.
+type AlertmanagerTemplate struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of AlertmanagerTemplateSpec.
+	// +required
+	Spec AlertmanagerTemplateSpec `json:"spec"`
+	// status defines the status subresource. It reports whether the
+	// template could be parsed for each Alertmanager selecting it.
+	//
+	// Most recent observed status of the AlertmanagerTemplate. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status monitoringv1.ConfigResourceStatus `json:"status,omitempty,omitzero"`
+}
+
+func (l *AlertmanagerTemplate) Bindings() []monitoringv1.WorkloadBinding {
+	return l.Status.Bindings
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerTemplate) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerTemplateList is a list of AlertmanagerTemplates.
+// +k8s:openapi-gen=true
+type AlertmanagerTemplateList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of AlertmanagerTemplates
+	Items []AlertmanagerTemplate `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerTemplateList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerTemplateSpec is a specification of the notification templates.
+// +k8s:openapi-gen=true
+type AlertmanagerTemplateSpec struct {
+	// template defines the notification templates using the Go templating
+	// language. The content is equivalent to a template file referenced in
+	// the `templates` section of the Alertmanager configuration.
+	//
+	// Example:
+	//
+	//	{{ define "slack.myorg.text" }}{{ .CommonAnnotations.summary }}{{ end }}
+	//
+	// The defined templates can be used by the receivers of all the
+	// AlertmanagerConfig objects selected by the Alertmanager.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Template string `json:"template"`
+}
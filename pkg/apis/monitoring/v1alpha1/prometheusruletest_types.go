@@ -0,0 +1,288 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	PrometheusRuleTestsKind   = "PrometheusRuleTest"
+	PrometheusRuleTestName    = "prometheusruletests"
+	PrometheusRuleTestKindKey = "prometheusruletest"
+)
+
+const (
+	// RuleTestPassed indicates whether the unit tests of the
+	// PrometheusRuleTest resource have passed.
+	// The possible status values for this condition type are:
+	// - True: all the tests passed.
+	// - False: at least one test failed or the tests couldn't be evaluated.
+	// - Unknown: the operator couldn't determine the condition status.
+	RuleTestPassed v1.ConditionType = "Passed"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="promruletest"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Passed",type="string",JSONPath=".status.conditions[?(@.type == 'Passed')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// PrometheusRuleTest defines unit tests for the PrometheusRule objects
+// selected from the same namespace.
+//
+// The operator evaluates the tests in-process, the same way as `promtool test
+// rules`, and reports the results in the status subresource.
+type PrometheusRuleTest struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of PrometheusRuleTestSpec.
+	// +required
+	Spec PrometheusRuleTestSpec `json:"spec"`
+	// status defines the most recent results of the tests. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status PrometheusRuleTestStatus `json:"status,omitempty,omitzero"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *PrometheusRuleTest) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// PrometheusRuleTestList is a list of PrometheusRuleTests.
+// +k8s:openapi-gen=true
+type PrometheusRuleTestList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of PrometheusRuleTests
+	// +required
+	Items []PrometheusRuleTest `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *PrometheusRuleTestList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// PrometheusRuleTestSpec is a specification of the rule unit tests. The
+// fields follow the format of the `promtool test rules` files.
+// +k8s:openapi-gen=true
+type PrometheusRuleTestSpec struct {
+	// ruleSelector defines the PrometheusRule objects to test. Only the
+	// objects from the namespace of the PrometheusRuleTest resource are
+	// selected.
+	// +required
+	RuleSelector *metav1.LabelSelector `json:"ruleSelector"`
+
+	// evaluationInterval defines the interval at which the rules are
+	// evaluated.
+	//
+	// Default: "1m"
+	// +optional
+	EvaluationInterval *v1.Duration `json:"evaluationInterval,omitempty"`
+
+	// groupEvalOrder defines the order in which the rule groups are
+	// evaluated. The groups not listed are evaluated in no particular order
+	// after the listed ones.
+	// +listType=set
+	// +optional
+	GroupEvalOrder []string `json:"groupEvalOrder,omitempty"`
+
+	// fuzzyCompare enables the comparison of floating-point values with a
+	// tolerance of 1 ULP (unit of least precision).
+	// +optional
+	FuzzyCompare *bool `json:"fuzzyCompare,omitempty"`
+
+	// tests defines the list of test groups.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Tests []RuleTestGroup `json:"tests"`
+}
+
+// RuleTestGroup is a group of input series with the associated tests.
+// +k8s:openapi-gen=true
+type RuleTestGroup struct {
+	// name of the test group.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// interval defines the interval between the samples of the input series.
+	//
+	// Default: the value of `evaluationInterval`.
+	// +optional
+	Interval *v1.Duration `json:"interval,omitempty"`
+
+	// inputSeries defines the series loaded before evaluating the rules.
+	// +optional
+	InputSeries []RuleTestInputSeries `json:"inputSeries,omitempty"`
+
+	// alertRuleTests defines the expected alerts at given evaluation times.
+	// +optional
+	AlertRuleTests []AlertRuleTest `json:"alertRuleTests,omitempty"`
+
+	// promqlExprTests defines the expected results of PromQL expressions at
+	// given evaluation times.
+	// +optional
+	PromQLExprTests []PromQLExprTest `json:"promqlExprTests,omitempty"`
+
+	// externalLabels defines the external labels accessible to the alert
+	// templates.
+	// +optional
+	ExternalLabels map[string]string `json:"externalLabels,omitempty"`
+
+	// externalURL defines the external URL accessible to the alert
+	// templates.
+	// +optional
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+// RuleTestInputSeries defines an input series.
+// +k8s:openapi-gen=true
+type RuleTestInputSeries struct {
+	// series defines the series in the PromQL notation (e.g.
+	// `up{job="prometheus"}`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Series string `json:"series"`
+
+	// values defines the values of the series using the expanding notation
+	// (e.g. `1+1x10 _ stale`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Values string `json:"values"`
+}
+
+// AlertRuleTest defines the alerts expected to fire at a given time.
+// +k8s:openapi-gen=true
+type AlertRuleTest struct {
+	// evalTime defines the time elapsed since time=0 at which the alerts are
+	// checked.
+	// +required
+	EvalTime v1.Duration `json:"evalTime"`
+
+	// alertname defines the name of the alert to check.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Alertname string `json:"alertname"`
+
+	// expAlerts defines the list of alerts expected to fire. An empty list
+	// means that no alert is expected.
+	// +optional
+	ExpAlerts []ExpectedAlert `json:"expAlerts,omitempty"`
+}
+
+// ExpectedAlert defines an alert expected to fire.
+// +k8s:openapi-gen=true
+type ExpectedAlert struct {
+	// expLabels defines the expected labels of the alert. The `alertname`
+	// label is added automatically.
+	// +optional
+	ExpLabels map[string]string `json:"expLabels,omitempty"`
+
+	// expAnnotations defines the expected annotations of the alert.
+	// +optional
+	ExpAnnotations map[string]string `json:"expAnnotations,omitempty"`
+}
+
+// PromQLExprTest defines the expected result of a PromQL expression at a
+// given time.
+// +k8s:openapi-gen=true
+type PromQLExprTest struct {
+	// expr defines the PromQL expression to evaluate.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Expr string `json:"expr"`
+
+	// evalTime defines the time elapsed since time=0 at which the
+	// expression is evaluated.
+	// +required
+	EvalTime v1.Duration `json:"evalTime"`
+
+	// expSamples defines the expected samples. An empty list means that the
+	// expression is expected to return no result.
+	// +optional
+	ExpSamples []ExpectedSample `json:"expSamples,omitempty"`
+}
+
+// ExpectedSample defines a sample expected in the result of a PromQL
+// expression.
+// +k8s:openapi-gen=true
+type ExpectedSample struct {
+	// labels defines the labels of the sample in the PromQL notation (e.g.
+	// `up{job="prometheus"}`).
+	// +optional
+	Labels string `json:"labels,omitempty"`
+
+	// value defines the expected value of the sample as a string (e.g. "1",
+	// "0.5", "NaN" or "+Inf").
+	//
+	// Default: "0"
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// histogram defines the expected native histogram value in the
+	// expanding notation. When set, `value` is ignored.
+	// +optional
+	Histogram string `json:"histogram,omitempty"`
+}
+
+// PrometheusRuleTestStatus is the most recent observed status of the
+// PrometheusRuleTest resource.
+// +k8s:openapi-gen=true
+type PrometheusRuleTestStatus struct {
+	// conditions defines the current state of the PrometheusRuleTest object.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []v1.Condition `json:"conditions,omitempty"`
+
+	// rules defines the list of PrometheusRule objects used by the tests.
+	// +listType=set
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+
+	// tests defines the results of the test groups.
+	// +optional
+	Tests []RuleTestGroupResult `json:"tests,omitempty"`
+}
+
+// RuleTestGroupResult is the result of a test group.
+// +k8s:openapi-gen=true
+type RuleTestGroupResult struct {
+	// name of the test group.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// passed is true if all the tests of the group passed.
+	// +required
+	Passed bool `json:"passed"`
+
+	// failures defines the messages describing the failed tests.
+	// +optional
+	Failures []string `json:"failures,omitempty"`
+}
@@ -53,6 +53,20 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&PrometheusAgentList{},
 		&ScrapeConfig{},
 		&ScrapeConfigList{},
+		&PrometheusRuleTest{},
+		&PrometheusRuleTestList{},
+		&AlertmanagerSilence{},
+		&AlertmanagerSilenceList{},
+		&AlertmanagerReceiver{},
+		&AlertmanagerReceiverList{},
+		&AlertmanagerTemplate{},
+		&AlertmanagerTemplateList{},
+		&ThanosQuery{},
+		&ThanosQueryList{},
+		&ThanosCompactor{},
+		&ThanosCompactorList{},
+		&ThanosStore{},
+		&ThanosStoreList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,234 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	// BucketSynced indicates whether the Thanos component could synchronize
+	// the metadata of the blocks stored in the object storage bucket.
+	// The possible status values for this condition type are:
+	// - True: the last synchronization succeeded on all pods.
+	// - False: the synchronization is failing on at least one pod.
+	// - Unknown: the operator couldn't determine the condition status (e.g.
+	// no pod is ready or no synchronization happened yet).
+	BucketSynced monitoringv1.ConditionType = "BucketSynced"
+	// CompactionHealthy indicates whether the Thanos compactor is processing
+	// the blocks of the object storage bucket.
+	// The possible status values for this condition type are:
+	// - True: the compactor isn't halted.
+	// - False: the compactor has halted because of a critical error (e.g.
+	// overlapping blocks) and requires a manual intervention.
+	// - Unknown: the operator couldn't determine the condition status.
+	CompactionHealthy monitoringv1.ConditionType = "CompactionHealthy"
+)
+
+// CommonThanosBucketFields are the fields shared by the Thanos components
+// operating on an object storage bucket (ThanosCompactor and ThanosStore).
+// +k8s:openapi-gen=true
+type CommonThanosBucketFields struct {
+	// version of Thanos to be deployed.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// podMetadata defines labels and annotations which are propagated to the pods.
+	//
+	// The following items are reserved and cannot be overridden:
+	// * "app.kubernetes.io/name" label, set to "thanos-compactor" or "thanos-store".
+	// * "app.kubernetes.io/managed-by" label, set to "prometheus-operator".
+	// * "app.kubernetes.io/instance" label, set to the name of the object.
+	// * "thanos-compactor" or "thanos-store" label, set to the name of the object.
+	// * "kubectl.kubernetes.io/default-container" annotation, set to "thanos-compactor" or "thanos-store".
+	// +optional
+	PodMetadata *monitoringv1.EmbeddedObjectMetadata `json:"podMetadata,omitempty"`
+
+	// image defines Thanos container image URL.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// imagePullPolicy defines the image pull policy for the Thanos container.
+	// See https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy for more details.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// imagePullSecrets defines an optional list of references to secrets in the same namespace
+	// to use for pulling thanos images from registries
+	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// paused defines when the deployment is paused, no actions except for deletion
+	// will be performed on the underlying objects.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// nodeSelector defines which Nodes the Pods are scheduled on.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// resources defines the resource requirements for single Pods.
+	// If not provided, no requests/limits will be set
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// affinity defines when specified, the pod's scheduling constraints.
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+
+	// tolerations defines when specified, the pod's tolerations.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// topologySpreadConstraints defines the pod's topology spread constraints.
+	// +optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// securityContext defines the pod-level security attributes and common container settings.
+	// This defaults to the default PodSecurityContext.
+	// +optional
+	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// priorityClassName defines the priority class assigned to the Pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// serviceAccountName defines the name of the ServiceAccount to use to run the Pods.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// containers allows injecting additional containers or modifying operator generated
+	// containers. Containers described here modify an operator generated container if
+	// they share the same name and modifications are done via a strategic merge patch.
+	// The current container names are: `thanos-compactor` and `thanos-store`.
+	// Overriding containers is entirely outside the scope of what the maintainers will support and by doing
+	// so, you accept that this behaviour may break at any time without notice.
+	// +optional
+	Containers []v1.Container `json:"containers,omitempty"`
+
+	// storage defines the specification of how storage shall be used for
+	// the local data directory.
+	// +optional
+	Storage *monitoringv1.StorageSpec `json:"storage,omitempty"`
+
+	// volumes defines how configuration of additional volumes on the output StatefulSet definition. Volumes specified will
+	// be appended to other volumes that are generated as a result of StorageSpec objects.
+	// +optional
+	Volumes []v1.Volume `json:"volumes,omitempty"`
+	// volumeMounts defines how the configuration of additional VolumeMounts on the output StatefulSet definition.
+	// VolumeMounts specified will be appended to other VolumeMounts in the Thanos container,
+	// that are generated as a result of StorageSpec objects.
+	// +optional
+	VolumeMounts []v1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// objectStorageConfig defines the configuration format is defined at https://thanos.io/tip/thanos/storage.md/#configuring-access-to-object-storage
+	//
+	// The operator performs no validation of the configuration.
+	//
+	// `objectStorageConfigFile` takes precedence over this field.
+	//
+	// +optional
+	ObjectStorageConfig *v1.SecretKeySelector `json:"objectStorageConfig,omitempty"`
+	// objectStorageConfigFile defines the path of the object storage configuration file.
+	//
+	// The configuration format is defined at https://thanos.io/tip/thanos/storage.md/#configuring-access-to-object-storage
+	//
+	// The operator performs no validation of the configuration file.
+	//
+	// This field takes precedence over `objectStorageConfig`.
+	//
+	// +optional
+	ObjectStorageConfigFile *string `json:"objectStorageConfigFile,omitempty"`
+
+	// logLevel for the Thanos component to be configured with.
+	// +kubebuilder:validation:Enum="";debug;info;warn;error
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+	// logFormat for the Thanos component to be configured with.
+	// +kubebuilder:validation:Enum="";logfmt;json
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// web defines the configuration of the web server.
+	// +optional
+	Web *ThanosBucketWebSpec `json:"web,omitempty"`
+
+	// additionalArgs defines how to add additional arguments for the Thanos container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Thanos container which may cause issues if they are invalid or not supported
+	// by the given Thanos version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	// +optional
+	AdditionalArgs []monitoringv1.Argument `json:"additionalArgs,omitempty"`
+}
+
+// ThanosBucketWebSpec defines the configuration of the web server of the
+// Thanos compactor and store gateway.
+// +k8s:openapi-gen=true
+type ThanosBucketWebSpec struct {
+	// +optional
+	monitoringv1.WebConfigFileFields `json:",inline"`
+
+	// operatorClientConfig defines the configuration used by the operator to
+	// scrape the metrics of the Thanos component (e.g. to report the
+	// `BucketSynced` condition).
+	// It is required when the web server verifies client certificates or
+	// requires authentication.
+	// +optional
+	OperatorClientConfig *monitoringv1.WebClientConfig `json:"operatorClientConfig,omitempty"`
+}
+
+// ThanosBucketStatus is the most recent observed status of the ThanosCompactor
+// and ThanosStore objects. Read-only.
+// More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type ThanosBucketStatus struct {
+	// paused defines whether any actions on the underlying managed objects are
+	// being performed. Only delete actions will be performed.
+	// +optional
+	Paused bool `json:"paused"`
+	// replicas defines the total number of non-terminated pods targeted by this object
+	// (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas"`
+	// updatedReplicas defines the total number of non-terminated pods targeted by this object
+	// that have the desired version spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// availableReplicas defines the total number of available pods (ready for at least minReadySeconds)
+	// targeted by this object.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// unavailableReplicas defines the total number of unavailable pods targeted by this object.
+	// +optional
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// conditions defines the current state of the object.
+	//
+	// Besides the `Available` and `Reconciled` conditions, the operator
+	// reports the `BucketSynced` condition (and `CompactionHealthy` for
+	// ThanosCompactor objects) from the metrics exposed by the pods.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []monitoringv1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosCompactorKind    = "ThanosCompactor"
+	ThanosCompactorName    = "thanoscompactors"
+	ThanosCompactorKindKey = "thanoscompactor"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="tc"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos Compactor"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Bucket Synced",type="string",JSONPath=".status.conditions[?(@.type == 'BucketSynced')].status"
+// +kubebuilder:printcolumn:name="Healthy",type="string",JSONPath=".status.conditions[?(@.type == 'CompactionHealthy')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosCompactor` custom resource definition (CRD) defines a desired [Thanos Compactor](https://thanos.io/tip/components/compact.md/) setup to run in a Kubernetes cluster.
+//
+// The operator deploys a single Thanos Compactor pod per object which
+// compacts, downsamples and applies the retention policies to the blocks
+// stored in the object storage bucket.
+type ThanosCompactor struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the desired behavior of the ThanosCompactor. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +required
+	Spec ThanosCompactorSpec `json:"spec"`
+	// status defines the most recent observed status of the ThanosCompactor. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosBucketStatus `json:"status,omitempty"`
+}
+
+// ThanosCompactorList is a list of ThanosCompactors.
+// +k8s:openapi-gen=true
+type ThanosCompactorList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosCompactors
+	// +required
+	Items []ThanosCompactor `json:"items"`
+}
+
+// ThanosCompactorSpec is a specification of the desired behavior of the ThanosCompactor. More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.objectStorageConfig) || has(self.objectStorageConfigFile)",message="either objectStorageConfig or objectStorageConfigFile must be defined"
+type ThanosCompactorSpec struct {
+	CommonThanosBucketFields `json:",inline"`
+
+	// retentionResolution defines how long the blocks are retained in the
+	// bucket for each resolution.
+	// +optional
+	RetentionResolution *ThanosRetentionResolution `json:"retentionResolution,omitempty"`
+
+	// disableDownsampling defines whether the downsampling of the blocks is
+	// disabled.
+	//
+	// Maps to the '--downsampling.disable' CLI arg.
+	// +optional
+	DisableDownsampling bool `json:"disableDownsampling,omitempty"`
+
+	// deduplicationReplicaLabels defines the labels used to deduplicate the
+	// blocks from highly-available sources during the compaction.
+	//
+	// Maps to the '--deduplication.replica-label' CLI args.
+	// +listType:=set
+	// +optional
+	DeduplicationReplicaLabels []string `json:"deduplicationReplicaLabels,omitempty"`
+
+	// deleteDelay defines the time before a block marked for deletion is
+	// deleted from the bucket.
+	//
+	// Maps to the '--delete-delay' CLI arg.
+	// +optional
+	DeleteDelay *monitoringv1.Duration `json:"deleteDelay,omitempty"`
+}
+
+// ThanosRetentionResolution defines the retention of the blocks for each
+// resolution. A value of `0d` retains the blocks forever.
+// +k8s:openapi-gen=true
+type ThanosRetentionResolution struct {
+	// raw defines the retention of the raw samples.
+	//
+	// Maps to the '--retention.resolution-raw' CLI arg.
+	// +optional
+	Raw *monitoringv1.Duration `json:"raw,omitempty"`
+	// fiveMinutes defines the retention of the samples downsampled to 5m.
+	//
+	// Maps to the '--retention.resolution-5m' CLI arg.
+	// +optional
+	FiveMinutes *monitoringv1.Duration `json:"fiveMinutes,omitempty"`
+	// oneHour defines the retention of the samples downsampled to 1h.
+	//
+	// Maps to the '--retention.resolution-1h' CLI arg.
+	// +optional
+	OneHour *monitoringv1.Duration `json:"oneHour,omitempty"`
+}
+
+// ExpectedReplicas returns the number of replicas of the Thanos compactor
+// which is always 1 since only one compactor may run against a bucket.
+func (tc *ThanosCompactor) ExpectedReplicas() int { return 1 }
+
+func (tc *ThanosCompactor) SetReplicas(i int)            { tc.Status.Replicas = int32(i) }
+func (tc *ThanosCompactor) SetUpdatedReplicas(i int)     { tc.Status.UpdatedReplicas = int32(i) }
+func (tc *ThanosCompactor) SetAvailableReplicas(i int)   { tc.Status.AvailableReplicas = int32(i) }
+func (tc *ThanosCompactor) SetUnavailableReplicas(i int) { tc.Status.UnavailableReplicas = int32(i) }
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosCompactor) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosCompactorList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
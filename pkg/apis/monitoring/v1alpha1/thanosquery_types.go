@@ -0,0 +1,325 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosQueryKind    = "ThanosQuery"
+	ThanosQueryName    = "thanosqueries"
+	ThanosQueryKindKey = "thanosquery"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="tq"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos Query"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The number of desired replicas"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosQuery` custom resource definition (CRD) defines a desired [Thanos Query](https://thanos.io/tip/components/query.md/) setup to run in a Kubernetes cluster.
+//
+// The resource defines via label and namespace selectors which `Prometheus`
+// (with the Thanos sidecar) and `ThanosRuler` objects should be queried by
+// the deployed Thanos Query instances. The operator keeps the list of
+// endpoints up-to-date as the selected objects are created, deleted, scaled
+// or resharded.
+type ThanosQuery struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the desired behavior of the ThanosQuery deployment. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +required
+	Spec ThanosQuerySpec `json:"spec"`
+	// status defines the most recent observed status of the ThanosQuery deployment. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosQueryStatus `json:"status,omitempty"`
+}
+
+// ThanosQueryList is a list of ThanosQueries.
+// +k8s:openapi-gen=true
+type ThanosQueryList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosQueries
+	// +required
+	Items []ThanosQuery `json:"items"`
+}
+
+// ThanosQuerySpec is a specification of the desired behavior of the ThanosQuery. More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type ThanosQuerySpec struct {
+	// version of Thanos to be deployed.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// podMetadata defines labels and annotations which are propagated to the ThanosQuery pods.
+	//
+	// The following items are reserved and cannot be overridden:
+	// * "app.kubernetes.io/name" label, set to "thanos-query".
+	// * "app.kubernetes.io/managed-by" label, set to "prometheus-operator".
+	// * "app.kubernetes.io/instance" label, set to the name of the ThanosQuery instance.
+	// * "thanos-query" label, set to the name of the ThanosQuery instance.
+	// * "kubectl.kubernetes.io/default-container" annotation, set to "thanos-query".
+	// +optional
+	PodMetadata *monitoringv1.EmbeddedObjectMetadata `json:"podMetadata,omitempty"`
+
+	// image defines Thanos container image URL.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// imagePullPolicy defines the image pull policy for the 'thanos-query' container.
+	// See https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy for more details.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// imagePullSecrets defines an optional list of references to secrets in the same namespace
+	// to use for pulling thanos images from registries
+	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// paused defines when a ThanosQuery deployment is paused, no actions except for deletion
+	// will be performed on the underlying objects.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// replicas defines the number of Thanos Query instances to deploy.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// nodeSelector defines which Nodes the Pods are scheduled on.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// resources defines the resource requirements for single Pods.
+	// If not provided, no requests/limits will be set
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// affinity defines when specified, the pod's scheduling constraints.
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+
+	// tolerations defines when specified, the pod's tolerations.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// topologySpreadConstraints defines the pod's topology spread constraints.
+	// +optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// securityContext defines the pod-level security attributes and common container settings.
+	// This defaults to the default PodSecurityContext.
+	// +optional
+	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// priorityClassName defines the priority class assigned to the Pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// serviceAccountName defines the name of the ServiceAccount to use to run the
+	// Thanos Query Pods.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// containers allows injecting additional containers or modifying operator generated
+	// containers. This can be used to allow adding an authentication proxy to a ThanosQuery pod or
+	// to change the behavior of an operator generated container. Containers described here modify
+	// an operator generated container if they share the same name and modifications are done via a
+	// strategic merge patch. The current container name is: `thanos-query`.
+	// Overriding containers is entirely outside the scope of what the maintainers will support and by doing
+	// so, you accept that this behaviour may break at any time without notice.
+	// +optional
+	Containers []v1.Container `json:"containers,omitempty"`
+
+	// logLevel for Thanos Query to be configured with.
+	// +kubebuilder:validation:Enum="";debug;info;warn;error
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+	// logFormat for Thanos Query to be configured with.
+	// +kubebuilder:validation:Enum="";logfmt;json
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// prometheusSelector defines the Prometheus objects whose Thanos sidecars
+	// are added to the list of endpoints. The Prometheus objects must define
+	// `spec.thanos` and the sidecar mustn't listen on the loopback interface
+	// for the gRPC endpoints.
+	//
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	// +optional
+	PrometheusSelector *metav1.LabelSelector `json:"prometheusSelector,omitempty"`
+
+	// prometheusNamespaceSelector defines the namespaces to match for the
+	// Prometheus discovery. An empty label selector matches all namespaces. A
+	// null label selector matches the current namespace only.
+	// +optional
+	PrometheusNamespaceSelector *metav1.LabelSelector `json:"prometheusNamespaceSelector,omitempty"`
+
+	// thanosRulerSelector defines the ThanosRuler objects which are added to
+	// the list of endpoints.
+	//
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	// +optional
+	ThanosRulerSelector *metav1.LabelSelector `json:"thanosRulerSelector,omitempty"`
+
+	// thanosRulerNamespaceSelector defines the namespaces to match for the
+	// ThanosRuler discovery. An empty label selector matches all namespaces. A
+	// null label selector matches the current namespace only.
+	// +optional
+	ThanosRulerNamespaceSelector *metav1.LabelSelector `json:"thanosRulerNamespaceSelector,omitempty"`
+
+	// endpoints defines additional static addresses of StoreAPI servers (e.g.
+	// Thanos Store or Thanos Receive) which are added to the discovered
+	// endpoints.
+	//
+	// Maps to the '--endpoint' CLI args.
+	// +listType:=set
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// replicaLabels defines the labels used to deduplicate the series from
+	// highly-available sources.
+	//
+	// Default: ["prometheus_replica", "thanos_ruler_replica"]
+	//
+	// Maps to the '--query.replica-label' CLI args.
+	// +listType:=set
+	// +optional
+	ReplicaLabels []string `json:"replicaLabels,omitempty"`
+
+	// grpcServerTlsConfig defines the TLS parameters of the gRPC server
+	// providing the StoreAPI.
+	//
+	// The certificates can be given either as files in the container (the
+	// `caFile`, `certFile` and `keyFile` fields) or as references to Secrets
+	// and ConfigMaps in the ThanosQuery's namespace.
+	//
+	// Maps to the '--grpc-server-tls-*' CLI args.
+	// +optional
+	GRPCServerTLSConfig *monitoringv1.TLSConfig `json:"grpcServerTlsConfig,omitempty"`
+
+	// grpcClientTlsConfig defines the TLS parameters used to connect to the
+	// endpoints. It should match the `grpcServerTlsConfig` of the selected
+	// Prometheus and ThanosRuler objects.
+	//
+	// The certificates can be given either as files in the container (the
+	// `caFile`, `certFile` and `keyFile` fields) or as references to Secrets
+	// and ConfigMaps in the ThanosQuery's namespace.
+	//
+	// Maps to the '--grpc-client-*' CLI args.
+	// +optional
+	GRPCClientTLSConfig *monitoringv1.TLSConfig `json:"grpcClientTlsConfig,omitempty"`
+
+	// web defines the configuration of the Thanos Query web server.
+	// +optional
+	Web *ThanosQueryWebSpec `json:"web,omitempty"`
+
+	// additionalArgs defines how to add additional arguments for the Thanos Query container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Thanos Query container which may cause issues if they are invalid or not supported
+	// by the given Thanos version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	// +optional
+	AdditionalArgs []monitoringv1.Argument `json:"additionalArgs,omitempty"`
+}
+
+// ThanosQueryWebSpec defines the configuration of the Thanos Query web server.
+// +k8s:openapi-gen=true
+type ThanosQueryWebSpec struct {
+	// +optional
+	monitoringv1.WebConfigFileFields `json:",inline"`
+}
+
+// ThanosQueryStatus is the most recent observed status of the ThanosQuery. Read-only.
+// More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type ThanosQueryStatus struct {
+	// paused defines whether any actions on the underlying managed objects are
+	// being performed. Only delete actions will be performed.
+	// +optional
+	Paused bool `json:"paused"`
+	// replicas defines the total number of non-terminated pods targeted by this ThanosQuery deployment
+	// (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas"`
+	// updatedReplicas defines the total number of non-terminated pods targeted by this ThanosQuery deployment
+	// that have the desired version spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// availableReplicas defines the total number of available pods (ready for at least minReadySeconds)
+	// targeted by this ThanosQuery deployment.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// unavailableReplicas defines the total number of unavailable pods targeted by this ThanosQuery deployment.
+	// +optional
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// conditions defines the current state of the ThanosQuery object.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []monitoringv1.Condition `json:"conditions,omitempty"`
+}
+
+func (tq *ThanosQuery) ExpectedReplicas() int {
+	if tq.Spec.Replicas == nil {
+		return 1
+	}
+	return int(*tq.Spec.Replicas)
+}
+
+func (tq *ThanosQuery) SetReplicas(i int)            { tq.Status.Replicas = int32(i) }
+func (tq *ThanosQuery) SetUpdatedReplicas(i int)     { tq.Status.UpdatedReplicas = int32(i) }
+func (tq *ThanosQuery) SetAvailableReplicas(i int)   { tq.Status.AvailableReplicas = int32(i) }
+func (tq *ThanosQuery) SetUnavailableReplicas(i int) { tq.Status.UnavailableReplicas = int32(i) }
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosQuery) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosQueryList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosStoreKind    = "ThanosStore"
+	ThanosStoreName    = "thanosstores"
+	ThanosStoreKindKey = "thanosstore"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="ts"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos Store"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The number of desired replicas"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Bucket Synced",type="string",JSONPath=".status.conditions[?(@.type == 'BucketSynced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosStore` custom resource definition (CRD) defines a desired [Thanos Store Gateway](https://thanos.io/tip/components/store.md/) setup to run in a Kubernetes cluster.
+//
+// The Thanos Store Gateway exposes the blocks stored in the object storage
+// bucket via the StoreAPI. The operator creates a Service named
+// `thanos-store-<name>` which can be used as an endpoint by Thanos Query.
+type ThanosStore struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the desired behavior of the ThanosStore. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +required
+	Spec ThanosStoreSpec `json:"spec"`
+	// status defines the most recent observed status of the ThanosStore. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosBucketStatus `json:"status,omitempty"`
+}
+
+// ThanosStoreList is a list of ThanosStores.
+// +k8s:openapi-gen=true
+type ThanosStoreList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosStores
+	// +required
+	Items []ThanosStore `json:"items"`
+}
+
+// ThanosStoreSpec is a specification of the desired behavior of the ThanosStore. More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.objectStorageConfig) || has(self.objectStorageConfigFile)",message="either objectStorageConfig or objectStorageConfigFile must be defined"
+type ThanosStoreSpec struct {
+	CommonThanosBucketFields `json:",inline"`
+
+	// replicas defines the number of Thanos Store instances to deploy. All
+	// the replicas serve the same blocks.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// minTime defines the start of the time range served by the store
+	// gateway. The value is either a RFC3339 timestamp or a duration relative
+	// to the current time (e.g. `-2w`).
+	//
+	// Maps to the '--min-time' CLI arg.
+	// +optional
+	MinTime string `json:"minTime,omitempty"`
+
+	// maxTime defines the end of the time range served by the store gateway.
+	// The value is either a RFC3339 timestamp or a duration relative to the
+	// current time (e.g. `-2h`).
+	//
+	// Maps to the '--max-time' CLI arg.
+	// +optional
+	MaxTime string `json:"maxTime,omitempty"`
+
+	// indexCacheConfig defines the configuration of the index cache. The
+	// configuration format is defined at https://thanos.io/tip/components/store.md/#index-cache
+	//
+	// Maps to the '--index-cache.config-file' CLI arg.
+	// +optional
+	IndexCacheConfig *v1.SecretKeySelector `json:"indexCacheConfig,omitempty"`
+
+	// cachingBucketConfig defines the configuration of the caching bucket.
+	// The configuration format is defined at https://thanos.io/tip/components/store.md/#caching-bucket
+	//
+	// Maps to the '--store.caching-bucket.config-file' CLI arg.
+	// +optional
+	CachingBucketConfig *v1.SecretKeySelector `json:"cachingBucketConfig,omitempty"`
+
+	// grpcServerTlsConfig defines the TLS parameters of the gRPC server
+	// providing the StoreAPI.
+	//
+	// The certificates can be given either as files in the container (the
+	// `caFile`, `certFile` and `keyFile` fields) or as references to Secrets
+	// and ConfigMaps in the ThanosStore's namespace.
+	//
+	// Maps to the '--grpc-server-tls-*' CLI args.
+	// +optional
+	GRPCServerTLSConfig *monitoringv1.TLSConfig `json:"grpcServerTlsConfig,omitempty"`
+}
+
+func (ts *ThanosStore) ExpectedReplicas() int {
+	if ts.Spec.Replicas == nil {
+		return 1
+	}
+	return int(*ts.Spec.Replicas)
+}
+
+func (ts *ThanosStore) SetReplicas(i int)            { ts.Status.Replicas = int32(i) }
+func (ts *ThanosStore) SetUpdatedReplicas(i int)     { ts.Status.UpdatedReplicas = int32(i) }
+func (ts *ThanosStore) SetAvailableReplicas(i int)   { ts.Status.AvailableReplicas = int32(i) }
+func (ts *ThanosStore) SetUnavailableReplicas(i int) { ts.Status.UnavailableReplicas = int32(i) }
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosStore) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosStoreList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
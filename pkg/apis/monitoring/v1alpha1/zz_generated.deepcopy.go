@@ -22,14 +22,38 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleTest) DeepCopyInto(out *AlertRuleTest) {
+	*out = *in
+	if in.ExpAlerts != nil {
+		in, out := &in.ExpAlerts, &out.ExpAlerts
+		*out = make([]ExpectedAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleTest.
+func (in *AlertRuleTest) DeepCopy() *AlertRuleTest {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertmanagerConfig) DeepCopyInto(out *AlertmanagerConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfig.
@@ -107,6 +131,196 @@ func (in *AlertmanagerConfigSpec) DeepCopy() *AlertmanagerConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerReceiver) DeepCopyInto(out *AlertmanagerReceiver) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerReceiver.
+func (in *AlertmanagerReceiver) DeepCopy() *AlertmanagerReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerReceiverList) DeepCopyInto(out *AlertmanagerReceiverList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertmanagerReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerReceiverList.
+func (in *AlertmanagerReceiverList) DeepCopy() *AlertmanagerReceiverList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerReceiverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilence) DeepCopyInto(out *AlertmanagerSilence) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilence.
+func (in *AlertmanagerSilence) DeepCopy() *AlertmanagerSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceList) DeepCopyInto(out *AlertmanagerSilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertmanagerSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceList.
+func (in *AlertmanagerSilenceList) DeepCopy() *AlertmanagerSilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceSpec) DeepCopyInto(out *AlertmanagerSilenceSpec) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]Matcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	in.EndsAt.DeepCopyInto(&out.EndsAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceSpec.
+func (in *AlertmanagerSilenceSpec) DeepCopy() *AlertmanagerSilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceStatus) DeepCopyInto(out *AlertmanagerSilenceStatus) {
+	*out = *in
+	if in.Alertmanagers != nil {
+		in, out := &in.Alertmanagers, &out.Alertmanagers
+		*out = make([]SilenceAlertmanagerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceStatus.
+func (in *AlertmanagerSilenceStatus) DeepCopy() *AlertmanagerSilenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerTemplate) DeepCopyInto(out *AlertmanagerTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerTemplate.
+func (in *AlertmanagerTemplate) DeepCopy() *AlertmanagerTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerTemplateList) DeepCopyInto(out *AlertmanagerTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertmanagerTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerTemplateList.
+func (in *AlertmanagerTemplateList) DeepCopy() *AlertmanagerTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerTemplateSpec) DeepCopyInto(out *AlertmanagerTemplateSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerTemplateSpec.
+func (in *AlertmanagerTemplateSpec) DeepCopy() *AlertmanagerTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttachMetadata) DeepCopyInto(out *AttachMetadata) {
 	*out = *in
@@ -213,6 +427,119 @@ func (in *AzureSDConfig) DeepCopy() *AzureSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonThanosBucketFields) DeepCopyInto(out *CommonThanosBucketFields) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = new(monitoringv1.EmbeddedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(monitoringv1.StorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObjectStorageConfig != nil {
+		in, out := &in.ObjectStorageConfig, &out.ObjectStorageConfig
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectStorageConfigFile != nil {
+		in, out := &in.ObjectStorageConfigFile, &out.ObjectStorageConfigFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.Web != nil {
+		in, out := &in.Web, &out.Web
+		*out = new(ThanosBucketWebSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalArgs != nil {
+		in, out := &in.AdditionalArgs, &out.AdditionalArgs
+		*out = make([]monitoringv1.Argument, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonThanosBucketFields.
+func (in *CommonThanosBucketFields) DeepCopy() *CommonThanosBucketFields {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonThanosBucketFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	*out = *in
@@ -788,6 +1115,50 @@ func (in *EurekaSDConfig) DeepCopy() *EurekaSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpectedAlert) DeepCopyInto(out *ExpectedAlert) {
+	*out = *in
+	if in.ExpLabels != nil {
+		in, out := &in.ExpLabels, &out.ExpLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExpAnnotations != nil {
+		in, out := &in.ExpAnnotations, &out.ExpAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpectedAlert.
+func (in *ExpectedAlert) DeepCopy() *ExpectedAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpectedAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpectedSample) DeepCopyInto(out *ExpectedSample) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpectedSample.
+func (in *ExpectedSample) DeepCopy() *ExpectedSample {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpectedSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileSDConfig) DeepCopyInto(out *FileSDConfig) {
 	*out = *in
@@ -1058,10 +1429,55 @@ func (in *HetznerSDConfig) DeepCopy() *HetznerSDConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
+func (in *IncidentIOConfig) DeepCopyInto(out *IncidentIOConfig) {
 	*out = *in
-	if in.TargetMatch != nil {
-		in, out := &in.TargetMatch, &out.TargetMatch
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.URLSecret != nil {
+		in, out := &in.URLSecret, &out.URLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSourceToken != nil {
+		in, out := &in.AlertSourceToken, &out.AlertSourceToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentIOConfig.
+func (in *IncidentIOConfig) DeepCopy() *IncidentIOConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentIOConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
+	*out = *in
+	if in.TargetMatch != nil {
+		in, out := &in.TargetMatch, &out.TargetMatch
 		*out = make([]Matcher, len(*in))
 		copy(*out, *in)
 	}
@@ -1134,6 +1550,83 @@ func (in *IonosSDConfig) DeepCopy() *IonosSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraConfig) DeepCopyInto(out *JiraConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenTransition != nil {
+		in, out := &in.ReopenTransition, &out.ReopenTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveTransition != nil {
+		in, out := &in.ResolveTransition, &out.ResolveTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WontFixResolution != nil {
+		in, out := &in.WontFixResolution, &out.WontFixResolution
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenDuration != nil {
+		in, out := &in.ReopenDuration, &out.ReopenDuration
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]v1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraConfig.
+func (in *JiraConfig) DeepCopy() *JiraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SSelectorConfig) DeepCopyInto(out *K8SSelectorConfig) {
 	*out = *in
@@ -1926,6 +2419,26 @@ func (in *PagerDutyLinkConfig) DeepCopy() *PagerDutyLinkConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromQLExprTest) DeepCopyInto(out *PromQLExprTest) {
+	*out = *in
+	if in.ExpSamples != nil {
+		in, out := &in.ExpSamples, &out.ExpSamples
+		*out = make([]ExpectedSample, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromQLExprTest.
+func (in *PromQLExprTest) DeepCopy() *PromQLExprTest {
+	if in == nil {
+		return nil
+	}
+	out := new(PromQLExprTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusAgent) DeepCopyInto(out *PrometheusAgent) {
 	*out = *in
@@ -1990,6 +2503,125 @@ func (in *PrometheusAgentSpec) DeepCopy() *PrometheusAgentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRuleTest) DeepCopyInto(out *PrometheusRuleTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleTest.
+func (in *PrometheusRuleTest) DeepCopy() *PrometheusRuleTest {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRuleTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRuleTestList) DeepCopyInto(out *PrometheusRuleTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PrometheusRuleTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleTestList.
+func (in *PrometheusRuleTestList) DeepCopy() *PrometheusRuleTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRuleTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRuleTestSpec) DeepCopyInto(out *PrometheusRuleTestSpec) {
+	*out = *in
+	if in.RuleSelector != nil {
+		in, out := &in.RuleSelector, &out.RuleSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvaluationInterval != nil {
+		in, out := &in.EvaluationInterval, &out.EvaluationInterval
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.GroupEvalOrder != nil {
+		in, out := &in.GroupEvalOrder, &out.GroupEvalOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FuzzyCompare != nil {
+		in, out := &in.FuzzyCompare, &out.FuzzyCompare
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]RuleTestGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleTestSpec.
+func (in *PrometheusRuleTestSpec) DeepCopy() *PrometheusRuleTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRuleTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRuleTestStatus) DeepCopyInto(out *PrometheusRuleTestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]monitoringv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]RuleTestGroupResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleTestStatus.
+func (in *PrometheusRuleTestStatus) DeepCopy() *PrometheusRuleTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRuleTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PuppetDBSDConfig) DeepCopyInto(out *PuppetDBSDConfig) {
 	*out = *in
@@ -2214,6 +2846,25 @@ func (in *Receiver) DeepCopyInto(out *Receiver) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.JiraConfigs != nil {
+		in, out := &in.JiraConfigs, &out.JiraConfigs
+		*out = make([]JiraConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IncidentIOConfigs != nil {
+		in, out := &in.IncidentIOConfigs, &out.IncidentIOConfigs
+		*out = make([]IncidentIOConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(monitoringv1.NotificationRateLimit)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
@@ -2430,62 +3081,143 @@ func (in *Route) DeepCopy() *Route {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SNSConfig) DeepCopyInto(out *SNSConfig) {
+func (in *RuleTestGroup) DeepCopyInto(out *RuleTestGroup) {
 	*out = *in
-	if in.SendResolved != nil {
-		in, out := &in.SendResolved, &out.SendResolved
-		*out = new(bool)
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(monitoringv1.Duration)
 		**out = **in
 	}
-	if in.Sigv4 != nil {
-		in, out := &in.Sigv4, &out.Sigv4
-		*out = new(monitoringv1.Sigv4)
-		(*in).DeepCopyInto(*out)
+	if in.InputSeries != nil {
+		in, out := &in.InputSeries, &out.InputSeries
+		*out = make([]RuleTestInputSeries, len(*in))
+		copy(*out, *in)
 	}
-	if in.Attributes != nil {
-		in, out := &in.Attributes, &out.Attributes
+	if in.AlertRuleTests != nil {
+		in, out := &in.AlertRuleTests, &out.AlertRuleTests
+		*out = make([]AlertRuleTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PromQLExprTests != nil {
+		in, out := &in.PromQLExprTests, &out.PromQLExprTests
+		*out = make([]PromQLExprTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalLabels != nil {
+		in, out := &in.ExternalLabels, &out.ExternalLabels
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.HTTPConfig != nil {
-		in, out := &in.HTTPConfig, &out.HTTPConfig
-		*out = new(HTTPConfig)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSConfig.
-func (in *SNSConfig) DeepCopy() *SNSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestGroup.
+func (in *RuleTestGroup) DeepCopy() *RuleTestGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(SNSConfig)
+	out := new(RuleTestGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
+func (in *RuleTestGroupResult) DeepCopyInto(out *RuleTestGroupResult) {
 	*out = *in
-	in.SecretKey.DeepCopyInto(&out.SecretKey)
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
-		**out = **in
-	}
-	if in.ApiURL != nil {
-		in, out := &in.ApiURL, &out.ApiURL
-		*out = new(string)
-		**out = **in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Zone != nil {
-		in, out := &in.Zone, &out.Zone
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestGroupResult.
+func (in *RuleTestGroupResult) DeepCopy() *RuleTestGroupResult {
+	if in == nil {
+		return nil
 	}
-	if in.NameFilter != nil {
+	out := new(RuleTestGroupResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleTestInputSeries) DeepCopyInto(out *RuleTestInputSeries) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestInputSeries.
+func (in *RuleTestInputSeries) DeepCopy() *RuleTestInputSeries {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleTestInputSeries)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSConfig) DeepCopyInto(out *SNSConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sigv4 != nil {
+		in, out := &in.Sigv4, &out.Sigv4
+		*out = new(monitoringv1.Sigv4)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSConfig.
+func (in *SNSConfig) DeepCopy() *SNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
+	*out = *in
+	in.SecretKey.DeepCopyInto(&out.SecretKey)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ApiURL != nil {
+		in, out := &in.ApiURL, &out.ApiURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameFilter != nil {
 		in, out := &in.NameFilter, &out.NameFilter
 		*out = new(string)
 		**out = **in
@@ -2904,6 +3636,25 @@ func (in *ScrapeConfigSpec) DeepCopy() *ScrapeConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceAlertmanagerStatus) DeepCopyInto(out *SilenceAlertmanagerStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceAlertmanagerStatus.
+func (in *SilenceAlertmanagerStatus) DeepCopy() *SilenceAlertmanagerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceAlertmanagerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlackAction) DeepCopyInto(out *SlackAction) {
 	*out = *in
@@ -3080,6 +3831,442 @@ func (in *TelegramConfig) DeepCopy() *TelegramConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosBucketStatus) DeepCopyInto(out *ThanosBucketStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]monitoringv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosBucketStatus.
+func (in *ThanosBucketStatus) DeepCopy() *ThanosBucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosBucketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosBucketWebSpec) DeepCopyInto(out *ThanosBucketWebSpec) {
+	*out = *in
+	in.WebConfigFileFields.DeepCopyInto(&out.WebConfigFileFields)
+	if in.OperatorClientConfig != nil {
+		in, out := &in.OperatorClientConfig, &out.OperatorClientConfig
+		*out = new(monitoringv1.WebClientConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosBucketWebSpec.
+func (in *ThanosBucketWebSpec) DeepCopy() *ThanosBucketWebSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosBucketWebSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactor) DeepCopyInto(out *ThanosCompactor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactor.
+func (in *ThanosCompactor) DeepCopy() *ThanosCompactor {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactorList) DeepCopyInto(out *ThanosCompactorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosCompactor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactorList.
+func (in *ThanosCompactorList) DeepCopy() *ThanosCompactorList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactorSpec) DeepCopyInto(out *ThanosCompactorSpec) {
+	*out = *in
+	in.CommonThanosBucketFields.DeepCopyInto(&out.CommonThanosBucketFields)
+	if in.RetentionResolution != nil {
+		in, out := &in.RetentionResolution, &out.RetentionResolution
+		*out = new(ThanosRetentionResolution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeduplicationReplicaLabels != nil {
+		in, out := &in.DeduplicationReplicaLabels, &out.DeduplicationReplicaLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeleteDelay != nil {
+		in, out := &in.DeleteDelay, &out.DeleteDelay
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactorSpec.
+func (in *ThanosCompactorSpec) DeepCopy() *ThanosCompactorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuery) DeepCopyInto(out *ThanosQuery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuery.
+func (in *ThanosQuery) DeepCopy() *ThanosQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQueryList) DeepCopyInto(out *ThanosQueryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosQuery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQueryList.
+func (in *ThanosQueryList) DeepCopy() *ThanosQueryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQueryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerySpec) DeepCopyInto(out *ThanosQuerySpec) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = new(monitoringv1.EmbeddedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrometheusSelector != nil {
+		in, out := &in.PrometheusSelector, &out.PrometheusSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusNamespaceSelector != nil {
+		in, out := &in.PrometheusNamespaceSelector, &out.PrometheusNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosRulerSelector != nil {
+		in, out := &in.ThanosRulerSelector, &out.ThanosRulerSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosRulerNamespaceSelector != nil {
+		in, out := &in.ThanosRulerNamespaceSelector, &out.ThanosRulerNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicaLabels != nil {
+		in, out := &in.ReplicaLabels, &out.ReplicaLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPCServerTLSConfig != nil {
+		in, out := &in.GRPCServerTLSConfig, &out.GRPCServerTLSConfig
+		*out = new(monitoringv1.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCClientTLSConfig != nil {
+		in, out := &in.GRPCClientTLSConfig, &out.GRPCClientTLSConfig
+		*out = new(monitoringv1.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Web != nil {
+		in, out := &in.Web, &out.Web
+		*out = new(ThanosQueryWebSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalArgs != nil {
+		in, out := &in.AdditionalArgs, &out.AdditionalArgs
+		*out = make([]monitoringv1.Argument, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerySpec.
+func (in *ThanosQuerySpec) DeepCopy() *ThanosQuerySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQueryStatus) DeepCopyInto(out *ThanosQueryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]monitoringv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQueryStatus.
+func (in *ThanosQueryStatus) DeepCopy() *ThanosQueryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQueryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQueryWebSpec) DeepCopyInto(out *ThanosQueryWebSpec) {
+	*out = *in
+	in.WebConfigFileFields.DeepCopyInto(&out.WebConfigFileFields)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQueryWebSpec.
+func (in *ThanosQueryWebSpec) DeepCopy() *ThanosQueryWebSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQueryWebSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosRetentionResolution) DeepCopyInto(out *ThanosRetentionResolution) {
+	*out = *in
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.FiveMinutes != nil {
+		in, out := &in.FiveMinutes, &out.FiveMinutes
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+	if in.OneHour != nil {
+		in, out := &in.OneHour, &out.OneHour
+		*out = new(monitoringv1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRetentionResolution.
+func (in *ThanosRetentionResolution) DeepCopy() *ThanosRetentionResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosRetentionResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStore) DeepCopyInto(out *ThanosStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStore.
+func (in *ThanosStore) DeepCopy() *ThanosStore {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStoreList) DeepCopyInto(out *ThanosStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStoreList.
+func (in *ThanosStoreList) DeepCopy() *ThanosStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStoreSpec) DeepCopyInto(out *ThanosStoreSpec) {
+	*out = *in
+	in.CommonThanosBucketFields.DeepCopyInto(&out.CommonThanosBucketFields)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IndexCacheConfig != nil {
+		in, out := &in.IndexCacheConfig, &out.IndexCacheConfig
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CachingBucketConfig != nil {
+		in, out := &in.CachingBucketConfig, &out.CachingBucketConfig
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCServerTLSConfig != nil {
+		in, out := &in.GRPCServerTLSConfig, &out.GRPCServerTLSConfig
+		*out = new(monitoringv1.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStoreSpec.
+func (in *ThanosStoreSpec) DeepCopy() *ThanosStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeInterval) DeepCopyInto(out *TimeInterval) {
 	*out = *in
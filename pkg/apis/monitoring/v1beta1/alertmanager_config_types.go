@@ -42,6 +42,7 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="amcfg"
+// +kubebuilder:subresource:status
 
 // The `AlertmanagerConfig` custom resource definition (CRD) defines how `Alertmanager` objects process Prometheus alerts. It allows to specify alert grouping and routing, notification receivers and inhibition rules.
 //
@@ -55,6 +56,18 @@ type AlertmanagerConfig struct {
 	// spec defines the specification of AlertmanagerConfigSpec
 	// +required
 	Spec AlertmanagerConfigSpec `json:"spec"`
+	// status defines the status subresource. It is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the AlertmanagerConfig. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status monitoringv1.ConfigResourceStatus `json:"status,omitempty,omitzero"`
+}
+
+func (l *AlertmanagerConfig) Bindings() []monitoringv1.WorkloadBinding {
+	return l.Status.Bindings
 }
 
 // AlertmanagerConfigList is a list of AlertmanagerConfig.
@@ -95,6 +108,14 @@ type Route struct {
 	// the `receivers` field.
 	// +optional
 	Receiver string `json:"receiver"`
+	// sharedReceiver defines the AlertmanagerReceiver object used as the
+	// receiver for this route, as `<namespace>/<name>`.
+	// The namespace must be listed in the `alertmanagerReceiverNamespaces`
+	// field of the Alertmanager resource.
+	// It is mutually exclusive with `receiver`.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	SharedReceiver string `json:"sharedReceiver,omitempty"`
 	// groupBy defines the list of labels to group by.
 	// Labels must not be repeated (unique list).
 	// Special label "..." (aggregate by all possible labels), if provided, must be the only element in the list.
@@ -138,9 +159,13 @@ type Route struct {
 	// JSON representation.
 
 	// muteTimeIntervals is a list of MuteTimeInterval names that will mute this route when matched,
+	// The time intervals exported by AlertmanagerConfig resources from other
+	// namespaces are referenced as `<namespace>/<alertmanagerconfig>/<name>`.
 	// +optional
 	MuteTimeIntervals []string `json:"muteTimeIntervals,omitempty"`
 	// activeTimeIntervals is a list of TimeInterval names when this route should be active.
+	// The time intervals exported by AlertmanagerConfig resources from other
+	// namespaces are referenced as `<namespace>/<alertmanagerconfig>/<name>`.
 	// +optional
 	ActiveTimeIntervals []string `json:"activeTimeIntervals,omitempty"`
 }
@@ -214,6 +239,21 @@ type Receiver struct {
 	// It requires Alertmanager >= 0.28.0.
 	// +optional
 	RocketChatConfigs []RocketChatConfig `json:"rocketchatConfigs,omitempty"`
+	// jiraConfigs defines the list of Jira configurations.
+	// It requires Alertmanager >= 0.28.0.
+	// +optional
+	JiraConfigs []JiraConfig `json:"jiraConfigs,omitempty"`
+	// incidentioConfigs defines the list of incident.io configurations.
+	// It requires Alertmanager >= 0.29.0.
+	// +optional
+	IncidentIOConfigs []IncidentIOConfig `json:"incidentioConfigs,omitempty"`
+	// rateLimit defines the maximum notification rate of the receiver for
+	// each alert group. It is enforced by raising the `groupInterval` and
+	// `repeatInterval` values of the routes using the receiver.
+	// The limits defined in the Alertmanager resource take precedence if they
+	// are stricter.
+	// +optional
+	RateLimit *monitoringv1.NotificationRateLimit `json:"rateLimit,omitempty"`
 }
 
 // PagerDutyConfig configures notifications via PagerDuty.
@@ -1277,6 +1317,107 @@ type RocketChatActionConfig struct {
 	Msg *string `json:"msg,omitempty"`
 }
 
+// JiraConfig configures notifications via Jira.
+// See https://prometheus.io/docs/alerting/latest/configuration/#jira_config
+// It requires Alertmanager >= 0.28.0.
+type JiraConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// apiURL defines the URL of the Jira API.
+	// If not specified, the global Jira API URL of the Alertmanager configuration is used.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+	// project defines the key of the Jira project where issues are created.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Project string `json:"project"`
+	// issueType defines the type of the created issues (e.g. "Bug").
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	IssueType string `json:"issueType"`
+	// summary defines the template of the issue's summary.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Summary *string `json:"summary,omitempty"`
+	// description defines the template of the issue's description.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// labels defines the labels attached to the issue.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// priority defines the template of the issue's priority.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Priority *string `json:"priority,omitempty"`
+	// reopenTransition defines the name of the workflow transition used to
+	// reopen a resolved issue.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ReopenTransition *string `json:"reopenTransition,omitempty"`
+	// resolveTransition defines the name of the workflow transition used to
+	// resolve an issue when the alert is resolved.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ResolveTransition *string `json:"resolveTransition,omitempty"`
+	// wontFixResolution defines the resolution of issues which shouldn't be
+	// reopened.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	WontFixResolution *string `json:"wontFixResolution,omitempty"`
+	// reopenDuration defines the duration after which a resolved issue isn't
+	// reopened anymore and a new issue is created instead.
+	// +optional
+	ReopenDuration *monitoringv1.Duration `json:"reopenDuration,omitempty"`
+	// fields defines additional fields set on the issue (e.g. custom fields).
+	// The values can be any JSON value.
+	// +optional
+	Fields map[string]apiextensionsv1.JSON `json:"fields,omitempty"`
+	// httpConfig defines the HTTP client configuration.
+	// The credentials of the Jira API are typically configured with
+	// httpConfig.basicAuth.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// IncidentIOConfig configures notifications via incident.io.
+// See https://prometheus.io/docs/alerting/latest/configuration/#incidentio_config
+// It requires Alertmanager >= 0.29.0.
+type IncidentIOConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// url defines the URL of the incident.io alert source.
+	// Either `url` or `urlSecret` is required.
+	// +optional
+	URL *URL `json:"url,omitempty"`
+	// urlSecret defines the secret's key that contains the URL of the
+	// incident.io alert source.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// Either `url` or `urlSecret` is required.
+	// +optional
+	URLSecret *SecretKeySelector `json:"urlSecret,omitempty"`
+	// alertSourceToken defines the secret's key that contains the token
+	// authenticating against the incident.io alert source.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	AlertSourceToken *SecretKeySelector `json:"alertSourceToken,omitempty"`
+	// maxAlerts defines the maximum number of alerts to be sent per
+	// notification. 0 means no limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAlerts int32 `json:"maxAlerts,omitempty"`
+	// timeout defines the maximum duration of a request to incident.io.
+	// +optional
+	Timeout *monitoringv1.Duration `json:"timeout,omitempty"`
+	// httpConfig defines the HTTP client configuration.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
 // InhibitRule defines an inhibition rule that allows to mute alerts when other
 // alerts are already firing.
 // See https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule
@@ -1295,6 +1436,15 @@ type InhibitRule struct {
 	// for the inhibition to take effect. This ensures related alerts are properly grouped.
 	// +optional
 	Equal []string `json:"equal,omitempty"`
+	// clusterWide defines whether the inhibition rule applies to the alerts
+	// of all namespaces. When false (default), the operator enforces that the
+	// source and target alerts match the resource's namespace.
+	//
+	// The resource's namespace must be listed in
+	// `alertmanagerConfigSharingNamespaces` of the Alertmanager resource
+	// otherwise the resource is rejected.
+	// +optional
+	ClusterWide bool `json:"clusterWide,omitempty"`
 }
 
 // KeyValue defines a (key, value) tuple.
@@ -1421,6 +1571,15 @@ type TimeInterval struct {
 	// timeIntervals defines a list of TimePeriod.
 	// +optional
 	TimeIntervals []TimePeriod `json:"timeIntervals,omitempty"`
+	// exported defines whether the routes of AlertmanagerConfig resources
+	// from other namespaces can reference the time interval as
+	// `<namespace>/<alertmanagerconfig>/<name>`.
+	//
+	// The resource's namespace must be listed in
+	// `alertmanagerConfigSharingNamespaces` of the Alertmanager resource
+	// otherwise the resource is rejected.
+	// +optional
+	Exported bool `json:"exported,omitempty"`
 }
 
 // TimePeriod describes periods of time.
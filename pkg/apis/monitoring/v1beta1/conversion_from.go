@@ -32,6 +32,7 @@ func convertRouteFrom(in *v1alpha1.Route) (*Route, error) {
 
 	out := &Route{
 		Receiver:            in.Receiver,
+		SharedReceiver:      in.SharedReceiver,
 		Continue:            in.Continue,
 		GroupBy:             in.GroupBy,
 		GroupWait:           in.GroupWait,
@@ -536,15 +537,48 @@ func convertMSTeamsV2ConfigFrom(in v1alpha1.MSTeamsV2Config) MSTeamsV2Config {
 	}
 }
 
+func convertJiraConfigFrom(in v1alpha1.JiraConfig) JiraConfig {
+	return JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*URL)(in.APIURL),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            in.Fields,
+		HTTPConfig:        convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
+func convertIncidentIOConfigFrom(in v1alpha1.IncidentIOConfig) IncidentIOConfig {
+	return IncidentIOConfig{
+		SendResolved:     in.SendResolved,
+		URL:              (*URL)(in.URL),
+		URLSecret:        convertSecretKeySelectorFrom(in.URLSecret),
+		AlertSourceToken: convertSecretKeySelectorFrom(in.AlertSourceToken),
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
 // ConvertFrom converts from the Hub version (v1alpha1) to this version (v1beta1).
 func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*v1alpha1.AlertmanagerConfig)
 
 	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
 
 	for _, in := range src.Spec.Receivers {
 		out := Receiver{
-			Name: in.Name,
+			Name:      in.Name,
+			RateLimit: in.RateLimit,
 		}
 
 		for _, in := range in.OpsGenieConfigs {
@@ -652,6 +686,20 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.IncidentIOConfigs {
+			out.IncidentIOConfigs = append(
+				out.IncidentIOConfigs,
+				convertIncidentIOConfigFrom(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -662,6 +710,7 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 				TargetMatch: convertMatchersFrom(in.TargetMatch),
 				SourceMatch: convertMatchersFrom(in.SourceMatch),
 				Equal:       in.Equal,
+				ClusterWide: in.ClusterWide,
 			},
 		)
 	}
@@ -672,6 +721,7 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 			TimeInterval{
 				Name:          in.Name,
 				TimeIntervals: convertTimeIntervalsFrom(in.TimeIntervals),
+				Exported:      in.Exported,
 			},
 		)
 	}
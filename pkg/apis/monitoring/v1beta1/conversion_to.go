@@ -32,6 +32,7 @@ func convertRouteTo(in *Route) (*v1alpha1.Route, error) {
 
 	out := &v1alpha1.Route{
 		Receiver:            in.Receiver,
+		SharedReceiver:      in.SharedReceiver,
 		Continue:            in.Continue,
 		GroupBy:             in.GroupBy,
 		GroupWait:           in.GroupWait,
@@ -532,15 +533,48 @@ func convertMSTeamsV2ConfigTo(in MSTeamsV2Config) v1alpha1.MSTeamsV2Config {
 	}
 }
 
+func convertJiraConfigTo(in JiraConfig) v1alpha1.JiraConfig {
+	return v1alpha1.JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*v1alpha1.URL)(in.APIURL),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            in.Fields,
+		HTTPConfig:        convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
+func convertIncidentIOConfigTo(in IncidentIOConfig) v1alpha1.IncidentIOConfig {
+	return v1alpha1.IncidentIOConfig{
+		SendResolved:     in.SendResolved,
+		URL:              (*v1alpha1.URL)(in.URL),
+		URLSecret:        convertSecretKeySelectorTo(in.URLSecret),
+		AlertSourceToken: convertSecretKeySelectorTo(in.AlertSourceToken),
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
 // ConvertTo converts from this version (v1beta1) to the Hub version (v1alpha1).
 func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1alpha1.AlertmanagerConfig)
 
 	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
 
 	for _, in := range src.Spec.Receivers {
 		out := v1alpha1.Receiver{
-			Name: in.Name,
+			Name:      in.Name,
+			RateLimit: in.RateLimit,
 		}
 
 		for _, in := range in.OpsGenieConfigs {
@@ -648,6 +682,20 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigTo(in),
+			)
+		}
+
+		for _, in := range in.IncidentIOConfigs {
+			out.IncidentIOConfigs = append(
+				out.IncidentIOConfigs,
+				convertIncidentIOConfigTo(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -658,6 +706,7 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 				TargetMatch: convertMatchersTo(in.TargetMatch),
 				SourceMatch: convertMatchersTo(in.SourceMatch),
 				Equal:       in.Equal,
+				ClusterWide: in.ClusterWide,
 			},
 		)
 
@@ -669,6 +718,7 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 			v1alpha1.MuteTimeInterval{
 				Name:          in.Name,
 				TimeIntervals: convertTimeIntervalsTo(in.TimeIntervals),
+				Exported:      in.Exported,
 			},
 		)
 	}
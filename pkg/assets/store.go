@@ -43,6 +43,9 @@ type StoreBuilder struct {
 	objStore   cache.Store
 	refTracker RefTracker
 
+	// When true, the referenced ConfigMaps and Secrets aren't fetched.
+	offline bool
+
 	tlsAssetKeys map[tlsAssetKey]struct{}
 }
 
@@ -69,6 +72,17 @@ func NewStoreBuilder(cmClient corev1client.ConfigMapsGetter, sClient corev1clien
 	return sb
 }
 
+// NewOfflineStoreBuilder returns a *StoreBuilder which doesn't fetch the
+// referenced ConfigMaps and Secrets: every reference resolves to an empty
+// value. It is useful to validate objects when the Kubernetes API isn't
+// reachable (e.g. from the admission webhook).
+func NewOfflineStoreBuilder() *StoreBuilder {
+	sb := newStoreBuilder()
+	sb.offline = true
+
+	return sb
+}
+
 func newStoreBuilder() *StoreBuilder {
 	return &StoreBuilder{
 		objStore:     cache.NewStore(assetKeyFunc),
@@ -287,6 +301,10 @@ func (s *StoreBuilder) GetConfigMapKey(ctx context.Context, namespace string, se
 		},
 	}
 	s.refTracker.insert(cm)
+	if s.offline {
+		return "", nil
+	}
+
 	obj, exists, err := s.objStore.Get(cm)
 	if err != nil {
 		return "", fmt.Errorf("unexpected store error when getting configmap %q: %w", sel.Name, err)
@@ -324,6 +342,10 @@ func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel v
 		},
 	}
 	s.refTracker.insert(sec)
+	if s.offline {
+		return "", nil
+	}
+
 	obj, exists, err := s.objStore.Get(sec)
 	if err != nil {
 		return "", fmt.Errorf("unexpected store error when getting secret %q: %w", sel.Name, err)
@@ -141,6 +141,27 @@ func TestGetSecretKey(t *testing.T) {
 	}
 }
 
+func TestOfflineStoreBuilder(t *testing.T) {
+	store := NewOfflineStoreBuilder()
+
+	s, err := store.GetSecretKey(context.Background(), "ns1", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+		Key:                  "key1",
+	})
+	require.NoError(t, err)
+	require.Empty(t, s)
+
+	s, err = store.GetConfigMapKey(context.Background(), "ns1", v1.ConfigMapKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "configmap"},
+		Key:                  "key1",
+	})
+	require.NoError(t, err)
+	require.Empty(t, s)
+
+	_, err = store.GetSecretKey(context.Background(), "", v1.SecretKeySelector{})
+	require.Error(t, err)
+}
+
 func TestAddBasicAuth(t *testing.T) {
 	c := fake.NewSimpleClientset(
 		&v1.Secret{
@@ -64,6 +64,7 @@ type AlertmanagerSpecApplyConfiguration struct {
 	InitContainers                       []corev1.Container                                      `json:"initContainers,omitempty"`
 	PriorityClassName                    *string                                                 `json:"priorityClassName,omitempty"`
 	AdditionalPeers                      []string                                                `json:"additionalPeers,omitempty"`
+	PeerDiscovery                        []AlertmanagerPeerDiscoveryApplyConfiguration           `json:"peerDiscovery,omitempty"`
 	ClusterAdvertiseAddress              *string                                                 `json:"clusterAdvertiseAddress,omitempty"`
 	ClusterGossipInterval                *monitoringv1.GoDuration                                `json:"clusterGossipInterval,omitempty"`
 	ClusterLabel                         *string                                                 `json:"clusterLabel,omitempty"`
@@ -74,6 +75,13 @@ type AlertmanagerSpecApplyConfiguration struct {
 	AlertmanagerConfigSelector           *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigSelector,omitempty"`
 	AlertmanagerConfigNamespaceSelector  *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigNamespaceSelector,omitempty"`
 	AlertmanagerConfigMatcherStrategy    *AlertmanagerConfigMatcherStrategyApplyConfiguration    `json:"alertmanagerConfigMatcherStrategy,omitempty"`
+	AlertmanagerConfigRateLimits         *AlertmanagerConfigRateLimitsApplyConfiguration         `json:"alertmanagerConfigRateLimits,omitempty"`
+	AlertmanagerConfigSharingNamespaces  []string                                                `json:"alertmanagerConfigSharingNamespaces,omitempty"`
+	AlertmanagerReceiverNamespaces       []string                                                `json:"alertmanagerReceiverNamespaces,omitempty"`
+	SilenceSelector                      *metav1.LabelSelectorApplyConfiguration                 `json:"silenceSelector,omitempty"`
+	SilenceNamespaceSelector             *metav1.LabelSelectorApplyConfiguration                 `json:"silenceNamespaceSelector,omitempty"`
+	TemplateSelector                     *metav1.LabelSelectorApplyConfiguration                 `json:"templateSelector,omitempty"`
+	TemplateNamespaceSelector            *metav1.LabelSelectorApplyConfiguration                 `json:"templateNamespaceSelector,omitempty"`
 	MinReadySeconds                      *int32                                                  `json:"minReadySeconds,omitempty"`
 	HostAliases                          []HostAliasApplyConfiguration                           `json:"hostAliases,omitempty"`
 	Web                                  *AlertmanagerWebSpecApplyConfiguration                  `json:"web,omitempty"`
@@ -423,6 +431,19 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAdditionalPeers(values ...strin
 	return b
 }
 
+// WithPeerDiscovery adds the given value to the PeerDiscovery field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PeerDiscovery field.
+func (b *AlertmanagerSpecApplyConfiguration) WithPeerDiscovery(values ...*AlertmanagerPeerDiscoveryApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPeerDiscovery")
+		}
+		b.PeerDiscovery = append(b.PeerDiscovery, *values[i])
+	}
+	return b
+}
+
 // WithClusterAdvertiseAddress sets the ClusterAdvertiseAddress field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClusterAdvertiseAddress field is set to the value of the last call.
@@ -503,6 +524,66 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigMatcherStrate
 	return b
 }
 
+// WithAlertmanagerConfigRateLimits sets the AlertmanagerConfigRateLimits field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerConfigRateLimits field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigRateLimits(value *AlertmanagerConfigRateLimitsApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.AlertmanagerConfigRateLimits = value
+	return b
+}
+
+// WithAlertmanagerConfigSharingNamespaces adds the given value to the AlertmanagerConfigSharingNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AlertmanagerConfigSharingNamespaces field.
+func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigSharingNamespaces(values ...string) *AlertmanagerSpecApplyConfiguration {
+	for i := range values {
+		b.AlertmanagerConfigSharingNamespaces = append(b.AlertmanagerConfigSharingNamespaces, values[i])
+	}
+	return b
+}
+
+// WithAlertmanagerReceiverNamespaces adds the given value to the AlertmanagerReceiverNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AlertmanagerReceiverNamespaces field.
+func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerReceiverNamespaces(values ...string) *AlertmanagerSpecApplyConfiguration {
+	for i := range values {
+		b.AlertmanagerReceiverNamespaces = append(b.AlertmanagerReceiverNamespaces, values[i])
+	}
+	return b
+}
+
+// WithSilenceSelector sets the SilenceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilenceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithSilenceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.SilenceSelector = value
+	return b
+}
+
+// WithSilenceNamespaceSelector sets the SilenceNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilenceNamespaceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithSilenceNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.SilenceNamespaceSelector = value
+	return b
+}
+
+// WithTemplateSelector sets the TemplateSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TemplateSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithTemplateSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.TemplateSelector = value
+	return b
+}
+
+// WithTemplateNamespaceSelector sets the TemplateNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TemplateNamespaceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithTemplateNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.TemplateNamespaceSelector = value
+	return b
+}
+
 // WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MinReadySeconds field is set to the value of the last call.
@@ -20,8 +20,9 @@ package v1
 // with apply.
 type AlertmanagerWebSpecApplyConfiguration struct {
 	WebConfigFileFieldsApplyConfiguration `json:",inline"`
-	GetConcurrency                        *uint32 `json:"getConcurrency,omitempty"`
-	Timeout                               *uint32 `json:"timeout,omitempty"`
+	GetConcurrency                        *uint32                            `json:"getConcurrency,omitempty"`
+	Timeout                               *uint32                            `json:"timeout,omitempty"`
+	OperatorClientConfig                  *WebClientConfigApplyConfiguration `json:"operatorClientConfig,omitempty"`
 }
 
 // AlertmanagerWebSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerWebSpec type for use with
@@ -61,3 +62,11 @@ func (b *AlertmanagerWebSpecApplyConfiguration) WithTimeout(value uint32) *Alert
 	b.Timeout = &value
 	return b
 }
+
+// WithOperatorClientConfig sets the OperatorClientConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatorClientConfig field is set to the value of the last call.
+func (b *AlertmanagerWebSpecApplyConfiguration) WithOperatorClientConfig(value *WebClientConfigApplyConfiguration) *AlertmanagerWebSpecApplyConfiguration {
+	b.OperatorClientConfig = value
+	return b
+}
@@ -42,6 +42,8 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	ImagePullSecrets                     []corev1.LocalObjectReference                           `json:"imagePullSecrets,omitempty"`
 	Replicas                             *int32                                                  `json:"replicas,omitempty"`
 	Shards                               *int32                                                  `json:"shards,omitempty"`
+	ShardingStrategy                     *monitoringv1.ShardingStrategy                          `json:"shardingStrategy,omitempty"`
+	ShardAutoscaling                     *ShardAutoscalingApplyConfiguration                     `json:"shardAutoscaling,omitempty"`
 	ReplicaExternalLabelName             *string                                                 `json:"replicaExternalLabelName,omitempty"`
 	PrometheusExternalLabelName          *string                                                 `json:"prometheusExternalLabelName,omitempty"`
 	LogLevel                             *string                                                 `json:"logLevel,omitempty"`
@@ -262,6 +264,22 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithShards(value int32) *Comm
 	return b
 }
 
+// WithShardingStrategy sets the ShardingStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardingStrategy field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithShardingStrategy(value monitoringv1.ShardingStrategy) *CommonPrometheusFieldsApplyConfiguration {
+	b.ShardingStrategy = &value
+	return b
+}
+
+// WithShardAutoscaling sets the ShardAutoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardAutoscaling field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithShardAutoscaling(value *ShardAutoscalingApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.ShardAutoscaling = value
+	return b
+}
+
 // WithReplicaExternalLabelName sets the ReplicaExternalLabelName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ReplicaExternalLabelName field is set to the value of the last call.
@@ -188,6 +188,22 @@ func (b *PrometheusSpecApplyConfiguration) WithShards(value int32) *PrometheusSp
 	return b
 }
 
+// WithShardingStrategy sets the ShardingStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardingStrategy field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithShardingStrategy(value monitoringv1.ShardingStrategy) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ShardingStrategy = &value
+	return b
+}
+
+// WithShardAutoscaling sets the ShardAutoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ShardAutoscaling field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithShardAutoscaling(value *ShardAutoscalingApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ShardAutoscaling = value
+	return b
+}
+
 // WithReplicaExternalLabelName sets the ReplicaExternalLabelName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ReplicaExternalLabelName field is set to the value of the last call.
@@ -16,14 +16,21 @@
 
 package v1
 
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
 // ShardStatusApplyConfiguration represents a declarative configuration of the ShardStatus type for use
 // with apply.
 type ShardStatusApplyConfiguration struct {
-	ShardID             *string `json:"shardID,omitempty"`
-	Replicas            *int32  `json:"replicas,omitempty"`
-	UpdatedReplicas     *int32  `json:"updatedReplicas,omitempty"`
-	AvailableReplicas   *int32  `json:"availableReplicas,omitempty"`
-	UnavailableReplicas *int32  `json:"unavailableReplicas,omitempty"`
+	ShardID             *string                                 `json:"shardID,omitempty"`
+	Replicas            *int32                                  `json:"replicas,omitempty"`
+	UpdatedReplicas     *int32                                  `json:"updatedReplicas,omitempty"`
+	AvailableReplicas   *int32                                  `json:"availableReplicas,omitempty"`
+	UnavailableReplicas *int32                                  `json:"unavailableReplicas,omitempty"`
+	HeadSeries          *int64                                  `json:"headSeries,omitempty"`
+	MemoryUsage         *resource.Quantity                      `json:"memoryUsage,omitempty"`
+	LastScalingDecision *ShardScalingDecisionApplyConfiguration `json:"lastScalingDecision,omitempty"`
 }
 
 // ShardStatusApplyConfiguration constructs a declarative configuration of the ShardStatus type for use with
@@ -71,3 +78,27 @@ func (b *ShardStatusApplyConfiguration) WithUnavailableReplicas(value int32) *Sh
 	b.UnavailableReplicas = &value
 	return b
 }
+
+// WithHeadSeries sets the HeadSeries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeadSeries field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithHeadSeries(value int64) *ShardStatusApplyConfiguration {
+	b.HeadSeries = &value
+	return b
+}
+
+// WithMemoryUsage sets the MemoryUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemoryUsage field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithMemoryUsage(value resource.Quantity) *ShardStatusApplyConfiguration {
+	b.MemoryUsage = &value
+	return b
+}
+
+// WithLastScalingDecision sets the LastScalingDecision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScalingDecision field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithLastScalingDecision(value *ShardScalingDecisionApplyConfiguration) *ShardStatusApplyConfiguration {
+	b.LastScalingDecision = value
+	return b
+}
@@ -32,6 +32,7 @@ type ThanosRulerSpecApplyConfiguration struct {
 	ImagePullSecrets                   []corev1.LocalObjectReference                   `json:"imagePullSecrets,omitempty"`
 	Paused                             *bool                                           `json:"paused,omitempty"`
 	Replicas                           *int32                                          `json:"replicas,omitempty"`
+	Shards                             *int32                                          `json:"shards,omitempty"`
 	NodeSelector                       map[string]string                               `json:"nodeSelector,omitempty"`
 	Resources                          *corev1.ResourceRequirements                    `json:"resources,omitempty"`
 	Affinity                           *corev1.Affinity                                `json:"affinity,omitempty"`
@@ -52,6 +53,7 @@ type ThanosRulerSpecApplyConfiguration struct {
 	ListenLocal                        *bool                                           `json:"listenLocal,omitempty"`
 	QueryEndpoints                     []string                                        `json:"queryEndpoints,omitempty"`
 	QueryConfig                        *corev1.SecretKeySelector                       `json:"queryConfig,omitempty"`
+	QueryDiscovery                     *ThanosRulerQueryDiscoveryApplyConfiguration    `json:"queryDiscovery,omitempty"`
 	AlertManagersURL                   []string                                        `json:"alertmanagersUrl,omitempty"`
 	AlertManagersConfig                *corev1.SecretKeySelector                       `json:"alertmanagersConfig,omitempty"`
 	RuleSelector                       *metav1.LabelSelectorApplyConfiguration         `json:"ruleSelector,omitempty"`
@@ -155,6 +157,14 @@ func (b *ThanosRulerSpecApplyConfiguration) WithReplicas(value int32) *ThanosRul
 	return b
 }
 
+// WithShards sets the Shards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shards field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithShards(value int32) *ThanosRulerSpecApplyConfiguration {
+	b.Shards = &value
+	return b
+}
+
 // WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the NodeSelector field,
@@ -331,6 +341,14 @@ func (b *ThanosRulerSpecApplyConfiguration) WithQueryConfig(value corev1.SecretK
 	return b
 }
 
+// WithQueryDiscovery sets the QueryDiscovery field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QueryDiscovery field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithQueryDiscovery(value *ThanosRulerQueryDiscoveryApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.QueryDiscovery = value
+	return b
+}
+
 // WithAlertManagersURL adds the given value to the AlertManagersURL field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the AlertManagersURL field.
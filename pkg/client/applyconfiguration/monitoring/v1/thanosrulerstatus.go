@@ -19,12 +19,14 @@ package v1
 // ThanosRulerStatusApplyConfiguration represents a declarative configuration of the ThanosRulerStatus type for use
 // with apply.
 type ThanosRulerStatusApplyConfiguration struct {
-	Paused              *bool                         `json:"paused,omitempty"`
-	Replicas            *int32                        `json:"replicas,omitempty"`
-	UpdatedReplicas     *int32                        `json:"updatedReplicas,omitempty"`
-	AvailableReplicas   *int32                        `json:"availableReplicas,omitempty"`
-	UnavailableReplicas *int32                        `json:"unavailableReplicas,omitempty"`
-	Conditions          []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Paused              *bool                           `json:"paused,omitempty"`
+	Replicas            *int32                          `json:"replicas,omitempty"`
+	UpdatedReplicas     *int32                          `json:"updatedReplicas,omitempty"`
+	AvailableReplicas   *int32                          `json:"availableReplicas,omitempty"`
+	UnavailableReplicas *int32                          `json:"unavailableReplicas,omitempty"`
+	Conditions          []ConditionApplyConfiguration   `json:"conditions,omitempty"`
+	ShardStatuses       []ShardStatusApplyConfiguration `json:"shardStatuses,omitempty"`
+	Shards              *int32                          `json:"shards,omitempty"`
 }
 
 // ThanosRulerStatusApplyConfiguration constructs a declarative configuration of the ThanosRulerStatus type for use with
@@ -85,3 +87,24 @@ func (b *ThanosRulerStatusApplyConfiguration) WithConditions(values ...*Conditio
 	}
 	return b
 }
+
+// WithShardStatuses adds the given value to the ShardStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ShardStatuses field.
+func (b *ThanosRulerStatusApplyConfiguration) WithShardStatuses(values ...*ShardStatusApplyConfiguration) *ThanosRulerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithShardStatuses")
+		}
+		b.ShardStatuses = append(b.ShardStatuses, *values[i])
+	}
+	return b
+}
+
+// WithShards sets the Shards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shards field is set to the value of the last call.
+func (b *ThanosRulerStatusApplyConfiguration) WithShards(value int32) *ThanosRulerStatusApplyConfiguration {
+	b.Shards = &value
+	return b
+}
@@ -22,6 +22,7 @@ type InhibitRuleApplyConfiguration struct {
 	TargetMatch []MatcherApplyConfiguration `json:"targetMatch,omitempty"`
 	SourceMatch []MatcherApplyConfiguration `json:"sourceMatch,omitempty"`
 	Equal       []string                    `json:"equal,omitempty"`
+	ClusterWide *bool                       `json:"clusterWide,omitempty"`
 }
 
 // InhibitRuleApplyConfiguration constructs a declarative configuration of the InhibitRule type for use with
@@ -65,3 +66,11 @@ func (b *InhibitRuleApplyConfiguration) WithEqual(values ...string) *InhibitRule
 	}
 	return b
 }
+
+// WithClusterWide sets the ClusterWide field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterWide field is set to the value of the last call.
+func (b *InhibitRuleApplyConfiguration) WithClusterWide(value bool) *InhibitRuleApplyConfiguration {
+	b.ClusterWide = &value
+	return b
+}
@@ -21,6 +21,7 @@ package v1alpha1
 type MuteTimeIntervalApplyConfiguration struct {
 	Name          *string                          `json:"name,omitempty"`
 	TimeIntervals []TimeIntervalApplyConfiguration `json:"timeIntervals,omitempty"`
+	Exported      *bool                            `json:"exported,omitempty"`
 }
 
 // MuteTimeIntervalApplyConfiguration constructs a declarative configuration of the MuteTimeInterval type for use with
@@ -49,3 +50,11 @@ func (b *MuteTimeIntervalApplyConfiguration) WithTimeIntervals(values ...*TimeIn
 	}
 	return b
 }
+
+// WithExported sets the Exported field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exported field is set to the value of the last call.
+func (b *MuteTimeIntervalApplyConfiguration) WithExported(value bool) *MuteTimeIntervalApplyConfiguration {
+	b.Exported = &value
+	return b
+}
@@ -16,25 +16,32 @@
 
 package v1alpha1
 
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+)
+
 // ReceiverApplyConfiguration represents a declarative configuration of the Receiver type for use
 // with apply.
 type ReceiverApplyConfiguration struct {
-	Name              *string                              `json:"name,omitempty"`
-	OpsGenieConfigs   []OpsGenieConfigApplyConfiguration   `json:"opsgenieConfigs,omitempty"`
-	PagerDutyConfigs  []PagerDutyConfigApplyConfiguration  `json:"pagerdutyConfigs,omitempty"`
-	DiscordConfigs    []DiscordConfigApplyConfiguration    `json:"discordConfigs,omitempty"`
-	SlackConfigs      []SlackConfigApplyConfiguration      `json:"slackConfigs,omitempty"`
-	WebhookConfigs    []WebhookConfigApplyConfiguration    `json:"webhookConfigs,omitempty"`
-	WeChatConfigs     []WeChatConfigApplyConfiguration     `json:"wechatConfigs,omitempty"`
-	EmailConfigs      []EmailConfigApplyConfiguration      `json:"emailConfigs,omitempty"`
-	VictorOpsConfigs  []VictorOpsConfigApplyConfiguration  `json:"victoropsConfigs,omitempty"`
-	PushoverConfigs   []PushoverConfigApplyConfiguration   `json:"pushoverConfigs,omitempty"`
-	SNSConfigs        []SNSConfigApplyConfiguration        `json:"snsConfigs,omitempty"`
-	TelegramConfigs   []TelegramConfigApplyConfiguration   `json:"telegramConfigs,omitempty"`
-	WebexConfigs      []WebexConfigApplyConfiguration      `json:"webexConfigs,omitempty"`
-	MSTeamsConfigs    []MSTeamsConfigApplyConfiguration    `json:"msteamsConfigs,omitempty"`
-	MSTeamsV2Configs  []MSTeamsV2ConfigApplyConfiguration  `json:"msteamsv2Configs,omitempty"`
-	RocketChatConfigs []RocketChatConfigApplyConfiguration `json:"rocketchatConfigs,omitempty"`
+	Name              *string                                     `json:"name,omitempty"`
+	OpsGenieConfigs   []OpsGenieConfigApplyConfiguration          `json:"opsgenieConfigs,omitempty"`
+	PagerDutyConfigs  []PagerDutyConfigApplyConfiguration         `json:"pagerdutyConfigs,omitempty"`
+	DiscordConfigs    []DiscordConfigApplyConfiguration           `json:"discordConfigs,omitempty"`
+	SlackConfigs      []SlackConfigApplyConfiguration             `json:"slackConfigs,omitempty"`
+	WebhookConfigs    []WebhookConfigApplyConfiguration           `json:"webhookConfigs,omitempty"`
+	WeChatConfigs     []WeChatConfigApplyConfiguration            `json:"wechatConfigs,omitempty"`
+	EmailConfigs      []EmailConfigApplyConfiguration             `json:"emailConfigs,omitempty"`
+	VictorOpsConfigs  []VictorOpsConfigApplyConfiguration         `json:"victoropsConfigs,omitempty"`
+	PushoverConfigs   []PushoverConfigApplyConfiguration          `json:"pushoverConfigs,omitempty"`
+	SNSConfigs        []SNSConfigApplyConfiguration               `json:"snsConfigs,omitempty"`
+	TelegramConfigs   []TelegramConfigApplyConfiguration          `json:"telegramConfigs,omitempty"`
+	WebexConfigs      []WebexConfigApplyConfiguration             `json:"webexConfigs,omitempty"`
+	MSTeamsConfigs    []MSTeamsConfigApplyConfiguration           `json:"msteamsConfigs,omitempty"`
+	MSTeamsV2Configs  []MSTeamsV2ConfigApplyConfiguration         `json:"msteamsv2Configs,omitempty"`
+	RocketChatConfigs []RocketChatConfigApplyConfiguration        `json:"rocketchatConfigs,omitempty"`
+	JiraConfigs       []JiraConfigApplyConfiguration              `json:"jiraConfigs,omitempty"`
+	IncidentIOConfigs []IncidentIOConfigApplyConfiguration        `json:"incidentioConfigs,omitempty"`
+	RateLimit         *v1.NotificationRateLimitApplyConfiguration `json:"rateLimit,omitempty"`
 }
 
 // ReceiverApplyConfiguration constructs a declarative configuration of the Receiver type for use with
@@ -245,3 +252,37 @@ func (b *ReceiverApplyConfiguration) WithRocketChatConfigs(values ...*RocketChat
 	}
 	return b
 }
+
+// WithJiraConfigs adds the given value to the JiraConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the JiraConfigs field.
+func (b *ReceiverApplyConfiguration) WithJiraConfigs(values ...*JiraConfigApplyConfiguration) *ReceiverApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithJiraConfigs")
+		}
+		b.JiraConfigs = append(b.JiraConfigs, *values[i])
+	}
+	return b
+}
+
+// WithIncidentIOConfigs adds the given value to the IncidentIOConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IncidentIOConfigs field.
+func (b *ReceiverApplyConfiguration) WithIncidentIOConfigs(values ...*IncidentIOConfigApplyConfiguration) *ReceiverApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIncidentIOConfigs")
+		}
+		b.IncidentIOConfigs = append(b.IncidentIOConfigs, *values[i])
+	}
+	return b
+}
+
+// WithRateLimit sets the RateLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RateLimit field is set to the value of the last call.
+func (b *ReceiverApplyConfiguration) WithRateLimit(value *v1.NotificationRateLimitApplyConfiguration) *ReceiverApplyConfiguration {
+	b.RateLimit = value
+	return b
+}
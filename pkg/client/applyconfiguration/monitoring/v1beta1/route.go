@@ -24,6 +24,7 @@ import (
 // with apply.
 type RouteApplyConfiguration struct {
 	Receiver            *string                     `json:"receiver,omitempty"`
+	SharedReceiver      *string                     `json:"sharedReceiver,omitempty"`
 	GroupBy             []string                    `json:"groupBy,omitempty"`
 	GroupWait           *string                     `json:"groupWait,omitempty"`
 	GroupInterval       *string                     `json:"groupInterval,omitempty"`
@@ -49,6 +50,14 @@ func (b *RouteApplyConfiguration) WithReceiver(value string) *RouteApplyConfigur
 	return b
 }
 
+// WithSharedReceiver sets the SharedReceiver field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SharedReceiver field is set to the value of the last call.
+func (b *RouteApplyConfiguration) WithSharedReceiver(value string) *RouteApplyConfiguration {
+	b.SharedReceiver = &value
+	return b
+}
+
 // WithGroupBy adds the given value to the GroupBy field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the GroupBy field.
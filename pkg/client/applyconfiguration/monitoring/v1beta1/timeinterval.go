@@ -21,6 +21,7 @@ package v1beta1
 type TimeIntervalApplyConfiguration struct {
 	Name          *string                        `json:"name,omitempty"`
 	TimeIntervals []TimePeriodApplyConfiguration `json:"timeIntervals,omitempty"`
+	Exported      *bool                          `json:"exported,omitempty"`
 }
 
 // TimeIntervalApplyConfiguration constructs a declarative configuration of the TimeInterval type for use with
@@ -49,3 +50,11 @@ func (b *TimeIntervalApplyConfiguration) WithTimeIntervals(values ...*TimePeriod
 	}
 	return b
 }
+
+// WithExported sets the Exported field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exported field is set to the value of the last call.
+func (b *TimeIntervalApplyConfiguration) WithExported(value bool) *TimeIntervalApplyConfiguration {
+	b.Exported = &value
+	return b
+}
@@ -40,6 +40,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.AlertmanagerApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerConfigMatcherStrategy"):
 		return &monitoringv1.AlertmanagerConfigMatcherStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AlertmanagerConfigRateLimits"):
+		return &monitoringv1.AlertmanagerConfigRateLimitsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerConfiguration"):
 		return &monitoringv1.AlertmanagerConfigurationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerEndpoints"):
@@ -48,6 +50,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.AlertmanagerGlobalConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerLimitsSpec"):
 		return &monitoringv1.AlertmanagerLimitsSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AlertmanagerPeerDiscovery"):
+		return &monitoringv1.AlertmanagerPeerDiscoveryApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AlertmanagerPeerService"):
+		return &monitoringv1.AlertmanagerPeerServiceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerSpec"):
 		return &monitoringv1.AlertmanagerSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerStatus"):
@@ -112,6 +118,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.HostPortApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPConfig"):
 		return &monitoringv1.HTTPConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationRateLimit"):
+		return &monitoringv1.IntegrationRateLimitApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ManagedIdentity"):
 		return &monitoringv1.ManagedIdentityApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MetadataConfig"):
@@ -120,6 +128,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.NamespaceSelectorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NativeHistogramConfig"):
 		return &monitoringv1.NativeHistogramConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NotificationRateLimit"):
+		return &monitoringv1.NotificationRateLimitApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("OAuth2"):
 		return &monitoringv1.OAuth2ApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ObjectReference"):
@@ -202,8 +212,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ServiceMonitorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServiceMonitorSpec"):
 		return &monitoringv1.ServiceMonitorSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardAutoscaling"):
+		return &monitoringv1.ShardAutoscalingApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardRetentionPolicy"):
 		return &monitoringv1.ShardRetentionPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardScalingDecision"):
+		return &monitoringv1.ShardScalingDecisionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardStatus"):
 		return &monitoringv1.ShardStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Sigv4"):
@@ -212,6 +226,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.StorageSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRuler"):
 		return &monitoringv1.ThanosRulerApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ThanosRulerQueryDiscovery"):
+		return &monitoringv1.ThanosRulerQueryDiscoveryApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ThanosRulerQueryService"):
+		return &monitoringv1.ThanosRulerQueryServiceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRulerSpec"):
 		return &monitoringv1.ThanosRulerSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRulerStatus"):
@@ -226,6 +244,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.TopologySpreadConstraintApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("TSDBSpec"):
 		return &monitoringv1.TSDBSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WebClientConfig"):
+		return &monitoringv1.WebClientConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WebConfigFileFields"):
 		return &monitoringv1.WebConfigFileFieldsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WebHTTPConfig"):
@@ -242,10 +262,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.AlertmanagerConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerConfigSpec"):
 		return &monitoringv1alpha1.AlertmanagerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerReceiver"):
+		return &monitoringv1alpha1.AlertmanagerReceiverApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilence"):
+		return &monitoringv1alpha1.AlertmanagerSilenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceSpec"):
+		return &monitoringv1alpha1.AlertmanagerSilenceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceStatus"):
+		return &monitoringv1alpha1.AlertmanagerSilenceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerTemplate"):
+		return &monitoringv1alpha1.AlertmanagerTemplateApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerTemplateSpec"):
+		return &monitoringv1alpha1.AlertmanagerTemplateSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertRuleTest"):
+		return &monitoringv1alpha1.AlertRuleTestApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AttachMetadata"):
 		return &monitoringv1alpha1.AttachMetadataApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AzureSDConfig"):
 		return &monitoringv1alpha1.AzureSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CommonThanosBucketFields"):
+		return &monitoringv1alpha1.CommonThanosBucketFieldsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ConsulSDConfig"):
 		return &monitoringv1alpha1.ConsulSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DayOfMonthRange"):
@@ -266,6 +302,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.EmailConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EurekaSDConfig"):
 		return &monitoringv1alpha1.EurekaSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExpectedAlert"):
+		return &monitoringv1alpha1.ExpectedAlertApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExpectedSample"):
+		return &monitoringv1alpha1.ExpectedSampleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FileSDConfig"):
 		return &monitoringv1alpha1.FileSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Filter"):
@@ -278,10 +318,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.HTTPConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPSDConfig"):
 		return &monitoringv1alpha1.HTTPSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IncidentIOConfig"):
+		return &monitoringv1alpha1.IncidentIOConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1alpha1.InhibitRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IonosSDConfig"):
 		return &monitoringv1alpha1.IonosSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1alpha1.JiraConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("K8SSelectorConfig"):
 		return &monitoringv1alpha1.K8SSelectorConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KeyValue"):
@@ -324,6 +368,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.PrometheusAgentApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PrometheusAgentSpec"):
 		return &monitoringv1alpha1.PrometheusAgentSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PrometheusRuleTest"):
+		return &monitoringv1alpha1.PrometheusRuleTestApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PrometheusRuleTestSpec"):
+		return &monitoringv1alpha1.PrometheusRuleTestSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PrometheusRuleTestStatus"):
+		return &monitoringv1alpha1.PrometheusRuleTestStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PromQLExprTest"):
+		return &monitoringv1alpha1.PromQLExprTestApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PuppetDBSDConfig"):
 		return &monitoringv1alpha1.PuppetDBSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PushoverConfig"):
@@ -338,12 +390,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.RocketChatFieldConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Route"):
 		return &monitoringv1alpha1.RouteApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RuleTestGroup"):
+		return &monitoringv1alpha1.RuleTestGroupApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RuleTestGroupResult"):
+		return &monitoringv1alpha1.RuleTestGroupResultApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RuleTestInputSeries"):
+		return &monitoringv1alpha1.RuleTestInputSeriesApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScalewaySDConfig"):
 		return &monitoringv1alpha1.ScalewaySDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeConfig"):
 		return &monitoringv1alpha1.ScrapeConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeConfigSpec"):
 		return &monitoringv1alpha1.ScrapeConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SilenceAlertmanagerStatus"):
+		return &monitoringv1alpha1.SilenceAlertmanagerStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackAction"):
 		return &monitoringv1alpha1.SlackActionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackConfig"):
@@ -358,6 +418,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.StaticConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TelegramConfig"):
 		return &monitoringv1alpha1.TelegramConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosBucketStatus"):
+		return &monitoringv1alpha1.ThanosBucketStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosBucketWebSpec"):
+		return &monitoringv1alpha1.ThanosBucketWebSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosCompactor"):
+		return &monitoringv1alpha1.ThanosCompactorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosCompactorSpec"):
+		return &monitoringv1alpha1.ThanosCompactorSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQuery"):
+		return &monitoringv1alpha1.ThanosQueryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQuerySpec"):
+		return &monitoringv1alpha1.ThanosQuerySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQueryStatus"):
+		return &monitoringv1alpha1.ThanosQueryStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQueryWebSpec"):
+		return &monitoringv1alpha1.ThanosQueryWebSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosRetentionResolution"):
+		return &monitoringv1alpha1.ThanosRetentionResolutionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosStore"):
+		return &monitoringv1alpha1.ThanosStoreApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosStoreSpec"):
+		return &monitoringv1alpha1.ThanosStoreSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TimeInterval"):
 		return &monitoringv1alpha1.TimeIntervalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TimeRange"):
@@ -384,8 +466,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.EmailConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("HTTPConfig"):
 		return &monitoringv1beta1.HTTPConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("IncidentIOConfig"):
+		return &monitoringv1beta1.IncidentIOConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1beta1.InhibitRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1beta1.JiraConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("KeyValue"):
 		return &monitoringv1beta1.KeyValueApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Matcher"):
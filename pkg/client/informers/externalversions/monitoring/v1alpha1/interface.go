@@ -24,10 +24,24 @@ import (
 type Interface interface {
 	// AlertmanagerConfigs returns a AlertmanagerConfigInformer.
 	AlertmanagerConfigs() AlertmanagerConfigInformer
+	// AlertmanagerReceivers returns a AlertmanagerReceiverInformer.
+	AlertmanagerReceivers() AlertmanagerReceiverInformer
+	// AlertmanagerSilences returns a AlertmanagerSilenceInformer.
+	AlertmanagerSilences() AlertmanagerSilenceInformer
+	// AlertmanagerTemplates returns a AlertmanagerTemplateInformer.
+	AlertmanagerTemplates() AlertmanagerTemplateInformer
 	// PrometheusAgents returns a PrometheusAgentInformer.
 	PrometheusAgents() PrometheusAgentInformer
+	// PrometheusRuleTests returns a PrometheusRuleTestInformer.
+	PrometheusRuleTests() PrometheusRuleTestInformer
 	// ScrapeConfigs returns a ScrapeConfigInformer.
 	ScrapeConfigs() ScrapeConfigInformer
+	// ThanosCompactors returns a ThanosCompactorInformer.
+	ThanosCompactors() ThanosCompactorInformer
+	// ThanosQueries returns a ThanosQueryInformer.
+	ThanosQueries() ThanosQueryInformer
+	// ThanosStores returns a ThanosStoreInformer.
+	ThanosStores() ThanosStoreInformer
 }
 
 type version struct {
@@ -46,12 +60,47 @@ func (v *version) AlertmanagerConfigs() AlertmanagerConfigInformer {
 	return &alertmanagerConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// AlertmanagerReceivers returns a AlertmanagerReceiverInformer.
+func (v *version) AlertmanagerReceivers() AlertmanagerReceiverInformer {
+	return &alertmanagerReceiverInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AlertmanagerSilences returns a AlertmanagerSilenceInformer.
+func (v *version) AlertmanagerSilences() AlertmanagerSilenceInformer {
+	return &alertmanagerSilenceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AlertmanagerTemplates returns a AlertmanagerTemplateInformer.
+func (v *version) AlertmanagerTemplates() AlertmanagerTemplateInformer {
+	return &alertmanagerTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PrometheusAgents returns a PrometheusAgentInformer.
 func (v *version) PrometheusAgents() PrometheusAgentInformer {
 	return &prometheusAgentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// PrometheusRuleTests returns a PrometheusRuleTestInformer.
+func (v *version) PrometheusRuleTests() PrometheusRuleTestInformer {
+	return &prometheusRuleTestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScrapeConfigs returns a ScrapeConfigInformer.
 func (v *version) ScrapeConfigs() ScrapeConfigInformer {
 	return &scrapeConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ThanosCompactors returns a ThanosCompactorInformer.
+func (v *version) ThanosCompactors() ThanosCompactorInformer {
+	return &thanosCompactorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ThanosQueries returns a ThanosQueryInformer.
+func (v *version) ThanosQueries() ThanosQueryInformer {
+	return &thanosQueryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ThanosStores returns a ThanosStoreInformer.
+func (v *version) ThanosStores() ThanosStoreInformer {
+	return &thanosStoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -24,6 +24,30 @@ type AlertmanagerConfigListerExpansion interface{}
 // AlertmanagerConfigNamespaceLister.
 type AlertmanagerConfigNamespaceListerExpansion interface{}
 
+// AlertmanagerReceiverListerExpansion allows custom methods to be added to
+// AlertmanagerReceiverLister.
+type AlertmanagerReceiverListerExpansion interface{}
+
+// AlertmanagerReceiverNamespaceListerExpansion allows custom methods to be added to
+// AlertmanagerReceiverNamespaceLister.
+type AlertmanagerReceiverNamespaceListerExpansion interface{}
+
+// AlertmanagerSilenceListerExpansion allows custom methods to be added to
+// AlertmanagerSilenceLister.
+type AlertmanagerSilenceListerExpansion interface{}
+
+// AlertmanagerSilenceNamespaceListerExpansion allows custom methods to be added to
+// AlertmanagerSilenceNamespaceLister.
+type AlertmanagerSilenceNamespaceListerExpansion interface{}
+
+// AlertmanagerTemplateListerExpansion allows custom methods to be added to
+// AlertmanagerTemplateLister.
+type AlertmanagerTemplateListerExpansion interface{}
+
+// AlertmanagerTemplateNamespaceListerExpansion allows custom methods to be added to
+// AlertmanagerTemplateNamespaceLister.
+type AlertmanagerTemplateNamespaceListerExpansion interface{}
+
 // PrometheusAgentListerExpansion allows custom methods to be added to
 // PrometheusAgentLister.
 type PrometheusAgentListerExpansion interface{}
@@ -32,6 +56,14 @@ type PrometheusAgentListerExpansion interface{}
 // PrometheusAgentNamespaceLister.
 type PrometheusAgentNamespaceListerExpansion interface{}
 
+// PrometheusRuleTestListerExpansion allows custom methods to be added to
+// PrometheusRuleTestLister.
+type PrometheusRuleTestListerExpansion interface{}
+
+// PrometheusRuleTestNamespaceListerExpansion allows custom methods to be added to
+// PrometheusRuleTestNamespaceLister.
+type PrometheusRuleTestNamespaceListerExpansion interface{}
+
 // ScrapeConfigListerExpansion allows custom methods to be added to
 // ScrapeConfigLister.
 type ScrapeConfigListerExpansion interface{}
@@ -39,3 +71,27 @@ type ScrapeConfigListerExpansion interface{}
 // ScrapeConfigNamespaceListerExpansion allows custom methods to be added to
 // ScrapeConfigNamespaceLister.
 type ScrapeConfigNamespaceListerExpansion interface{}
+
+// ThanosCompactorListerExpansion allows custom methods to be added to
+// ThanosCompactorLister.
+type ThanosCompactorListerExpansion interface{}
+
+// ThanosCompactorNamespaceListerExpansion allows custom methods to be added to
+// ThanosCompactorNamespaceLister.
+type ThanosCompactorNamespaceListerExpansion interface{}
+
+// ThanosQueryListerExpansion allows custom methods to be added to
+// ThanosQueryLister.
+type ThanosQueryListerExpansion interface{}
+
+// ThanosQueryNamespaceListerExpansion allows custom methods to be added to
+// ThanosQueryNamespaceLister.
+type ThanosQueryNamespaceListerExpansion interface{}
+
+// ThanosStoreListerExpansion allows custom methods to be added to
+// ThanosStoreLister.
+type ThanosStoreListerExpansion interface{}
+
+// ThanosStoreNamespaceListerExpansion allows custom methods to be added to
+// ThanosStoreNamespaceLister.
+type ThanosStoreNamespaceListerExpansion interface{}
@@ -38,6 +38,8 @@ type AlertmanagerConfigsGetter interface {
 type AlertmanagerConfigInterface interface {
 	Create(ctx context.Context, alertmanagerConfig *monitoringv1alpha1.AlertmanagerConfig, opts v1.CreateOptions) (*monitoringv1alpha1.AlertmanagerConfig, error)
 	Update(ctx context.Context, alertmanagerConfig *monitoringv1alpha1.AlertmanagerConfig, opts v1.UpdateOptions) (*monitoringv1alpha1.AlertmanagerConfig, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, alertmanagerConfig *monitoringv1alpha1.AlertmanagerConfig, opts v1.UpdateOptions) (*monitoringv1alpha1.AlertmanagerConfig, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*monitoringv1alpha1.AlertmanagerConfig, error)
@@ -45,6 +47,8 @@ type AlertmanagerConfigInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *monitoringv1alpha1.AlertmanagerConfig, err error)
 	Apply(ctx context.Context, alertmanagerConfig *applyconfigurationmonitoringv1alpha1.AlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *monitoringv1alpha1.AlertmanagerConfig, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, alertmanagerConfig *applyconfigurationmonitoringv1alpha1.AlertmanagerConfigApplyConfiguration, opts v1.ApplyOptions) (result *monitoringv1alpha1.AlertmanagerConfig, err error)
 	AlertmanagerConfigExpansion
 }
 
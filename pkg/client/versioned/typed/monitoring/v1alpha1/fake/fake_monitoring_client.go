@@ -30,14 +30,42 @@ func (c *FakeMonitoringV1alpha1) AlertmanagerConfigs(namespace string) v1alpha1.
 	return newFakeAlertmanagerConfigs(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) AlertmanagerReceivers(namespace string) v1alpha1.AlertmanagerReceiverInterface {
+	return newFakeAlertmanagerReceivers(c, namespace)
+}
+
+func (c *FakeMonitoringV1alpha1) AlertmanagerSilences(namespace string) v1alpha1.AlertmanagerSilenceInterface {
+	return newFakeAlertmanagerSilences(c, namespace)
+}
+
+func (c *FakeMonitoringV1alpha1) AlertmanagerTemplates(namespace string) v1alpha1.AlertmanagerTemplateInterface {
+	return newFakeAlertmanagerTemplates(c, namespace)
+}
+
 func (c *FakeMonitoringV1alpha1) PrometheusAgents(namespace string) v1alpha1.PrometheusAgentInterface {
 	return newFakePrometheusAgents(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) PrometheusRuleTests(namespace string) v1alpha1.PrometheusRuleTestInterface {
+	return newFakePrometheusRuleTests(c, namespace)
+}
+
 func (c *FakeMonitoringV1alpha1) ScrapeConfigs(namespace string) v1alpha1.ScrapeConfigInterface {
 	return newFakeScrapeConfigs(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) ThanosCompactors(namespace string) v1alpha1.ThanosCompactorInterface {
+	return newFakeThanosCompactors(c, namespace)
+}
+
+func (c *FakeMonitoringV1alpha1) ThanosQueries(namespace string) v1alpha1.ThanosQueryInterface {
+	return newFakeThanosQueries(c, namespace)
+}
+
+func (c *FakeMonitoringV1alpha1) ThanosStores(namespace string) v1alpha1.ThanosStoreInterface {
+	return newFakeThanosStores(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMonitoringV1alpha1) RESTClient() rest.Interface {
@@ -18,6 +18,20 @@ package v1alpha1
 
 type AlertmanagerConfigExpansion interface{}
 
+type AlertmanagerReceiverExpansion interface{}
+
+type AlertmanagerSilenceExpansion interface{}
+
+type AlertmanagerTemplateExpansion interface{}
+
 type PrometheusAgentExpansion interface{}
 
+type PrometheusRuleTestExpansion interface{}
+
 type ScrapeConfigExpansion interface{}
+
+type ThanosCompactorExpansion interface{}
+
+type ThanosQueryExpansion interface{}
+
+type ThanosStoreExpansion interface{}
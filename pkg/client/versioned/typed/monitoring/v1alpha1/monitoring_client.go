@@ -27,8 +27,15 @@ import (
 type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
+	AlertmanagerReceiversGetter
+	AlertmanagerSilencesGetter
+	AlertmanagerTemplatesGetter
 	PrometheusAgentsGetter
+	PrometheusRuleTestsGetter
 	ScrapeConfigsGetter
+	ThanosCompactorsGetter
+	ThanosQueriesGetter
+	ThanosStoresGetter
 }
 
 // MonitoringV1alpha1Client is used to interact with features provided by the monitoring.coreos.com group.
@@ -40,14 +47,42 @@ func (c *MonitoringV1alpha1Client) AlertmanagerConfigs(namespace string) Alertma
 	return newAlertmanagerConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) AlertmanagerReceivers(namespace string) AlertmanagerReceiverInterface {
+	return newAlertmanagerReceivers(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) AlertmanagerSilences(namespace string) AlertmanagerSilenceInterface {
+	return newAlertmanagerSilences(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) AlertmanagerTemplates(namespace string) AlertmanagerTemplateInterface {
+	return newAlertmanagerTemplates(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) PrometheusAgents(namespace string) PrometheusAgentInterface {
 	return newPrometheusAgents(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) PrometheusRuleTests(namespace string) PrometheusRuleTestInterface {
+	return newPrometheusRuleTests(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) ScrapeConfigs(namespace string) ScrapeConfigInterface {
 	return newScrapeConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) ThanosCompactors(namespace string) ThanosCompactorInterface {
+	return newThanosCompactors(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ThanosQueries(namespace string) ThanosQueryInterface {
+	return newThanosQueries(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ThanosStores(namespace string) ThanosStoreInterface {
+	return newThanosStores(c, namespace)
+}
+
 // NewForConfig creates a new MonitoringV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,468 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+)
+
+// nonPersistedGroups are the API groups of the resources which are never
+// persisted by the API server (e.g. SelfSubjectAccessReview). Requests to
+// these groups are sent unchanged in dry-run mode.
+var nonPersistedGroups = map[string]struct{}{
+	"authentication.k8s.io": {},
+	"authorization.k8s.io":  {},
+}
+
+// requestTarget identifies the object targeted by a Kubernetes API request.
+type requestTarget struct {
+	// Path prefix before the API root (e.g. when the API server is behind a
+	// proxy).
+	prefix string
+	// API root ("/api/v1" or "/apis/<group>/<version>").
+	root string
+
+	group       string
+	resource    string
+	namespace   string
+	name        string
+	subresource string
+}
+
+// parseRequestPath returns the target of a resource request. It returns
+// false if the path isn't a resource path.
+func parseRequestPath(path string) (requestTarget, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var (
+		t    requestTarget
+		rest []string
+	)
+	for i, s := range segments {
+		switch {
+		case s == "api" && len(segments) > i+1:
+			t.root = "/" + strings.Join(segments[i:i+2], "/")
+			rest = segments[i+2:]
+		case s == "apis" && len(segments) > i+2:
+			t.group = segments[i+1]
+			t.root = "/" + strings.Join(segments[i:i+3], "/")
+			rest = segments[i+3:]
+		default:
+			continue
+		}
+
+		if i > 0 {
+			t.prefix = "/" + strings.Join(segments[:i], "/")
+		}
+		break
+	}
+
+	if len(rest) >= 3 && rest[0] == "namespaces" {
+		t.namespace = rest[1]
+		rest = rest[2:]
+	}
+
+	if len(rest) == 0 || len(rest) > 3 {
+		return requestTarget{}, false
+	}
+
+	t.resource = rest[0]
+	if len(rest) > 1 {
+		t.name = rest[1]
+	}
+	if len(rest) > 2 {
+		t.subresource = rest[2]
+	}
+
+	return t, true
+}
+
+// objectPath returns the path of the object (without subresource).
+func (t requestTarget) objectPath() string {
+	p := t.prefix + t.root
+	if t.namespace != "" {
+		p += "/namespaces/" + t.namespace
+	}
+
+	return p + "/" + t.resource + "/" + t.name
+}
+
+func (t requestTarget) String() string {
+	s := t.resource
+	if t.group != "" {
+		s += "." + t.group
+	}
+
+	s += " " + types.NamespacedName{Namespace: t.namespace, Name: t.name}.String()
+	if t.subresource != "" {
+		s += " (" + t.subresource + ")"
+	}
+
+	return s
+}
+
+// dryRunRoundTripper converts all the write requests into server-side
+// dry-run requests and reports the difference between the current state of
+// the objects and the state which would have been persisted.
+type dryRunRoundTripper struct {
+	rt http.RoundTripper
+	*dryRunReporter
+}
+
+// dryRunReporter is shared by all the round-trippers created by the same
+// wrapper.
+type dryRunReporter struct {
+	logger       *slog.Logger
+	fieldManager string
+	changes      *prometheus.CounterVec
+
+	mtx sync.Mutex
+	// Last reported diff per object to avoid logging the same changes at
+	// every reconciliation.
+	pendingChanges map[string]string
+}
+
+// EnableDryRun configures the REST configuration so that the Kubernetes
+// clients created from it never persist changes: every write request is sent
+// as a server-side dry-run request and the changes are logged instead.
+//
+// Create and update requests are converted into server-side apply requests
+// using fieldManager. Patch and delete requests are sent with the "All"
+// dry-run directive.
+//
+// It must be called before creating the clients. The JSON content type is
+// enforced because the objects need to be decoded without knowing their
+// types.
+func EnableDryRun(cfg *rest.Config, logger *slog.Logger, fieldManager string, r prometheus.Registerer) {
+	cfg.ContentType = runtime.ContentTypeJSON
+	cfg.AcceptContentTypes = runtime.ContentTypeJSON
+	cfg.Wrap(newDryRunWrapper(logger, fieldManager, r))
+}
+
+func newDryRunWrapper(logger *slog.Logger, fieldManager string, r prometheus.Registerer) transport.WrapperFunc {
+	drr := &dryRunReporter{
+		logger:       logger,
+		fieldManager: fieldManager,
+		changes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "prometheus_operator_dry_run_changes_total",
+				Help: "Total number of write requests which would have modified an object in dry-run mode.",
+			},
+			[]string{"resource", "verb"},
+		),
+		pendingChanges: map[string]string{},
+	}
+
+	r.MustRegister(
+		drr.changes,
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: "prometheus_operator_dry_run_pending_changes",
+				Help: "Number of objects which would be modified by the operator in dry-run mode.",
+			},
+			func() float64 {
+				drr.mtx.Lock()
+				defer drr.mtx.Unlock()
+				return float64(len(drr.pendingChanges))
+			},
+		),
+	)
+
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &dryRunRoundTripper{
+			rt:             rt,
+			dryRunReporter: drr,
+		}
+	}
+}
+
+func (drt *dryRunRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var verb string
+	switch req.Method {
+	case http.MethodPost:
+		verb = "create"
+	case http.MethodPut:
+		verb = "update"
+	case http.MethodPatch:
+		verb = "patch"
+	case http.MethodDelete:
+		verb = "delete"
+	default:
+		return drt.rt.RoundTrip(req)
+	}
+
+	target, ok := parseRequestPath(req.URL.Path)
+	if !ok {
+		return drt.rt.RoundTrip(req)
+	}
+
+	if _, found := nonPersistedGroups[target.group]; found {
+		return drt.rt.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dryRunReq, target, err := drt.dryRunRequest(req, target, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Events are written at a high rate and they aren't part of the
+	// reconciled state: they are sent in dry-run mode but not reported.
+	report := target.name != "" && target.resource != "events"
+
+	var current []byte
+	if report {
+		current, err = drt.get(req, target)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := drt.rt.RoundTrip(dryRunReq)
+	if err != nil || !report {
+		return resp, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, nil
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+
+	var desired []byte
+	if req.Method != http.MethodDelete {
+		desired = b
+	}
+
+	drt.report(target, verb, current, desired)
+
+	return resp, nil
+}
+
+// dryRunRequest returns the dry-run equivalent of the write request. The
+// returned target is updated when the request targets a collection.
+func (drt *dryRunRoundTripper) dryRunRequest(req *http.Request, target requestTarget, body []byte) (*http.Request, requestTarget, error) {
+	r := req.Clone(req.Context())
+	q := r.URL.Query()
+	q.Set("dryRun", metav1.DryRunAll)
+
+	isJSON := strings.Contains(req.Header.Get("Content-Type"), "json")
+	if req.Method == http.MethodPost && target.name == "" && isJSON {
+		var obj metav1.PartialObjectMetadata
+		if err := json.Unmarshal(body, &obj); err != nil {
+			return nil, target, fmt.Errorf("dry-run: failed to decode request body: %w", err)
+		}
+		target.name = obj.Name
+	}
+
+	// Creations and updates of named objects are converted into server-side
+	// apply requests.
+	if (req.Method == http.MethodPost || req.Method == http.MethodPut) && target.name != "" && isJSON &&
+		(req.Method == http.MethodPut || target.subresource == "") {
+		applyBody, err := applyPatch(body)
+		if err != nil {
+			return nil, target, fmt.Errorf("dry-run: failed to convert %s request into apply request: %w", req.Method, err)
+		}
+		body = applyBody
+
+		r.Method = http.MethodPatch
+		r.URL.Path = target.objectPath()
+		if target.subresource != "" {
+			r.URL.Path += "/" + target.subresource
+		}
+		r.Header.Set("Content-Type", string(types.ApplyPatchType))
+		q.Set("fieldManager", drt.fieldManager)
+		q.Set("force", "true")
+	}
+
+	r.URL.RawQuery = q.Encode()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return r, target, nil
+}
+
+// applyPatch returns the object as a server-side apply patch.
+func applyPatch(b []byte) ([]byte, error) {
+	obj := map[string]any{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	if md, ok := obj["metadata"].(map[string]any); ok {
+		// The resource version would act as a precondition and the managed
+		// fields must not be set.
+		delete(md, "resourceVersion")
+		delete(md, "managedFields")
+	}
+
+	return json.Marshal(obj)
+}
+
+// get returns the current state of the target object or nil if it doesn't
+// exist.
+func (drt *dryRunRoundTripper) get(req *http.Request, target requestTarget) ([]byte, error) {
+	r := req.Clone(req.Context())
+	r.Method = http.MethodGet
+	r.URL.Path = target.objectPath()
+	r.URL.RawQuery = ""
+	r.Body = nil
+	r.GetBody = nil
+	r.ContentLength = 0
+	r.Header.Del("Content-Type")
+	r.Header.Set("Accept", "application/json")
+
+	resp, err := drt.rt.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return nil, fmt.Errorf("dry-run: failed to get %s: unexpected status code %d", target, resp.StatusCode)
+	}
+
+	return b, nil
+}
+
+func (drr *dryRunReporter) report(target requestTarget, verb string, current, desired []byte) {
+	logger := drr.logger.With(
+		"verb", verb,
+		"group", target.group,
+		"resource", target.resource,
+		"namespace", target.namespace,
+		"name", target.name,
+		"subresource", target.subresource,
+	)
+
+	diff, err := objectDiff(target, current, desired)
+	if err != nil {
+		logger.Warn("dry-run: failed to compute the object's diff", "err", err)
+		return
+	}
+
+	key := target.String()
+
+	drr.mtx.Lock()
+	defer drr.mtx.Unlock()
+
+	if diff == "" {
+		delete(drr.pendingChanges, key)
+		logger.Debug("dry-run: no change")
+		return
+	}
+
+	drr.changes.WithLabelValues(target.resource, verb).Inc()
+
+	if drr.pendingChanges[key] == diff {
+		logger.Debug("dry-run: object would be modified (unchanged diff)")
+		return
+	}
+	drr.pendingChanges[key] = diff
+
+	logger.Info("dry-run: object would be modified", "diff", diff)
+}
+
+// objectDiff returns a human-readable diff between the current and desired
+// states of the object. An empty string means no difference.
+func objectDiff(target requestTarget, current, desired []byte) (string, error) {
+	c, err := normalizeObject(target, current)
+	if err != nil {
+		return "", err
+	}
+
+	d, err := normalizeObject(target, desired)
+	if err != nil {
+		return "", err
+	}
+
+	return cmp.Diff(c, d), nil
+}
+
+// normalizeObject decodes the object and removes the fields which are
+// managed by the API server. The data of Secrets is replaced by a hash to
+// avoid leaking sensitive information in the logs.
+func normalizeObject(target requestTarget, b []byte) (map[string]any, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	obj := map[string]any{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	if md, ok := obj["metadata"].(map[string]any); ok {
+		for _, k := range []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid"} {
+			delete(md, k)
+		}
+	}
+
+	if target.group == "" && target.resource == "secrets" {
+		for _, field := range []string{"data", "stringData"} {
+			data, ok := obj[field].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			for k, v := range data {
+				data[k] = fmt.Sprintf("<redacted sha256:%x>", sha256.Sum256([]byte(fmt.Sprint(v))))
+			}
+		}
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+type recordedRequest struct {
+	method      string
+	path        string
+	query       map[string][]string
+	contentType string
+}
+
+func TestParseRequestPath(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		expected requestTarget
+		invalid  bool
+	}{
+		{
+			path:     "/api/v1/namespaces/ns/secrets/foo",
+			expected: requestTarget{root: "/api/v1", resource: "secrets", namespace: "ns", name: "foo"},
+		},
+		{
+			path:     "/api/v1/namespaces/ns/secrets",
+			expected: requestTarget{root: "/api/v1", resource: "secrets", namespace: "ns"},
+		},
+		{
+			path:     "/apis/monitoring.coreos.com/v1/namespaces/ns/prometheuses/foo/status",
+			expected: requestTarget{root: "/apis/monitoring.coreos.com/v1", group: "monitoring.coreos.com", resource: "prometheuses", namespace: "ns", name: "foo", subresource: "status"},
+		},
+		{
+			path:     "/proxy/cluster/apis/apps/v1/namespaces/ns/statefulsets/foo",
+			expected: requestTarget{prefix: "/proxy/cluster", root: "/apis/apps/v1", group: "apps", resource: "statefulsets", namespace: "ns", name: "foo"},
+		},
+		{
+			path:     "/api/v1/nodes/foo",
+			expected: requestTarget{root: "/api/v1", resource: "nodes", name: "foo"},
+		},
+		{
+			path:    "/healthz",
+			invalid: true,
+		},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			target, ok := parseRequestPath(tc.path)
+			if tc.invalid {
+				require.False(t, ok)
+				return
+			}
+
+			require.True(t, ok)
+			require.Equal(t, tc.expected, target)
+		})
+	}
+}
+
+func TestDryRunWrapper(t *testing.T) {
+	current := &v1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       "ns",
+			ResourceVersion: "1",
+		},
+		Data: map[string][]byte{"key": []byte("old")},
+	}
+
+	var (
+		mtx      sync.Mutex
+		requests []recordedRequest
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		requests = append(requests, recordedRequest{
+			method:      r.Method,
+			path:        r.URL.Path,
+			query:       r.URL.Query(),
+			contentType: r.Header.Get("Content-Type"),
+		})
+		mtx.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path != "/api/v1/namespaces/ns/secrets/foo" {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(current)
+		case http.MethodDelete:
+			_ = json.NewEncoder(w).Encode(metav1.Status{Status: metav1.StatusSuccess})
+		default:
+			// Echo the request's body like the API server would return
+			// the object after a successful dry-run request.
+			_, _ = io.Copy(w, r.Body)
+		}
+	}))
+	defer srv.Close()
+
+	reg := prometheus.NewRegistry()
+	cfg := &rest.Config{Host: srv.URL}
+	EnableDryRun(cfg, slog.New(slog.DiscardHandler), "PrometheusOperator", reg)
+
+	kclient, err := kubernetes.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	last := func() recordedRequest {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return requests[len(requests)-1]
+	}
+
+	// Update is converted into a dry-run apply request.
+	desired := current.DeepCopy()
+	desired.Data["key"] = []byte("new")
+	_, err = kclient.CoreV1().Secrets("ns").Update(ctx, desired, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	req := last()
+	require.Equal(t, http.MethodPatch, req.method)
+	require.Equal(t, "/api/v1/namespaces/ns/secrets/foo", req.path)
+	require.Equal(t, string(types.ApplyPatchType), req.contentType)
+	require.Equal(t, []string{metav1.DryRunAll}, req.query["dryRun"])
+	require.Equal(t, []string{"PrometheusOperator"}, req.query["fieldManager"])
+	require.Equal(t, []string{"true"}, req.query["force"])
+
+	// Create is converted into a dry-run apply request against the object's
+	// path.
+	_, err = kclient.CoreV1().ConfigMaps("ns").Create(ctx, &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bar"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	req = last()
+	require.Equal(t, http.MethodPatch, req.method)
+	require.Equal(t, "/api/v1/namespaces/ns/configmaps/bar", req.path)
+	require.Equal(t, []string{metav1.DryRunAll}, req.query["dryRun"])
+
+	// Delete keeps its verb.
+	err = kclient.CoreV1().Secrets("ns").Delete(ctx, "foo", metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	req = last()
+	require.Equal(t, http.MethodDelete, req.method)
+	require.Equal(t, []string{metav1.DryRunAll}, req.query["dryRun"])
+
+	// Access reviews aren't persisted and are sent unchanged.
+	_, err = kclient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authv1.SelfSubjectAccessReview{}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	req = last()
+	require.Equal(t, http.MethodPost, req.method)
+	require.Empty(t, req.query["dryRun"])
+
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP prometheus_operator_dry_run_changes_total Total number of write requests which would have modified an object in dry-run mode.
+# TYPE prometheus_operator_dry_run_changes_total counter
+prometheus_operator_dry_run_changes_total{resource="configmaps",verb="create"} 1
+prometheus_operator_dry_run_changes_total{resource="secrets",verb="delete"} 1
+prometheus_operator_dry_run_changes_total{resource="secrets",verb="update"} 1
+# HELP prometheus_operator_dry_run_pending_changes Number of objects which would be modified by the operator in dry-run mode.
+# TYPE prometheus_operator_dry_run_pending_changes gauge
+prometheus_operator_dry_run_pending_changes 2
+`)))
+}
+
+func TestNormalizeObjectRedactsSecrets(t *testing.T) {
+	obj, err := normalizeObject(
+		requestTarget{resource: "secrets"},
+		[]byte(`{"metadata":{"name":"foo","resourceVersion":"1"},"data":{"key":"c2VjcmV0"}}`),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]any{"name": "foo"}, obj["metadata"])
+	require.NotContains(t, obj["data"].(map[string]any)["key"], "c2VjcmV0")
+}
@@ -57,7 +57,10 @@ import (
 // KubeConfigEnv (optionally) specify the location of kubeconfig file.
 const KubeConfigEnv = "KUBECONFIG"
 
-const StatusCleanupFinalizerName = "monitoring.coreos.com/status-cleanup"
+const (
+	StatusCleanupFinalizerName     = "monitoring.coreos.com/status-cleanup"
+	SilenceExpirationFinalizerName = "monitoring.coreos.com/silence-expiration"
+)
 
 var invalidDNS1123Characters = regexp.MustCompile("[^-a-z0-9]+")
 
@@ -351,6 +354,24 @@ func UpdateDaemonSet(ctx context.Context, dmsClient clientappsv1.DaemonSetInterf
 	})
 }
 
+// UpdateDeployment merges metadata of existing Deployment with new one and updates it.
+func UpdateDeployment(ctx context.Context, deployClient clientappsv1.DeploymentInterface, deploy *appsv1.Deployment) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existingDeploy, err := deployClient.Get(ctx, deploy.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		mergeMetadata(&deploy.ObjectMeta, existingDeploy.ObjectMeta)
+		// Propagate annotations set by kubectl on spec.template.annotations. e.g performing a rolling restart.
+		mergeKubectlAnnotations(&existingDeploy.Spec.Template.ObjectMeta, deploy.Spec.Template.ObjectMeta)
+
+		_, err = deployClient.Update(ctx, deploy, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 // CreateOrUpdateSecret merges metadata of existing Secret with new one and updates it.
 func CreateOrUpdateSecret(ctx context.Context, secretClient clientv1.SecretInterface, desired *v1.Secret) error {
 	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
@@ -650,3 +671,7 @@ func FinalizerDeletePatch(finalizers []string, finalizerName string) ([]byte, er
 func HasStatusCleanupFinalizer(obj metav1.Object) bool {
 	return slices.Contains(obj.GetFinalizers(), StatusCleanupFinalizerName)
 }
+
+func HasSilenceExpirationFinalizer(obj metav1.Object) bool {
+	return slices.Contains(obj.GetFinalizers(), SilenceExpirationFinalizerName)
+}
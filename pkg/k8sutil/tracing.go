@@ -0,0 +1,36 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"k8s.io/client-go/rest"
+)
+
+// EnableTracing configures the client to record a span for each request sent
+// to the Kubernetes API server. The spans are children of the span stored in
+// the request's context (if any).
+func EnableTracing(cfg *rest.Config) {
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(
+			rt,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return "kubernetes " + r.Method
+			}),
+		)
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// DeploymentReporter reports the state of the pods managed by a deployment.
+type DeploymentReporter struct {
+	Pods   []*Pod
+	deploy *appsv1.Deployment
+}
+
+// Update updates the replica counters of the governing object from the
+// deployment's status and returns its Available condition.
+func (dr *DeploymentReporter) Update(gObj GoverningObject) monitoringv1.Condition {
+	condition := monitoringv1.Condition{
+		Type:   monitoringv1.Available,
+		Status: monitoringv1.ConditionTrue,
+		LastTransitionTime: metav1.Time{
+			Time: time.Now().UTC(),
+		},
+		ObservedGeneration: gObj.GetGeneration(),
+	}
+
+	var status appsv1.DeploymentStatus
+	if dr.deploy != nil {
+		status = dr.deploy.Status
+	}
+
+	gObj.SetReplicas(int(status.Replicas))
+	gObj.SetUpdatedReplicas(int(status.UpdatedReplicas))
+	gObj.SetAvailableReplicas(int(status.AvailableReplicas))
+	gObj.SetUnavailableReplicas(int(status.UnavailableReplicas))
+
+	switch {
+	case dr.deploy == nil:
+		condition.Reason = "DeploymentNotFound"
+		condition.Status = monitoringv1.ConditionFalse
+	case int(status.AvailableReplicas) < gObj.ExpectedReplicas():
+		switch status.AvailableReplicas {
+		case 0:
+			condition.Reason = "NoPodReady"
+			condition.Status = monitoringv1.ConditionFalse
+		default:
+			condition.Reason = "SomePodsNotReady"
+			condition.Status = monitoringv1.ConditionDegraded
+		}
+	}
+
+	var messages []string
+	for _, p := range dr.Pods {
+		if m := p.Message(); m != "" {
+			messages = append(messages, fmt.Sprintf("pod %s: %s", p.Name, m))
+		}
+	}
+
+	condition.Message = strings.Join(messages, "\n")
+
+	return condition
+}
+
+// NewDeploymentReporter returns a deployment's reporter.
+func NewDeploymentReporter(ctx context.Context, kclient kubernetes.Interface, deploy *appsv1.Deployment) (*DeploymentReporter, error) {
+	if deploy == nil {
+		// deploy is nil when the controller couldn't create the deployment
+		// (incompatible spec fields for instance).
+		return &DeploymentReporter{
+			Pods: []*Pod{},
+		}, nil
+	}
+
+	ls, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		// Something is really broken if the deployment's selector isn't valid.
+		panic(err)
+	}
+
+	pods, err := kclient.CoreV1().Pods(deploy.Namespace).List(ctx, metav1.ListOptions{LabelSelector: ls.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	reporter := &DeploymentReporter{
+		deploy: deploy,
+		Pods:   make([]*Pod, 0, len(pods.Items)),
+	}
+	for _, p := range pods.Items {
+		// Only the pods controlled by the replicasets of the deployment are
+		// reported (the selector may match the pods of another workload).
+		var found bool
+		for _, owner := range p.OwnerReferences {
+			if owner.Kind == "ReplicaSet" && strings.HasPrefix(owner.Name, deploy.Name+"-") {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			continue
+		}
+
+		reporter.Pods = append(reporter.Pods, ptr.To(Pod(p)))
+	}
+
+	return reporter, nil
+}
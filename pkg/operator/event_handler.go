@@ -106,6 +106,18 @@ func LabelsChanged(ep EventPayload) bool {
 	return !reflect.DeepEqual(ep.OldMeta.GetLabels(), ep.CurrentMeta.GetLabels())
 }
 
+// AnnotationsChanged returns true if the old and current objects don't have
+// the same annotations.
+//
+// It always returns true for creation and deletion events.
+func AnnotationsChanged(ep EventPayload) bool {
+	if ep.EventType != EventTypeOnUpdate {
+		return true
+	}
+
+	return !reflect.DeepEqual(ep.OldMeta.GetAnnotations(), ep.CurrentMeta.GetAnnotations())
+}
+
 // EventHandlerOption allows to configure the event handler.
 type EventHandlerOption func(*EventHandler)
 
@@ -404,6 +404,9 @@ func (rr *ResourceReconciler) OnAdd(obj any, _ bool) {
 	case *appsv1.DaemonSet:
 		rr.onDaemonSetAdd(v)
 		return
+	case *appsv1.Deployment:
+		rr.onDeploymentAdd(v)
+		return
 	case *appsv1.StatefulSet:
 		rr.onStatefulSetAdd(v)
 		return
@@ -435,6 +438,9 @@ func (rr *ResourceReconciler) OnUpdate(old, cur any) {
 	case *appsv1.DaemonSet:
 		rr.onDaemonSetUpdate(old.(*appsv1.DaemonSet), v)
 		return
+	case *appsv1.Deployment:
+		rr.onDeploymentUpdate(old.(*appsv1.Deployment), v)
+		return
 	case *appsv1.StatefulSet:
 		rr.onStatefulSetUpdate(old.(*appsv1.StatefulSet), v)
 		return
@@ -459,7 +465,7 @@ func (rr *ResourceReconciler) OnUpdate(old, cur any) {
 		return
 	}
 
-	if !k8sutil.HasStatusCleanupFinalizer(mCur) && rr.DeletionInProgress(mCur) {
+	if !k8sutil.HasStatusCleanupFinalizer(mCur) && !k8sutil.HasSilenceExpirationFinalizer(mCur) && rr.DeletionInProgress(mCur) {
 		return
 	}
 
@@ -479,6 +485,9 @@ func (rr *ResourceReconciler) OnDelete(obj any) {
 	case *appsv1.DaemonSet:
 		rr.onDaemonSetDelete(v)
 		return
+	case *appsv1.Deployment:
+		rr.onDeploymentDelete(v)
+		return
 	case *appsv1.StatefulSet:
 		rr.onStatefulSetDelete(v)
 		return
@@ -527,6 +536,18 @@ func (rr *ResourceReconciler) onDaemonSetAdd(ds *appsv1.DaemonSet) {
 	rr.EnqueueForReconciliation(obj)
 }
 
+func (rr *ResourceReconciler) onDeploymentAdd(d *appsv1.Deployment) {
+	obj := rr.resolve(d)
+	if obj == nil {
+		return
+	}
+
+	rr.logger.Debug("Deployment added")
+	rr.metrics.TriggerByCounter("Deployment", AddEvent).Inc()
+
+	rr.EnqueueForReconciliation(obj)
+}
+
 func (rr *ResourceReconciler) onStatefulSetUpdate(old, cur *appsv1.StatefulSet) {
 	rr.logger.Debug("update handler", "resource", "statefulset", "old", old.ResourceVersion, "cur", cur.ResourceVersion)
 
@@ -586,6 +607,36 @@ func (rr *ResourceReconciler) onDaemonSetUpdate(old, cur *appsv1.DaemonSet) {
 	rr.EnqueueForReconciliation(obj)
 }
 
+func (rr *ResourceReconciler) onDeploymentUpdate(old, cur *appsv1.Deployment) {
+	rr.logger.Debug("update handler", "resource", "deployment", "old", old.ResourceVersion, "cur", cur.ResourceVersion)
+
+	if rr.DeletionInProgress(cur) {
+		return
+	}
+
+	if !rr.hasObjectChanged(old, cur) {
+		return
+	}
+
+	obj := rr.resolve(cur)
+	if obj == nil {
+		return
+	}
+
+	rr.logger.Debug("Deployment updated")
+	rr.metrics.TriggerByCounter("Deployment", UpdateEvent).Inc()
+
+	if !rr.hasStateChanged(old, cur) {
+		// If the deployment state (spec, labels or annotations) hasn't
+		// changed, the operator can only update the status subresource instead
+		// of doing a full reconciliation.
+		rr.EnqueueForStatus(obj)
+		return
+	}
+
+	rr.EnqueueForReconciliation(obj)
+}
+
 func (rr *ResourceReconciler) onStatefulSetDelete(ss *appsv1.StatefulSet) {
 	obj := rr.resolve(ss)
 	if obj == nil {
@@ -609,6 +660,18 @@ func (rr *ResourceReconciler) onDaemonSetDelete(ds *appsv1.DaemonSet) {
 	rr.EnqueueForReconciliation(obj)
 }
 
+func (rr *ResourceReconciler) onDeploymentDelete(d *appsv1.Deployment) {
+	obj := rr.resolve(d)
+	if obj == nil {
+		return
+	}
+
+	rr.logger.Debug("Deployment delete")
+	rr.metrics.TriggerByCounter("Deployment", DeleteEvent).Inc()
+
+	rr.EnqueueForReconciliation(obj)
+}
+
 // EnqueueForReconciliation asks for reconciling the object.
 func (rr *ResourceReconciler) EnqueueForReconciliation(obj metav1.Object) {
 	if !rr.isManagedByController(obj) {
@@ -627,6 +690,16 @@ func (rr *ResourceReconciler) EnqueueForStatus(obj metav1.Object) {
 	rr.statusQ.Add(obj.GetNamespace() + "/" + obj.GetName())
 }
 
+// EnqueueForStatusAfter asks for updating the status of the object once the
+// given duration has passed.
+func (rr *ResourceReconciler) EnqueueForStatusAfter(obj metav1.Object, d time.Duration) {
+	if !rr.isManagedByController(obj) {
+		return
+	}
+
+	rr.statusQ.AddAfter(obj.GetNamespace()+"/"+obj.GetName(), d)
+}
+
 // Run the goroutines responsible for processing the reconciliation and status
 // queues.
 func (rr *ResourceReconciler) Run(ctx context.Context) {
@@ -668,7 +741,9 @@ func (rr *ResourceReconciler) processNextReconcileItem(ctx context.Context) bool
 
 	rr.reconcileTotal.Inc()
 	startTime := time.Now()
-	err := rr.syncer.Sync(ctx, key)
+	spanCtx, span := StartSpan(ctx, "reconcile", controllerKindAttr.String(rr.resourceKind), objectKeyAttr.String(key))
+	err := rr.syncer.Sync(spanCtx, key)
+	EndSpan(span, err)
 	rr.reconcileDuration.Observe(time.Since(startTime).Seconds())
 
 	if err == nil {
@@ -692,7 +767,9 @@ func (rr *ResourceReconciler) processNextStatusItem(ctx context.Context) bool {
 	defer rr.statusQ.Done(key)
 
 	rr.statusTotal.Inc()
-	err := rr.syncer.UpdateStatus(ctx, key)
+	spanCtx, span := StartSpan(ctx, "update-status", controllerKindAttr.String(rr.resourceKind), objectKeyAttr.String(key))
+	err := rr.syncer.UpdateStatus(spanCtx, key)
+	EndSpan(span, err)
 	if err == nil {
 		rr.statusQ.Forget(key)
 		return true
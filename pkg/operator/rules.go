@@ -204,9 +204,32 @@ func ValidateRule(promRuleSpec monitoringv1.PrometheusRuleSpec) []error {
 	return errs
 }
 
+// PrometheusRuleSelection is the result of the PrometheusRule selection.
+type PrometheusRuleSelection struct {
+	// RuleFiles maps the rule file names to their content.
+	RuleFiles map[string]string
+	// Sources maps the rule file names to the PrometheusRule objects from
+	// which they have been generated.
+	Sources map[string]*monitoringv1.PrometheusRule
+	// Rejected maps the `<namespace>/<name>` keys of the rejected
+	// PrometheusRule objects to the rejection error.
+	Rejected map[string]error
+}
+
 // Select selects PrometheusRules and translates them into native Prometheus/Thanos configurations.
 // The second returned value is the number of rejected PrometheusRule objects.
 func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]string, int, error) {
+	sel, err := prs.SelectRules(namespaces)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return sel.RuleFiles, len(sel.Rejected), nil
+}
+
+// SelectRules is like Select but it also returns the PrometheusRule objects
+// from which the rule files have been generated and the rejected objects.
+func (prs *PrometheusRuleSelector) SelectRules(namespaces []string) (*PrometheusRuleSelection, error) {
 	promRules := map[string]*monitoringv1.PrometheusRule{}
 
 	for _, ns := range namespaces {
@@ -220,12 +243,15 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 			promRules[fmt.Sprintf("%v-%v-%v.yaml", promRule.Namespace, promRule.Name, promRule.UID)] = promRule
 		})
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to list prometheus rules in namespace %s: %w", ns, err)
+			return nil, fmt.Errorf("failed to list prometheus rules in namespace %s: %w", ns, err)
 		}
 	}
 
-	var rejected int
-	rules := make(map[string]string, len(promRules))
+	sel := &PrometheusRuleSelection{
+		RuleFiles: make(map[string]string, len(promRules)),
+		Sources:   make(map[string]*monitoringv1.PrometheusRule, len(promRules)),
+		Rejected:  map[string]error{},
+	}
 
 	for ruleName, promRule := range promRules {
 		var err error
@@ -236,7 +262,7 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 
 		content, err = prs.generateRulesConfiguration(promRule)
 		if err != nil {
-			rejected++
+			sel.Rejected[fmt.Sprintf("%s/%s", promRule.Namespace, promRule.Name)] = err
 			prs.logger.Warn(
 				"skipping prometheusrule",
 				"error", err.Error(),
@@ -247,11 +273,12 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 			continue
 		}
 
-		rules[ruleName] = content
+		sel.RuleFiles[ruleName] = content
+		sel.Sources[ruleName] = promRule
 	}
 
 	ruleNames := []string{}
-	for name := range rules {
+	for name := range sel.RuleFiles {
 		ruleNames = append(ruleNames, name)
 	}
 
@@ -260,5 +287,5 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (map[string]strin
 		"rules", strings.Join(ruleNames, ","),
 	)
 
-	return rules, rejected, nil
+	return sel, nil
 }
@@ -0,0 +1,556 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/template"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// RuleLintCheck identifies a semantic check performed on the rules of a
+// PrometheusRule object.
+type RuleLintCheck string
+
+const (
+	// RuleLintUnknownFunction reports PromQL functions which aren't supported
+	// by the target Prometheus version.
+	RuleLintUnknownFunction RuleLintCheck = "unknown-function"
+	// RuleLintRateOverGauge reports rate(), irate() and increase() applied to
+	// metrics which aren't named like counters.
+	RuleLintRateOverGauge RuleLintCheck = "rate-over-gauge"
+	// RuleLintMissingFor reports alerting rules without a `for` duration.
+	RuleLintMissingFor RuleLintCheck = "missing-for"
+	// RuleLintAnnotationTemplate reports annotation templates which fail to
+	// execute or reference labels not returned by the alert expression.
+	RuleLintAnnotationTemplate RuleLintCheck = "annotation-template"
+	// RuleLintDuplicateAlert reports alerting rules whose name is already
+	// used by another PrometheusRule object in the same namespace.
+	RuleLintDuplicateAlert RuleLintCheck = "duplicate-alert"
+)
+
+// RuleLintChecks lists all the supported checks.
+var RuleLintChecks = []RuleLintCheck{
+	RuleLintUnknownFunction,
+	RuleLintRateOverGauge,
+	RuleLintMissingFor,
+	RuleLintAnnotationTemplate,
+	RuleLintDuplicateAlert,
+}
+
+// RuleLintAction defines how the issues of a check are reported.
+type RuleLintAction string
+
+const (
+	// RuleLintWarn reports the issues as warnings.
+	RuleLintWarn RuleLintAction = "warn"
+	// RuleLintDeny rejects the rules.
+	RuleLintDeny RuleLintAction = "deny"
+	// RuleLintDisabled doesn't run the check.
+	RuleLintDisabled RuleLintAction = "disabled"
+)
+
+// RuleLintIssue is a problem found by a lint check.
+type RuleLintIssue struct {
+	Check   RuleLintCheck
+	Action  RuleLintAction
+	Group   string
+	Rule    string
+	Message string
+}
+
+func (i RuleLintIssue) String() string {
+	return fmt.Sprintf("group %q, rule %q: %s (%s)", i.Group, i.Rule, i.Message, i.Check)
+}
+
+// functionsMinVersion maps the PromQL functions known by the rule parser to
+// the first Prometheus version supporting them. Experimental functions aren't
+// listed because they are rejected by the rule validation. The table must be
+// updated when the Prometheus dependency adds new functions.
+var functionsMinVersion = map[string]semver.Version{
+	"abs":                semver.MustParse("2.0.0"),
+	"absent":             semver.MustParse("2.0.0"),
+	"absent_over_time":   semver.MustParse("2.16.0"),
+	"acos":               semver.MustParse("2.31.0"),
+	"acosh":              semver.MustParse("2.31.0"),
+	"asin":               semver.MustParse("2.31.0"),
+	"asinh":              semver.MustParse("2.31.0"),
+	"atan":               semver.MustParse("2.31.0"),
+	"atanh":              semver.MustParse("2.31.0"),
+	"avg_over_time":      semver.MustParse("2.0.0"),
+	"ceil":               semver.MustParse("2.0.0"),
+	"changes":            semver.MustParse("2.0.0"),
+	"clamp":              semver.MustParse("2.26.0"),
+	"clamp_max":          semver.MustParse("2.0.0"),
+	"clamp_min":          semver.MustParse("2.0.0"),
+	"cos":                semver.MustParse("2.31.0"),
+	"cosh":               semver.MustParse("2.31.0"),
+	"count_over_time":    semver.MustParse("2.0.0"),
+	"day_of_month":       semver.MustParse("2.0.0"),
+	"day_of_week":        semver.MustParse("2.0.0"),
+	"day_of_year":        semver.MustParse("2.31.0"),
+	"days_in_month":      semver.MustParse("2.0.0"),
+	"deg":                semver.MustParse("2.31.0"),
+	"delta":              semver.MustParse("2.0.0"),
+	"deriv":              semver.MustParse("2.0.0"),
+	"exp":                semver.MustParse("2.0.0"),
+	"floor":              semver.MustParse("2.0.0"),
+	"histogram_avg":      semver.MustParse("2.51.0"),
+	"histogram_count":    semver.MustParse("2.40.0"),
+	"histogram_fraction": semver.MustParse("2.40.0"),
+	"histogram_quantile": semver.MustParse("2.0.0"),
+	"histogram_stddev":   semver.MustParse("2.47.0"),
+	"histogram_stdvar":   semver.MustParse("2.47.0"),
+	"histogram_sum":      semver.MustParse("2.40.0"),
+	"hour":               semver.MustParse("2.0.0"),
+	"idelta":             semver.MustParse("2.0.0"),
+	"increase":           semver.MustParse("2.0.0"),
+	"irate":              semver.MustParse("2.0.0"),
+	"label_join":         semver.MustParse("2.0.0"),
+	"label_replace":      semver.MustParse("2.0.0"),
+	"last_over_time":     semver.MustParse("2.26.0"),
+	"ln":                 semver.MustParse("2.0.0"),
+	"log10":              semver.MustParse("2.0.0"),
+	"log2":               semver.MustParse("2.0.0"),
+	"max_over_time":      semver.MustParse("2.0.0"),
+	"min_over_time":      semver.MustParse("2.0.0"),
+	"minute":             semver.MustParse("2.0.0"),
+	"month":              semver.MustParse("2.0.0"),
+	"pi":                 semver.MustParse("2.31.0"),
+	"predict_linear":     semver.MustParse("2.0.0"),
+	"present_over_time":  semver.MustParse("2.29.0"),
+	"quantile_over_time": semver.MustParse("2.0.0"),
+	"rad":                semver.MustParse("2.31.0"),
+	"rate":               semver.MustParse("2.0.0"),
+	"resets":             semver.MustParse("2.0.0"),
+	"round":              semver.MustParse("2.0.0"),
+	"scalar":             semver.MustParse("2.0.0"),
+	"sgn":                semver.MustParse("2.26.0"),
+	"sin":                semver.MustParse("2.31.0"),
+	"sinh":               semver.MustParse("2.31.0"),
+	"sort":               semver.MustParse("2.0.0"),
+	"sort_desc":          semver.MustParse("2.0.0"),
+	"sqrt":               semver.MustParse("2.0.0"),
+	"stddev_over_time":   semver.MustParse("2.0.0"),
+	"stdvar_over_time":   semver.MustParse("2.0.0"),
+	"sum_over_time":      semver.MustParse("2.0.0"),
+	"tan":                semver.MustParse("2.31.0"),
+	"tanh":               semver.MustParse("2.31.0"),
+	"time":               semver.MustParse("2.0.0"),
+	"timestamp":          semver.MustParse("2.0.0"),
+	"vector":             semver.MustParse("2.0.0"),
+	"year":               semver.MustParse("2.0.0"),
+}
+
+// counterSuffixes are the suffixes of metric names which are expected to be
+// counters.
+var counterSuffixes = []string{"_total", "_count", "_sum", "_bucket"}
+
+// labelReference matches the label references in templates such as
+// `$labels.instance` or `.Labels.instance`.
+var labelReference = regexp.MustCompile(`(?:\$labels|\.Labels)\.([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// RuleLinter runs semantic checks on rules which are syntactically valid (see
+// ValidateRule). Unlike validation errors, the issues don't prevent Prometheus
+// from loading the rules but they usually indicate mistakes.
+type RuleLinter struct {
+	version semver.Version
+	actions map[RuleLintCheck]RuleLintAction
+}
+
+// NewRuleLinter returns a linter for the given Prometheus version. The
+// actions override the default action (warn) for the given checks.
+func NewRuleLinter(version string, actions map[string]string) (*RuleLinter, error) {
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Prometheus version: %w", err)
+	}
+
+	rl := &RuleLinter{
+		version: v,
+		actions: make(map[RuleLintCheck]RuleLintAction, len(RuleLintChecks)),
+	}
+
+	for _, c := range RuleLintChecks {
+		rl.actions[c] = RuleLintWarn
+	}
+
+	for c, a := range actions {
+		if !slices.Contains(RuleLintChecks, RuleLintCheck(c)) {
+			return nil, fmt.Errorf("unknown check %q", c)
+		}
+
+		switch action := RuleLintAction(a); action {
+		case RuleLintWarn, RuleLintDeny, RuleLintDisabled:
+			rl.actions[RuleLintCheck(c)] = action
+		default:
+			return nil, fmt.Errorf("invalid action %q for check %q", a, c)
+		}
+	}
+
+	return rl, nil
+}
+
+// Action returns the action configured for the check.
+func (rl *RuleLinter) Action(c RuleLintCheck) RuleLintAction {
+	return rl.actions[c]
+}
+
+// Lint returns the issues found in the rules of the PrometheusRule spec.
+func (rl *RuleLinter) Lint(promRuleSpec monitoringv1.PrometheusRuleSpec) []RuleLintIssue {
+	var issues []RuleLintIssue
+
+	for _, g := range promRuleSpec.Groups {
+		for _, r := range g.Rules {
+			name := r.Alert
+			if name == "" {
+				name = r.Record
+			}
+
+			report := func(c RuleLintCheck, format string, args ...any) {
+				if rl.actions[c] == RuleLintDisabled {
+					return
+				}
+
+				issues = append(issues, RuleLintIssue{
+					Check:   c,
+					Action:  rl.actions[c],
+					Group:   g.Name,
+					Rule:    name,
+					Message: fmt.Sprintf(format, args...),
+				})
+			}
+
+			expr, err := parser.ParseExpr(r.Expr.String())
+			if err != nil {
+				// Invalid expressions are reported by ValidateRule.
+				continue
+			}
+
+			rl.lintExpression(expr, report)
+
+			if r.Alert == "" {
+				continue
+			}
+
+			if d, err := model.ParseDuration(string(ptr.Deref(r.For, ""))); err != nil || d == 0 {
+				report(RuleLintMissingFor, "alerting rule without 'for' duration fires on the first evaluation")
+			}
+
+			lintAnnotations(r, expr, report)
+		}
+	}
+
+	return issues
+}
+
+// LintDuplicateAlerts returns the alerting rules of the PrometheusRule whose
+// name is also used by other PrometheusRule objects from the same namespace.
+// Duplicate names within the object aren't reported because it's a common
+// practice to define the same alert with different severities.
+func (rl *RuleLinter) LintDuplicateAlerts(promRule *monitoringv1.PrometheusRule, others []monitoringv1.PrometheusRule) []RuleLintIssue {
+	if rl.actions[RuleLintDuplicateAlert] == RuleLintDisabled {
+		return nil
+	}
+
+	// Map the alert names to the name of the first object defining them.
+	alerts := map[string]string{}
+	for _, o := range others {
+		if o.Namespace != promRule.Namespace || o.Name == promRule.Name {
+			continue
+		}
+
+		for _, g := range o.Spec.Groups {
+			for _, r := range g.Rules {
+				if r.Alert == "" {
+					continue
+				}
+
+				if _, found := alerts[r.Alert]; !found {
+					alerts[r.Alert] = o.Name
+				}
+			}
+		}
+	}
+
+	var issues []RuleLintIssue
+	for _, g := range promRule.Spec.Groups {
+		for _, r := range g.Rules {
+			other, found := alerts[r.Alert]
+			if r.Alert == "" || !found {
+				continue
+			}
+
+			issues = append(issues, RuleLintIssue{
+				Check:   RuleLintDuplicateAlert,
+				Action:  rl.actions[RuleLintDuplicateAlert],
+				Group:   g.Name,
+				Rule:    r.Alert,
+				Message: fmt.Sprintf("alert name already defined by PrometheusRule %q", other),
+			})
+		}
+	}
+
+	return issues
+}
+
+func (rl *RuleLinter) lintExpression(expr parser.Expr, report func(RuleLintCheck, string, ...any)) {
+	parser.Inspect(expr, func(node parser.Node, path []parser.Node) error {
+		call, ok := node.(*parser.Call)
+		if !ok {
+			return nil
+		}
+
+		if v, found := functionsMinVersion[call.Func.Name]; !found && !call.Func.Experimental {
+			report(RuleLintUnknownFunction, "function %s() isn't known by the operator", call.Func.Name)
+		} else if found && rl.version.LT(v) {
+			report(RuleLintUnknownFunction, "function %s() requires Prometheus >= %s", call.Func.Name, v)
+		}
+
+		switch call.Func.Name {
+		case "rate", "irate", "increase":
+		default:
+			return nil
+		}
+
+		// Native histograms don't follow the counter naming convention.
+		for _, n := range path {
+			if c, ok := n.(*parser.Call); ok && strings.HasPrefix(c.Func.Name, "histogram_") {
+				return nil
+			}
+		}
+
+		metric := metricName(call.Args[0])
+		if metric == "" {
+			return nil
+		}
+
+		for _, s := range counterSuffixes {
+			if strings.HasSuffix(metric, s) {
+				return nil
+			}
+		}
+
+		report(RuleLintRateOverGauge, "%s() applied to metric %q which isn't named like a counter", call.Func.Name, metric)
+		return nil
+	})
+}
+
+// metricName returns the metric name of a range vector selector or an empty
+// string if it can't be determined.
+func metricName(expr parser.Expr) string {
+	ms, ok := expr.(*parser.MatrixSelector)
+	if !ok {
+		return ""
+	}
+
+	vs, ok := ms.VectorSelector.(*parser.VectorSelector)
+	if !ok {
+		return ""
+	}
+
+	if vs.Name != "" {
+		return vs.Name
+	}
+
+	for _, m := range vs.LabelMatchers {
+		if m.Name == labels.MetricName && m.Type == labels.MatchEqual {
+			return m.Value
+		}
+	}
+
+	return ""
+}
+
+func lintAnnotations(r monitoringv1.Rule, expr parser.Expr, report func(RuleLintCheck, string, ...any)) {
+	outputLabels, known := expressionLabels(expr)
+	if known {
+		// The labels of the rule and the alert name are always set on the
+		// alerts.
+		outputLabels = append(outputLabels, labels.AlertName)
+		for k := range r.Labels {
+			outputLabels = append(outputLabels, k)
+		}
+	}
+
+	defs := []string{
+		"{{$labels := .Labels}}",
+		"{{$externalLabels := .ExternalLabels}}",
+		"{{$externalURL := .ExternalURL}}",
+		"{{$value := .Value}}",
+	}
+
+	// The query function returns a single sample to avoid failures when
+	// the template accesses the first result.
+	queryFn := func(context.Context, string, time.Time) (promql.Vector, error) {
+		return promql.Vector{promql.Sample{F: 1, Metric: labels.EmptyLabels()}}, nil
+	}
+
+	keys := make([]string, 0, len(r.Annotations))
+	for k := range r.Annotations {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		text := r.Annotations[k]
+
+		// Fill the referenced labels with a numeric value so that
+		// conversion functions (e.g. humanize) don't fail.
+		lbls := map[string]string{}
+		for _, m := range labelReference.FindAllStringSubmatch(text, -1) {
+			lbls[m[1]] = "1"
+
+			if known && !slices.Contains(outputLabels, m[1]) {
+				report(RuleLintAnnotationTemplate, "annotation %q references label %q which isn't returned by the expression", k, m[1])
+			}
+		}
+
+		tmpl := template.NewTemplateExpander(
+			context.Background(),
+			strings.Join(append(defs, text), ""),
+			"__alert_"+r.Alert,
+			template.AlertTemplateData(lbls, map[string]string{}, "", promql.Sample{F: 1}),
+			model.Now(),
+			queryFn,
+			nil,
+			nil,
+		)
+		if _, err := tmpl.Expand(); err != nil {
+			// Strip the template name and position which are meaningless
+			// to the user because of the definitions' prefix.
+			msg := err.Error()
+			if i := strings.Index(msg, " at <"); i >= 0 {
+				msg = msg[i+1:]
+			}
+			report(RuleLintAnnotationTemplate, "annotation %q fails to execute %s", k, msg)
+		}
+	}
+}
+
+// expressionLabels returns the names of the labels returned by the
+// expression. The second value is false if the labels can't be determined
+// (e.g. the expression returns the labels of the selected series).
+func expressionLabels(expr parser.Expr) ([]string, bool) {
+	switch e := expr.(type) {
+	case *parser.ParenExpr:
+		return expressionLabels(e.Expr)
+
+	case *parser.StepInvariantExpr:
+		return expressionLabels(e.Expr)
+
+	case *parser.NumberLiteral:
+		return nil, true
+
+	case *parser.AggregateExpr:
+		switch e.Op {
+		case parser.TOPK, parser.BOTTOMK, parser.LIMITK, parser.LIMIT_RATIO:
+			return expressionLabels(e.Expr)
+		}
+
+		if e.Without {
+			return nil, false
+		}
+
+		grouping := slices.Clone(e.Grouping)
+		if e.Op == parser.COUNT_VALUES {
+			if s, ok := e.Param.(*parser.StringLiteral); ok {
+				grouping = append(grouping, s.Val)
+			}
+		}
+
+		return grouping, true
+
+	case *parser.BinaryExpr:
+		if e.LHS.Type() == parser.ValueTypeScalar {
+			return expressionLabels(e.RHS)
+		}
+
+		lhs, known := expressionLabels(e.LHS)
+		if !known {
+			return nil, false
+		}
+
+		switch e.Op {
+		case parser.LOR:
+			rhs, known := expressionLabels(e.RHS)
+			if !known {
+				return nil, false
+			}
+
+			return append(lhs, rhs...), true
+
+		case parser.LAND, parser.LUNLESS:
+			return lhs, true
+		}
+
+		if e.VectorMatching != nil && e.VectorMatching.Card == parser.CardOneToMany {
+			// The result has the labels of the right-hand side.
+			rhs, known := expressionLabels(e.RHS)
+			if !known {
+				return nil, false
+			}
+
+			return append(rhs, e.VectorMatching.Include...), true
+		}
+
+		if e.VectorMatching != nil && e.VectorMatching.Card == parser.CardManyToOne {
+			lhs = append(lhs, e.VectorMatching.Include...)
+		}
+
+		return lhs, true
+
+	case *parser.Call:
+		switch e.Func.Name {
+		case "vector", "time", "scalar", "pi":
+			return nil, true
+
+		case "label_replace", "label_join":
+			lbls, known := expressionLabels(e.Args[0])
+			if !known {
+				return nil, false
+			}
+
+			if s, ok := e.Args[1].(*parser.StringLiteral); ok {
+				lbls = append(lbls, s.Val)
+			}
+
+			return lbls, true
+		}
+
+		for _, arg := range e.Args {
+			if arg.Type() == parser.ValueTypeVector {
+				return expressionLabels(arg)
+			}
+		}
+
+		return nil, false
+	}
+
+	return nil, false
+}
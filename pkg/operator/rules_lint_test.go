@@ -0,0 +1,279 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestRuleLinter(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		version  string
+		actions  map[string]string
+		rule     monitoringv1.Rule
+		expected []RuleLintIssue
+	}{
+		{
+			name: "valid alerting rule",
+			rule: monitoringv1.Rule{
+				Alert: "HighErrorRate",
+				Expr:  intstr.FromString(`sum by (job) (rate(http_requests_total{code="500"}[5m])) > 1`),
+				For:   ptr.To(monitoringv1.Duration("5m")),
+				Annotations: map[string]string{
+					"summary": "Job {{ $labels.job }} has {{ $value | humanize }} errors/s.",
+				},
+			},
+		},
+		{
+			name: "valid recording rule",
+			rule: monitoringv1.Rule{
+				Record: "job:http_request_duration_seconds:p99",
+				Expr:   intstr.FromString(`histogram_quantile(0.99, sum by (job) (rate(http_request_duration_seconds[5m])))`),
+			},
+		},
+		{
+			name:    "unsupported function",
+			version: "v2.46.0",
+			rule: monitoringv1.Rule{
+				Record: "job:http_request_duration_seconds:stddev",
+				Expr:   intstr.FromString(`histogram_stddev(rate(http_request_duration_seconds[5m]))`),
+			},
+			expected: []RuleLintIssue{{
+				Check:   RuleLintUnknownFunction,
+				Action:  RuleLintWarn,
+				Rule:    "job:http_request_duration_seconds:stddev",
+				Message: "function histogram_stddev() requires Prometheus >= 2.47.0",
+			}},
+		},
+		{
+			name:    "supported function",
+			version: "v2.47.0",
+			rule: monitoringv1.Rule{
+				Record: "job:http_request_duration_seconds:stddev",
+				Expr:   intstr.FromString(`histogram_stddev(rate(http_request_duration_seconds[5m]))`),
+			},
+		},
+		{
+			name:    "unsupported non-histogram function",
+			version: "v2.28.0",
+			rule: monitoringv1.Rule{
+				Alert: "TargetMissing",
+				Expr:  intstr.FromString(`absent(present_over_time(up{job="api"}[5m]))`),
+				For:   ptr.To(monitoringv1.Duration("5m")),
+			},
+			expected: []RuleLintIssue{{
+				Check:   RuleLintUnknownFunction,
+				Action:  RuleLintWarn,
+				Rule:    "TargetMissing",
+				Message: "function present_over_time() requires Prometheus >= 2.29.0",
+			}},
+		},
+		{
+			name: "rate over gauge",
+			rule: monitoringv1.Rule{
+				Record: "instance:memory_usage:rate5m",
+				Expr:   intstr.FromString(`rate({__name__="node_memory_MemFree_bytes"}[5m])`),
+			},
+			expected: []RuleLintIssue{{
+				Check:   RuleLintRateOverGauge,
+				Action:  RuleLintWarn,
+				Rule:    "instance:memory_usage:rate5m",
+				Message: `rate() applied to metric "node_memory_MemFree_bytes" which isn't named like a counter`,
+			}},
+		},
+		{
+			name: "missing for",
+			rule: monitoringv1.Rule{
+				Alert: "InstanceDown",
+				Expr:  intstr.FromString(`up == 0`),
+				For:   ptr.To(monitoringv1.Duration("0s")),
+			},
+			expected: []RuleLintIssue{{
+				Check:   RuleLintMissingFor,
+				Action:  RuleLintWarn,
+				Rule:    "InstanceDown",
+				Message: "alerting rule without 'for' duration fires on the first evaluation",
+			}},
+		},
+		{
+			name: "annotation referencing aggregated label",
+			rule: monitoringv1.Rule{
+				Alert: "HighErrorRate",
+				Expr:  intstr.FromString(`sum by (job) (rate(http_requests_total[5m])) > 1`),
+				For:   ptr.To(monitoringv1.Duration("5m")),
+				Annotations: map[string]string{
+					"summary": "Instance {{ $labels.instance }} of {{ .Labels.job }} is failing.",
+				},
+			},
+			expected: []RuleLintIssue{{
+				Check:   RuleLintAnnotationTemplate,
+				Action:  RuleLintWarn,
+				Rule:    "HighErrorRate",
+				Message: `annotation "summary" references label "instance" which isn't returned by the expression`,
+			}},
+		},
+		{
+			name: "annotation referencing rule label",
+			rule: monitoringv1.Rule{
+				Alert: "HighErrorRate",
+				Expr:  intstr.FromString(`sum by (job) (rate(http_requests_total[5m])) > 1`),
+				For:   ptr.To(monitoringv1.Duration("5m")),
+				Labels: map[string]string{
+					"severity": "critical",
+				},
+				Annotations: map[string]string{
+					"summary": "Job {{ $labels.job }} is failing ({{ $labels.severity }}).",
+				},
+			},
+		},
+		{
+			name: "annotation referencing alert name",
+			rule: monitoringv1.Rule{
+				Alert: "HighErrorRate",
+				Expr:  intstr.FromString(`sum by (job) (rate(http_requests_total[5m])) > 1`),
+				For:   ptr.To(monitoringv1.Duration("5m")),
+				Annotations: map[string]string{
+					"summary": "{{ $labels.alertname }} for job {{ $labels.job }}.",
+				},
+			},
+		},
+		{
+			name: "annotation failing to execute",
+			rule: monitoringv1.Rule{
+				Alert: "InstanceDown",
+				Expr:  intstr.FromString(`up == 0`),
+				For:   ptr.To(monitoringv1.Duration("5m")),
+				Annotations: map[string]string{
+					"summary": "{{ $labels.instance.name }} is down.",
+				},
+			},
+			expected: []RuleLintIssue{{
+				Check:   RuleLintAnnotationTemplate,
+				Action:  RuleLintWarn,
+				Rule:    "InstanceDown",
+				Message: `annotation "summary" fails to execute at <$labels.instance.name>: can't evaluate field name in type string`,
+			}},
+		},
+		{
+			name:    "denied check",
+			actions: map[string]string{"missing-for": "deny"},
+			rule: monitoringv1.Rule{
+				Alert: "InstanceDown",
+				Expr:  intstr.FromString(`up == 0`),
+			},
+			expected: []RuleLintIssue{{
+				Check:   RuleLintMissingFor,
+				Action:  RuleLintDeny,
+				Rule:    "InstanceDown",
+				Message: "alerting rule without 'for' duration fires on the first evaluation",
+			}},
+		},
+		{
+			name:    "disabled check",
+			actions: map[string]string{"missing-for": "disabled"},
+			rule: monitoringv1.Rule{
+				Alert: "InstanceDown",
+				Expr:  intstr.FromString(`up == 0`),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			version := tc.version
+			if version == "" {
+				version = DefaultPrometheusVersion
+			}
+
+			rl, err := NewRuleLinter(version, tc.actions)
+			require.NoError(t, err)
+
+			issues := rl.Lint(monitoringv1.PrometheusRuleSpec{
+				Groups: []monitoringv1.RuleGroup{{
+					Name:  "group",
+					Rules: []monitoringv1.Rule{tc.rule},
+				}},
+			})
+
+			for i := range tc.expected {
+				tc.expected[i].Group = "group"
+			}
+			require.Equal(t, tc.expected, issues)
+		})
+	}
+}
+
+// TestFunctionsMinVersion verifies that all the functions supported by the
+// rule parser have a minimum version.
+func TestFunctionsMinVersion(t *testing.T) {
+	for name, f := range parser.Functions {
+		if f.Experimental {
+			continue
+		}
+
+		_, found := functionsMinVersion[name]
+		require.True(t, found, "missing minimum version for function %s()", name)
+	}
+}
+
+func TestNewRuleLinterInvalidActions(t *testing.T) {
+	_, err := NewRuleLinter(DefaultPrometheusVersion, map[string]string{"foo": "warn"})
+	require.Error(t, err)
+
+	_, err = NewRuleLinter(DefaultPrometheusVersion, map[string]string{"missing-for": "foo"})
+	require.Error(t, err)
+}
+
+func TestRuleLinterDuplicateAlerts(t *testing.T) {
+	newPrometheusRule := func(ns, name string, alerts ...string) monitoringv1.PrometheusRule {
+		var rules []monitoringv1.Rule
+		for _, a := range alerts {
+			rules = append(rules, monitoringv1.Rule{Alert: a, Expr: intstr.FromString("vector(1)")})
+		}
+
+		return monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec: monitoringv1.PrometheusRuleSpec{
+				Groups: []monitoringv1.RuleGroup{{Name: "group", Rules: rules}},
+			},
+		}
+	}
+
+	rl, err := NewRuleLinter(DefaultPrometheusVersion, nil)
+	require.NoError(t, err)
+
+	promRule := newPrometheusRule("ns", "foo", "Foo", "Foo", "Bar")
+	issues := rl.LintDuplicateAlerts(&promRule, []monitoringv1.PrometheusRule{
+		// The previous version of the object itself is ignored.
+		newPrometheusRule("ns", "foo", "Foo", "Bar"),
+		newPrometheusRule("ns", "bar", "Bar"),
+		newPrometheusRule("other", "baz", "Foo"),
+	})
+
+	require.Equal(t, []RuleLintIssue{{
+		Check:   RuleLintDuplicateAlert,
+		Action:  RuleLintWarn,
+		Group:   "group",
+		Rule:    "Bar",
+		Message: `alert name already defined by PrometheusRule "bar"`,
+	}}, issues)
+}
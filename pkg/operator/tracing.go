@@ -0,0 +1,59 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const tracerName = "github.com/prometheus-operator/prometheus-operator"
+
+const (
+	controllerKindAttr = attribute.Key("controller.kind")
+	objectKeyAttr      = attribute.Key("k8s.object.key")
+	objectGenAttr      = attribute.Key("k8s.object.generation")
+	outcomeAttr        = attribute.Key("outcome")
+)
+
+// StartSpan starts a new span for the given operation. The span is a no-op
+// when tracing isn't enabled.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the outcome of the operation and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.SetAttributes(outcomeAttr.String("error"))
+	} else {
+		span.SetAttributes(outcomeAttr.String("success"))
+	}
+
+	span.End()
+}
+
+// SetSpanObject adds the generation of the reconciled object to the span
+// stored in the context.
+func SetSpanObject(ctx context.Context, obj metav1.Object) {
+	trace.SpanFromContext(ctx).SetAttributes(objectGenAttr.Int64(obj.GetGeneration()))
+}
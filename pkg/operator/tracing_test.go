@@ -0,0 +1,63 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSpans(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx, span := StartSpan(context.Background(), "reconcile", objectKeyAttr.String("default/test"))
+	SetSpanObject(ctx, &metav1.ObjectMeta{Generation: 3})
+
+	_, child := StartSpan(ctx, "generate-config")
+	EndSpan(child, errors.New("invalid configuration"))
+	EndSpan(span, nil)
+
+	spans := sr.Ended()
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "generate-config", spans[0].Name())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Contains(t, spans[0].Attributes(), outcomeAttr.String("error"))
+	require.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+
+	require.Equal(t, "reconcile", spans[1].Name())
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+	require.ElementsMatch(t,
+		[]attribute.KeyValue{
+			objectKeyAttr.String("default/test"),
+			objectGenAttr.Int64(3),
+			outcomeAttr.String("success"),
+		},
+		spans[1].Attributes(),
+	)
+}
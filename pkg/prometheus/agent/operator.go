@@ -18,17 +18,20 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/mitchellh/hashstructure"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
@@ -91,7 +94,9 @@ type Operator struct {
 
 	newEventRecorder operator.NewEventRecorderFunc
 
-	statusReporter prompkg.StatusReporter
+	statusReporter  prompkg.StatusReporter
+	shardAutoscaler *prompkg.ShardAutoscaler
+	selections      *prompkg.SelectionTracker
 
 	daemonSetFeatureGateEnabled  bool
 	configResourcesStatusEnabled bool
@@ -380,16 +385,45 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	o.shardAutoscaler = prompkg.NewShardAutoscaler(
+		o.logger,
+		o.kclient,
+		o.mclient,
+		o.ssetInfs,
+		prompkg.NewHTTPShardLoadGetter(o.kclient),
+		o.newEventRecorder,
+	)
+
+	o.selections = prompkg.NewSelectionTracker()
+
 	o.statusReporter = prompkg.StatusReporter{
 		Kclient:         o.kclient,
 		Reconciliations: o.reconciliations,
 		SsetInfs:        o.ssetInfs,
 		Rr:              o.rr,
+		ShardAutoscaler: o.shardAutoscaler,
 	}
 
 	return o, nil
 }
 
+// Register registers the HTTP handlers of the controller.
+func (c *Operator) Register(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/prometheusagents/{namespace}/{name}/selected", c.selections)
+	mux.Handle("GET /api/v1/prometheusagents/{namespace}/{name}/provenance", prompkg.NewProvenanceHandler(
+		c.logger,
+		c.kclient,
+		func(namespace, name string) (monitoringv1.PrometheusInterface, error) {
+			p, err := operator.GetObjectFromKey[*monitoringv1alpha1.PrometheusAgent](c.promInfs, namespace+"/"+name)
+			if err != nil || p == nil {
+				return nil, err
+			}
+
+			return p, nil
+		},
+	))
+}
+
 // Run the controller.
 func (c *Operator) Run(ctx context.Context) error {
 	go c.rr.Run(ctx)
@@ -425,6 +459,13 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	// TODO(simonpasquier): watch for PrometheusAgent pods instead of polling.
 	go operator.StatusPoller(ctx, c)
+	go c.shardAutoscaler.Run(ctx, func(fn func(monitoringv1.PrometheusInterface)) {
+		if err := c.promInfs.ListAll(labels.Everything(), func(o any) {
+			fn(o.(*monitoringv1alpha1.PrometheusAgent))
+		}); err != nil {
+			c.logger.Error("failed to list PrometheusAgent objects", "err", err)
+		}
+	})
 
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
@@ -610,10 +651,12 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.selections.Delete(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
 
+	operator.SetSpanObject(ctx, p)
 	logger := c.logger.With("key", key)
 
 	finalizersAdded, err := c.finalizerSyncer.Sync(ctx, p, c.rr.DeletionInProgress(p), func() error { return nil })
@@ -630,6 +673,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	// Check if the Agent instance is marked for deletion.
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.selections.Delete(key)
 		return nil
 	}
 
@@ -666,7 +710,9 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
 
-	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
+	spanCtx, span := operator.StartSpan(ctx, "apply-tls-assets")
+	tlsAssets, err := operator.ReconcileShardedSecret(spanCtx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
+	operator.EndSpan(span, err)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
@@ -713,7 +759,10 @@ func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv
 
 	if notFound {
 		logger.Debug("creating daemonset")
-		if _, err := dsetClient.Create(ctx, dset, metav1.CreateOptions{}); err != nil {
+		spanCtx, span := operator.StartSpan(ctx, "create-daemonset", attribute.String("daemonset", dset.Name))
+		_, err := dsetClient.Create(spanCtx, dset, metav1.CreateOptions{})
+		operator.EndSpan(span, err)
+		if err != nil {
 			return fmt.Errorf("creating daemonset failed: %w", err)
 		}
 
@@ -721,7 +770,9 @@ func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv
 		return nil
 	}
 
-	err = k8sutil.UpdateDaemonSet(ctx, dsetClient, dset)
+	spanCtx, span := operator.StartSpan(ctx, "update-daemonset", attribute.String("daemonset", dset.Name))
+	err = k8sutil.UpdateDaemonSet(spanCtx, dsetClient, dset)
+	operator.EndSpan(span, err)
 	sErr, ok := err.(*apierrors.StatusError)
 
 	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
@@ -824,7 +875,10 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 
 		if notFound {
 			logger.Debug("creating statefulset")
-			if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
+			spanCtx, span := operator.StartSpan(ctx, "create-statefulset", attribute.String("statefulset", ssetName))
+			_, err := ssetClient.Create(spanCtx, sset, metav1.CreateOptions{})
+			operator.EndSpan(span, err)
+			if err != nil {
 				return fmt.Errorf("creating statefulset failed: %w", err)
 			}
 			continue
@@ -840,7 +894,9 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 			"existing_hash", existingStatefulSet.Annotations[operator.InputHashAnnotationKey],
 		)
 
-		err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
+		spanCtx, span := operator.StartSpan(ctx, "update-statefulset", attribute.String("statefulset", ssetName))
+		err = k8sutil.UpdateStatefulSet(spanCtx, ssetClient, sset)
+		operator.EndSpan(span, err)
 		sErr, ok := err.(*apierrors.StatusError)
 
 		if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
@@ -895,54 +951,97 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 	return nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
+type selectedConfigResources struct {
+	sMons         prompkg.TypedResourcesSelection[*monitoringv1.ServiceMonitor]
+	pMons         prompkg.TypedResourcesSelection[*monitoringv1.PodMonitor]
+	bMons         prompkg.TypedResourcesSelection[*monitoringv1.Probe]
+	scrapeConfigs prompkg.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
+}
+
+func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, store *assets.StoreBuilder) (*selectedConfigResources, error) {
 	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+		return nil, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
 	}
 
 	pmons, err := resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting PodMonitors failed: %w", err)
+		return nil, fmt.Errorf("selecting PodMonitors failed: %w", err)
 	}
 
 	bmons, err := resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting Probes failed: %w", err)
+		return nil, fmt.Errorf("selecting Probes failed: %w", err)
 	}
 
 	var scrapeConfigs prompkg.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
 	if c.sconInfs != nil {
 		scrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
 		if err != nil {
-			return fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+			return nil, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
 		}
 	}
 
+	return &selectedConfigResources{
+		sMons:         smons,
+		bMons:         bmons,
+		pMons:         pmons,
+		scrapeConfigs: scrapeConfigs,
+	}, nil
+}
+
+// loadConfigurationSecrets loads the Secrets and ConfigMaps referenced by the
+// PrometheusAgent object into the store and returns the additional scrape
+// configurations.
+func loadConfigurationSecrets(ctx context.Context, logger *slog.Logger, sClient corev1client.SecretInterface, p *monitoringv1alpha1.PrometheusAgent, store *assets.StoreBuilder) ([]byte, error) {
 	if err := prompkg.AddRemoteWritesToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
-	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+	}
+
+	return additionalScrapeConfigs, nil
+}
+
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
+	spanCtx, span := operator.StartSpan(ctx, "select-resources")
+	resources, err := c.getSelectedConfigResources(spanCtx, logger, p, store)
+	operator.EndSpan(span, err)
+	if err != nil {
+		return err
+	}
+	smons, pmons, bmons, scrapeConfigs := resources.sMons, resources.pMons, resources.bMons, resources.scrapeConfigs
+
+	if key, ok := c.accessor.MetaNamespaceKey(p); ok {
+		c.selections.Set(key, prompkg.NewSelectionReport(smons, pmons, bmons, scrapeConfigs, nil))
+	}
+
+	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
+	spanCtx, span = operator.StartSpan(ctx, "load-secrets")
+	additionalScrapeConfigs, err := loadConfigurationSecrets(spanCtx, logger, sClient, p, store)
+	operator.EndSpan(span, err)
+	if err != nil {
+		return err
 	}
 
 	// Update secret based on the most recent configuration.
+	_, span = operator.StartSpan(ctx, "generate-config")
 	conf, err := cg.GenerateAgentConfiguration(
 		smons.ValidResources(),
 		pmons.ValidResources(),
@@ -951,18 +1050,32 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		store,
 		additionalScrapeConfigs,
 	)
+	operator.EndSpan(span, err)
 	if err != nil {
 		return fmt.Errorf("generating config failed: %w", err)
 	}
 
+	provenance := prompkg.NewConfigProvenance(
+		p,
+		smons.ValidResources(),
+		pmons.ValidResources(),
+		bmons.ValidResources(),
+		scrapeConfigs.ValidResources(),
+		nil,
+	)
+
 	// Compress config to avoid 1mb secret limit for a while
-	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
+	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf, provenance)
 	if err != nil {
 		return fmt.Errorf("creating compressed secret failed: %w", err)
 	}
 
 	logger.Debug("updating Prometheus configuration secret")
-	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	ctx, span = operator.StartSpan(ctx, "apply-config-secret")
+	err = k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	operator.EndSpan(span, err)
+
+	return err
 }
 
 func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config, tlsAssets *operator.ShardedSecret, ssSpec appsv1.StatefulSetSpec) (string, error) {
@@ -1031,7 +1144,7 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 		return fmt.Errorf("failed to create selector for prometheus agent scale status: %w", err)
 	}
 	p.Status.Selector = selector.String()
-	p.Status.Shards = ptr.Deref(p.Spec.Shards, 1)
+	p.Status.Shards = prompkg.ShardsNumber(p)
 
 	if _, err = c.mclient.MonitoringV1alpha1().PrometheusAgents(p.Namespace).ApplyStatus(ctx, prompkg.ApplyConfigurationFromPrometheusAgent(p, true), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		c.logger.Info("failed to apply prometheus status subresource, trying again without scale fields", "err", err)
@@ -58,14 +58,30 @@ func prometheusStatusApplyConfigurationFromPrometheusStatus(status *monitoringv1
 	}
 
 	for _, shardStatus := range status.ShardStatuses {
-		psac.WithShardStatuses(
-			monitoringv1ac.ShardStatus().
-				WithShardID(shardStatus.ShardID).
-				WithReplicas(shardStatus.Replicas).
-				WithUpdatedReplicas(shardStatus.UpdatedReplicas).
-				WithAvailableReplicas(shardStatus.AvailableReplicas).
-				WithUnavailableReplicas(shardStatus.UnavailableReplicas),
-		)
+		ssac := monitoringv1ac.ShardStatus().
+			WithShardID(shardStatus.ShardID).
+			WithReplicas(shardStatus.Replicas).
+			WithUpdatedReplicas(shardStatus.UpdatedReplicas).
+			WithAvailableReplicas(shardStatus.AvailableReplicas).
+			WithUnavailableReplicas(shardStatus.UnavailableReplicas)
+
+		if shardStatus.HeadSeries != nil {
+			ssac.WithHeadSeries(*shardStatus.HeadSeries)
+		}
+
+		if shardStatus.MemoryUsage != nil {
+			ssac.WithMemoryUsage(*shardStatus.MemoryUsage)
+		}
+
+		if d := shardStatus.LastScalingDecision; d != nil {
+			ssac.WithLastScalingDecision(monitoringv1ac.ShardScalingDecision().
+				WithPreviousShards(d.PreviousShards).
+				WithShards(d.Shards).
+				WithTime(d.Time),
+			)
+		}
+
+		psac.WithShardStatuses(ssac)
 	}
 
 	return psac
@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"path"
 	"path/filepath"
+	"strconv"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,6 +70,9 @@ var (
 	// PrometheusModeLabelName is the statefulset's label identifying whether the owning resource is a Prometheus or PrometheusAgent.
 	PrometheusModeLabelName = "operator.prometheus.io/mode"
 
+	// AutoscaledShardsAnnotationName is the Prometheus/PrometheusAgent resource's annotation storing the number of shards decided by the shard autoscaler.
+	AutoscaledShardsAnnotationName = "operator.prometheus.io/autoscaled-shards"
+
 	ProbeTimeoutSeconds int32 = 3
 	LabelPrometheusName       = "prometheus-name"
 )
@@ -88,19 +92,26 @@ func ExpectedStatefulSetShardNames(
 	p monitoringv1.PrometheusInterface,
 ) []string {
 	res := []string{}
-	for i := int32(0); i < shardsNumber(p); i++ {
+	for i := int32(0); i < ShardsNumber(p); i++ {
 		res = append(res, prometheusNameByShard(p, i))
 	}
 
 	return res
 }
 
-// shardsNumber returns the normalized number of shards.
-func shardsNumber(
+// ShardsNumber returns the normalized number of shards.
+//
+// When shard autoscaling is enabled, it returns the number of shards decided
+// by the autoscaler (bounded by the min and max values).
+func ShardsNumber(
 	p monitoringv1.PrometheusInterface,
 ) int32 {
 	cpf := p.GetCommonPrometheusFields()
 
+	if cpf.ShardAutoscaling != nil {
+		return autoscaledShardsNumber(p)
+	}
+
 	if ptr.Deref(cpf.Shards, 1) <= 1 {
 		return 1
 	}
@@ -108,6 +119,19 @@ func shardsNumber(
 	return *cpf.Shards
 }
 
+func autoscaledShardsNumber(p monitoringv1.PrometheusInterface) int32 {
+	cpf := p.GetCommonPrometheusFields()
+
+	shards := ptr.Deref(cpf.Shards, 1)
+	if v, found := p.GetObjectMeta().GetAnnotations()[AutoscaledShardsAnnotationName]; found {
+		if i, err := strconv.ParseInt(v, 10, 32); err == nil {
+			shards = int32(i)
+		}
+	}
+
+	return min(max(shards, cpf.ShardAutoscaling.MinShards, 1), max(cpf.ShardAutoscaling.MaxShards, 1))
+}
+
 // ReplicasNumberPtr returns a ptr to the normalized number of replicas.
 func ReplicasNumberPtr(
 	p monitoringv1.PrometheusInterface,
@@ -139,7 +163,11 @@ func compress(data []byte) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func MakeConfigurationSecret(p monitoringv1.PrometheusInterface, config Config, data []byte) (*v1.Secret, error) {
+// MakeConfigurationSecret returns the Secret holding the compressed
+// Prometheus configuration. When provenance isn't nil, the Secret also
+// contains the compressed provenance document under the ProvenanceFilename
+// key.
+func MakeConfigurationSecret(p monitoringv1.PrometheusInterface, config Config, data []byte, provenance *ConfigProvenance) (*v1.Secret, error) {
 	promConfig, err := compress(data)
 	if err != nil {
 		return nil, err
@@ -151,6 +179,14 @@ func MakeConfigurationSecret(p monitoringv1.PrometheusInterface, config Config,
 		},
 	}
 
+	if provenance != nil {
+		b, err := marshalProvenance(provenance)
+		if err != nil {
+			return nil, err
+		}
+		s.Data[ProvenanceFilename] = b
+	}
+
 	operator.UpdateObject(
 		s,
 		operator.WithLabels(config.Labels),
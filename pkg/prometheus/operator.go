@@ -26,8 +26,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
@@ -53,6 +55,9 @@ type StatusReporter struct {
 	Reconciliations *operator.ReconciliationTracker
 	SsetInfs        *informers.ForResource
 	Rr              *operator.ResourceReconciler
+	// ShardAutoscaler is optional. When defined, the shard statuses report
+	// the load observed by the autoscaler.
+	ShardAutoscaler *ShardAutoscaler
 }
 
 func KeyToStatefulSetKey(p monitoringv1.PrometheusInterface, key string, shard int) string {
@@ -163,6 +168,24 @@ func (sr *StatusReporter) Process(ctx context.Context, p monitoringv1.Prometheus
 		replicas = int(*commonFields.Replicas)
 	}
 
+	var (
+		shardLoads   map[int]ShardLoad
+		lastDecision *monitoringv1.ShardScalingDecision
+	)
+	if sr.ShardAutoscaler != nil && commonFields.ShardAutoscaling != nil {
+		shardLoads = sr.ShardAutoscaler.ShardLoads(p)
+		lastDecision = sr.ShardAutoscaler.LastScalingDecision(p)
+		if lastDecision == nil {
+			// Preserve the decision reported before the operator restarted.
+			for _, ss := range p.GetStatus().ShardStatuses {
+				if ss.LastScalingDecision != nil {
+					lastDecision = ss.LastScalingDecision
+					break
+				}
+			}
+		}
+	}
+
 	for shard := range ExpectedStatefulSetShardNames(p) {
 		ssetName := KeyToStatefulSetKey(p, key, shard)
 
@@ -176,7 +199,8 @@ func (sr *StatusReporter) Process(ctx context.Context, p monitoringv1.Prometheus
 				pStatus.ShardStatuses = append(
 					pStatus.ShardStatuses,
 					monitoringv1.ShardStatus{
-						ShardID: strconv.Itoa(shard),
+						ShardID:             strconv.Itoa(shard),
+						LastScalingDecision: lastDecision,
 					})
 
 				continue
@@ -200,16 +224,19 @@ func (sr *StatusReporter) Process(ctx context.Context, p monitoringv1.Prometheus
 		pStatus.AvailableReplicas += int32(len(stsReporter.ReadyPods()))
 		pStatus.UnavailableReplicas += int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods()))
 
-		pStatus.ShardStatuses = append(
-			pStatus.ShardStatuses,
-			monitoringv1.ShardStatus{
-				ShardID:             strconv.Itoa(shard),
-				Replicas:            int32(len(stsReporter.Pods)),
-				UpdatedReplicas:     int32(len(stsReporter.UpdatedPods())),
-				AvailableReplicas:   int32(len(stsReporter.ReadyPods())),
-				UnavailableReplicas: int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods())),
-			},
-		)
+		shardStatus := monitoringv1.ShardStatus{
+			ShardID:             strconv.Itoa(shard),
+			Replicas:            int32(len(stsReporter.Pods)),
+			UpdatedReplicas:     int32(len(stsReporter.UpdatedPods())),
+			AvailableReplicas:   int32(len(stsReporter.ReadyPods())),
+			UnavailableReplicas: int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods())),
+			LastScalingDecision: lastDecision,
+		}
+		if load, found := shardLoads[shard]; found {
+			shardStatus.HeadSeries = ptr.To(load.HeadSeries)
+			shardStatus.MemoryUsage = resource.NewQuantity(load.MemoryBytes, resource.BinarySI)
+		}
+		pStatus.ShardStatuses = append(pStatus.ShardStatuses, shardStatus)
 
 		if len(stsReporter.ReadyPods()) >= replicas {
 			// All pods are ready (or the desired number of replicas is zero).
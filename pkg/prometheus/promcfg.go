@@ -25,6 +25,7 @@ import (
 	"reflect"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/alecthomas/units"
@@ -55,6 +56,7 @@ const (
 
 	hashLabelNameForSharding          = "__tmp_hash"
 	hashLabelNameForDisablingSharding = "__tmp_disable_sharding"
+	shardLabelNameForSharding         = "__tmp_shard"
 )
 
 var invalidLabelCharRE = regexp.MustCompile(`[^a-zA-Z0-9_]`)
@@ -76,6 +78,9 @@ type ConfigGenerator struct {
 	daemonSet                  bool
 	prometheusTopologySharding bool
 	inlineTLSConfig            bool
+	// rendezvousShardSlots contains the regular expressions matching the
+	// slots of each shard when the rendezvous sharding strategy is used.
+	rendezvousShardSlots []string
 
 	bypassVersionCheck bool
 }
@@ -158,6 +163,15 @@ func NewConfigGenerator(
 	cg.scrapeClasses = scrapeClasses
 	cg.defaultScrapeClassName = defaultScrapeClassName
 
+	// With a single shard, both strategies keep all the targets and the
+	// simpler hashmod relabeling is used.
+	if shards := ShardsNumber(p); shards > 1 && ptr.Deref(cpf.ShardingStrategy, monitoringv1.HashModShardingStrategy) == monitoringv1.RendezvousShardingStrategy {
+		if shards > rendezvousSlots {
+			return nil, fmt.Errorf("the %s sharding strategy supports at most %d shards, got %d", monitoringv1.RendezvousShardingStrategy, rendezvousSlots, shards)
+		}
+		cg.rendezvousShardSlots = rendezvousShardSlots(shards)
+	}
+
 	for _, opt := range opts {
 		opt(cg)
 	}
@@ -237,6 +251,7 @@ func (cg *ConfigGenerator) WithKeyVals(keyvals ...any) *ConfigGenerator {
 		daemonSet:                  cg.daemonSet,
 		prometheusTopologySharding: cg.prometheusTopologySharding,
 		inlineTLSConfig:            cg.inlineTLSConfig,
+		rendezvousShardSlots:       cg.rendezvousShardSlots,
 		bypassVersionCheck:         cg.bypassVersionCheck,
 	}
 }
@@ -262,6 +277,7 @@ func (cg *ConfigGenerator) WithMinimumVersion(version string) *ConfigGenerator {
 			daemonSet:                  cg.daemonSet,
 			prometheusTopologySharding: cg.prometheusTopologySharding,
 			inlineTLSConfig:            cg.inlineTLSConfig,
+			rendezvousShardSlots:       cg.rendezvousShardSlots,
 			bypassVersionCheck:         cg.bypassVersionCheck,
 		}
 	}
@@ -290,6 +306,7 @@ func (cg *ConfigGenerator) WithMaximumVersion(version string) *ConfigGenerator {
 			daemonSet:                  cg.daemonSet,
 			prometheusTopologySharding: cg.prometheusTopologySharding,
 			inlineTLSConfig:            cg.inlineTLSConfig,
+			rendezvousShardSlots:       cg.rendezvousShardSlots,
 			bypassVersionCheck:         cg.bypassVersionCheck,
 		}
 	}
@@ -932,7 +949,7 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 	var (
 		scrapeConfigs   []yaml.MapSlice
 		apiserverConfig = cpf.APIServerConfig
-		shards          = shardsNumber(cg.prom)
+		shards          = ShardsNumber(cg.prom)
 	)
 
 	scrapeConfigs = cg.appendServiceMonitorConfigs(scrapeConfigs, sMons, apiserverConfig, store, shards)
@@ -1286,6 +1303,29 @@ func getStatupProbePeriodSecondsAndFailureThreshold(maxStartupDurationSeconds *i
 	return int32(startupPeriodSeconds), int32(startupFailureThreshold)
 }
 
+// podMonitorJobName returns the name of the scrape job generated for the
+// i-th endpoint of the PodMonitor.
+func podMonitorJobName(m *monitoringv1.PodMonitor, i int) string {
+	return fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i)
+}
+
+// probeJobName returns the name of the scrape job generated for the Probe.
+func probeJobName(m *monitoringv1.Probe) string {
+	return fmt.Sprintf("probe/%s/%s", m.Namespace, m.Name)
+}
+
+// serviceMonitorJobName returns the name of the scrape job generated for the
+// i-th endpoint of the ServiceMonitor.
+func serviceMonitorJobName(m *monitoringv1.ServiceMonitor, i int) string {
+	return fmt.Sprintf("serviceMonitor/%s/%s/%d", m.Namespace, m.Name, i)
+}
+
+// scrapeConfigJobName returns the name of the scrape job generated for the
+// ScrapeConfig.
+func scrapeConfigJobName(sc *monitoringv1alpha1.ScrapeConfig) string {
+	return fmt.Sprintf("scrapeConfig/%s/%s", sc.Namespace, sc.Name)
+}
+
 func (cg *ConfigGenerator) generatePodMonitorConfig(
 	m *monitoringv1.PodMonitor,
 	ep monitoringv1.PodMetricsEndpoint,
@@ -1298,7 +1338,7 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 	cfg := yaml.MapSlice{
 		{
 			Key:   "job_name",
-			Value: fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i),
+			Value: podMonitorJobName(m, i),
 		},
 	}
 	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels)
@@ -1510,7 +1550,7 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 
 	// DaemonSet mode doesn't support sharding.
 	if !cg.daemonSet {
-		relabelings = appendShardingRelabelingWithAddress(relabelings, shards)
+		relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
 	}
 
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
@@ -1551,7 +1591,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 ) yaml.MapSlice {
 	scrapeClass := cg.getScrapeClassOrDefault(m.Spec.ScrapeClassName)
 
-	jobName := fmt.Sprintf("probe/%s/%s", m.Namespace, m.Name)
+	jobName := probeJobName(m)
 	cfg := yaml.MapSlice{
 		{
 			Key:   "job_name",
@@ -1764,7 +1804,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.Ingress.RelabelConfigs))...)
 	}
 
-	relabelings = appendShardingRelabelingForProbes(relabelings, shards)
+	relabelings = cg.appendShardingRelabelingForProbes(relabelings, shards)
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
 	cfg = cg.addTLStoYaml(cfg, s, mergeSafeTLSConfigWithScrapeClass(m.Spec.TLSConfig, scrapeClass))
@@ -1807,7 +1847,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	cfg := yaml.MapSlice{
 		{
 			Key:   "job_name",
-			Value: fmt.Sprintf("serviceMonitor/%s/%s/%d", m.Namespace, m.Name, i),
+			Value: serviceMonitorJobName(m, i),
 		},
 	}
 	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels)
@@ -2053,7 +2093,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	labeler := namespacelabeler.New(cpf.EnforcedNamespaceLabel, cpf.ExcludedFromEnforcement, false)
 	relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, ep.RelabelConfigs))...)
 
-	relabelings = appendShardingRelabelingWithAddress(relabelings, shards)
+	relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
 	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
@@ -2109,12 +2149,12 @@ func (cg *ConfigGenerator) getLimit(user *uint64, enforced *uint64) *uint64 {
 	return enforced
 }
 
-func appendShardingRelabelingWithAddress(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
-	return appendShardingRelabelingWithLabel(relabelings, shards, "__address__")
+func (cg *ConfigGenerator) appendShardingRelabelingWithAddress(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
+	return cg.appendShardingRelabelingWithLabel(relabelings, shards, "__address__")
 }
 
-func appendShardingRelabelingForProbes(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
-	return appendShardingRelabelingWithLabel(relabelings, shards, "__param_target")
+func (cg *ConfigGenerator) appendShardingRelabelingForProbes(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
+	return cg.appendShardingRelabelingWithLabel(relabelings, shards, "__param_target")
 }
 
 func (cg *ConfigGenerator) appendShardingRelabelingWithAddressIfMissing(relabelings []yaml.MapSlice, shards int32) []yaml.MapSlice {
@@ -2126,29 +2166,63 @@ func (cg *ConfigGenerator) appendShardingRelabelingWithAddressIfMissing(relabeli
 			}
 		}
 	}
-	return appendShardingRelabelingWithAddress(relabelings, shards)
+	return cg.appendShardingRelabelingWithAddress(relabelings, shards)
 }
 
-func appendShardingRelabelingWithLabel(relabelings []yaml.MapSlice, shards int32, shardLabel string) []yaml.MapSlice {
-	return append(relabelings,
-		// Store the "shardLabel" value into the __tmp_hash label unless the
-		// latter is already set.
-		yaml.MapSlice{
-			{Key: "source_labels", Value: []string{shardLabel, hashLabelNameForSharding}},
-			{Key: "target_label", Value: hashLabelNameForSharding},
-			{Key: "regex", Value: "(.+);"},
-			{Key: "replacement", Value: "$1"},
-			{Key: "action", Value: "replace"},
-		}, yaml.MapSlice{
+func (cg *ConfigGenerator) appendShardingRelabelingWithLabel(relabelings []yaml.MapSlice, shards int32, shardLabel string) []yaml.MapSlice {
+	// Store the "shardLabel" value into the __tmp_hash label unless the
+	// latter is already set.
+	relabelings = append(relabelings, yaml.MapSlice{
+		{Key: "source_labels", Value: []string{shardLabel, hashLabelNameForSharding}},
+		{Key: "target_label", Value: hashLabelNameForSharding},
+		{Key: "regex", Value: "(.+);"},
+		{Key: "replacement", Value: "$1"},
+		{Key: "action", Value: "replace"},
+	})
+
+	if cg.rendezvousShardSlots == nil {
+		return append(relabelings,
+			yaml.MapSlice{
+				{Key: "source_labels", Value: []string{hashLabelNameForSharding}},
+				{Key: "target_label", Value: hashLabelNameForSharding},
+				{Key: "modulus", Value: shards},
+				{Key: "action", Value: "hashmod"},
+			}, yaml.MapSlice{
+				{Key: "source_labels", Value: []string{hashLabelNameForSharding, hashLabelNameForDisablingSharding}},
+				{Key: "regex", Value: fmt.Sprintf("$(%s);|.+;.+", operator.ShardEnvVar)},
+				{Key: "action", Value: "keep"},
+			})
+	}
+
+	// With the rendezvous strategy, the hash is mapped to a fixed number of
+	// slots and the __tmp_shard label is set to the index of the shard owning
+	// the slot.
+	relabelings = append(relabelings, yaml.MapSlice{
+		{Key: "source_labels", Value: []string{hashLabelNameForSharding}},
+		{Key: "target_label", Value: hashLabelNameForSharding},
+		{Key: "modulus", Value: rendezvousSlots},
+		{Key: "action", Value: "hashmod"},
+	})
+
+	for shard, slots := range cg.rendezvousShardSlots {
+		if slots == "" {
+			continue
+		}
+
+		relabelings = append(relabelings, yaml.MapSlice{
 			{Key: "source_labels", Value: []string{hashLabelNameForSharding}},
-			{Key: "target_label", Value: hashLabelNameForSharding},
-			{Key: "modulus", Value: shards},
-			{Key: "action", Value: "hashmod"},
-		}, yaml.MapSlice{
-			{Key: "source_labels", Value: []string{hashLabelNameForSharding, hashLabelNameForDisablingSharding}},
-			{Key: "regex", Value: fmt.Sprintf("$(%s);|.+;.+", operator.ShardEnvVar)},
-			{Key: "action", Value: "keep"},
+			{Key: "target_label", Value: shardLabelNameForSharding},
+			{Key: "regex", Value: slots},
+			{Key: "replacement", Value: strconv.Itoa(shard)},
+			{Key: "action", Value: "replace"},
 		})
+	}
+
+	return append(relabelings, yaml.MapSlice{
+		{Key: "source_labels", Value: []string{shardLabelNameForSharding, hashLabelNameForDisablingSharding}},
+		{Key: "regex", Value: fmt.Sprintf("$(%s);|.+;.+", operator.ShardEnvVar)},
+		{Key: "action", Value: "keep"},
+	})
 }
 
 func generateRelabelConfig(rc []monitoringv1.RelabelConfig) []yaml.MapSlice {
@@ -3057,7 +3131,7 @@ func (cg *ConfigGenerator) GenerateAgentConfiguration(
 	var (
 		scrapeConfigs   []yaml.MapSlice
 		apiserverConfig = cpf.APIServerConfig
-		shards          = shardsNumber(cg.prom)
+		shards          = ShardsNumber(cg.prom)
 	)
 
 	scrapeConfigs = cg.appendPodMonitorConfigs(scrapeConfigs, pMons, apiserverConfig, store, shards)
@@ -3141,7 +3215,7 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 ) (yaml.MapSlice, error) {
 	scrapeClass := cg.getScrapeClassOrDefault(sc.Spec.ScrapeClassName)
 
-	jobName := fmt.Sprintf("scrapeConfig/%s/%s", sc.Namespace, sc.Name)
+	jobName := scrapeConfigJobName(sc)
 
 	cfg := yaml.MapSlice{
 		{
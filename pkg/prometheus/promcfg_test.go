@@ -1550,6 +1550,94 @@ func TestAdditionalScrapeConfigs(t *testing.T) {
 	}
 }
 
+func TestShardingStrategy(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		shards   *int32
+		strategy *monitoringv1.ShardingStrategy
+		golden   string
+	}{
+		{
+			name:   "default strategy",
+			shards: ptr.To(int32(3)),
+			golden: "ShardingStrategy_default.golden",
+		},
+		{
+			name:     "hashmod strategy",
+			shards:   ptr.To(int32(3)),
+			strategy: ptr.To(monitoringv1.HashModShardingStrategy),
+			golden:   "ShardingStrategy_default.golden",
+		},
+		{
+			name:     "rendezvous strategy",
+			shards:   ptr.To(int32(3)),
+			strategy: ptr.To(monitoringv1.RendezvousShardingStrategy),
+			golden:   "ShardingStrategy_rendezvous.golden",
+		},
+		{
+			name:     "rendezvous strategy with 4 shards",
+			shards:   ptr.To(int32(4)),
+			strategy: ptr.To(monitoringv1.RendezvousShardingStrategy),
+			golden:   "ShardingStrategy_rendezvous_4_shards.golden",
+		},
+		{
+			name:     "rendezvous strategy without shards",
+			strategy: ptr.To(monitoringv1.RendezvousShardingStrategy),
+			golden:   "ShardingStrategy_rendezvous_unsharded.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.Shards = tc.shards
+			p.Spec.ShardingStrategy = tc.strategy
+
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{
+					"servicemonitor1": makeServiceMonitors()["servicemonitor1"],
+				},
+				nil,
+				map[string]*monitoringv1.Probe{
+					"probe1": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "testprobe1",
+							Namespace: "default",
+						},
+						Spec: monitoringv1.ProbeSpec{
+							ProberSpec: monitoringv1.ProberSpec{
+								URL: "blackbox.exporter.io",
+							},
+							Targets: monitoringv1.ProbeTargets{
+								StaticConfig: &monitoringv1.ProbeTargetStaticConfig{
+									Targets: []string{"prometheus.io"},
+								},
+							},
+						},
+					},
+				},
+				nil,
+				&assets.StoreBuilder{},
+				golden.Get(t, "TestAdditionalScrapeConfigsAdditionalScrapeConfig.golden"),
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestRendezvousShardingStrategyTooManyShards(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.Shards = ptr.To(int32(rendezvousSlots + 1))
+	p.Spec.ShardingStrategy = ptr.To(monitoringv1.RendezvousShardingStrategy)
+
+	_, err := NewConfigGenerator(nil, p)
+	require.Error(t, err)
+}
+
 func TestAdditionalAlertRelabelConfigs(t *testing.T) {
 	p := defaultPrometheus()
 	p.Spec.Alerting = &monitoringv1.AlertingSpec{
@@ -0,0 +1,206 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// ProvenanceFilename is the key of the configuration Secret holding the
+// provenance document.
+const ProvenanceFilename = "provenance.json.gz"
+
+// ConfigProvenance maps the items of the generated configuration to the
+// objects from which they have been generated.
+type ConfigProvenance struct {
+	ScrapeJobs   []ProvenanceEntry `json:"scrapeJobs"`
+	RuleFiles    []ProvenanceEntry `json:"ruleFiles,omitempty"`
+	RemoteWrites []ProvenanceEntry `json:"remoteWrites"`
+}
+
+// ProvenanceEntry identifies the object from which a configuration item
+// (scrape job, rule file or remote-write endpoint) has been generated.
+type ProvenanceEntry struct {
+	// Name is the scrape job name, the rule file name or the remote-write
+	// name (or URL when the name isn't defined).
+	Name string `json:"name"`
+
+	Kind            string    `json:"kind"`
+	Namespace       string    `json:"namespace"`
+	ObjectName      string    `json:"objectName"`
+	UID             types.UID `json:"uid"`
+	ResourceVersion string    `json:"resourceVersion"`
+	Generation      int64     `json:"generation"`
+}
+
+func newProvenanceEntry(name, kind string, o metav1.Object) ProvenanceEntry {
+	return ProvenanceEntry{
+		Name:            name,
+		Kind:            kind,
+		Namespace:       o.GetNamespace(),
+		ObjectName:      o.GetName(),
+		UID:             o.GetUID(),
+		ResourceVersion: o.GetResourceVersion(),
+		Generation:      o.GetGeneration(),
+	}
+}
+
+// NewConfigProvenance returns the provenance document of the configuration
+// generated from the given objects.
+// The rule files are keyed by file name.
+func NewConfigProvenance(
+	p monitoringv1.PrometheusInterface,
+	sMons map[string]*monitoringv1.ServiceMonitor,
+	pMons map[string]*monitoringv1.PodMonitor,
+	probes map[string]*monitoringv1.Probe,
+	sCons map[string]*monitoringv1alpha1.ScrapeConfig,
+	rules map[string]*monitoringv1.PrometheusRule,
+) *ConfigProvenance {
+	cp := &ConfigProvenance{
+		ScrapeJobs:   []ProvenanceEntry{},
+		RemoteWrites: []ProvenanceEntry{},
+	}
+
+	for _, k := range sortutil.SortedKeys(pMons) {
+		m := pMons[k]
+		for i := range m.Spec.PodMetricsEndpoints {
+			cp.ScrapeJobs = append(cp.ScrapeJobs, newProvenanceEntry(podMonitorJobName(m, i), monitoringv1.PodMonitorsKind, m))
+		}
+	}
+
+	for _, k := range sortutil.SortedKeys(probes) {
+		m := probes[k]
+		cp.ScrapeJobs = append(cp.ScrapeJobs, newProvenanceEntry(probeJobName(m), monitoringv1.ProbesKind, m))
+	}
+
+	for _, k := range sortutil.SortedKeys(sMons) {
+		m := sMons[k]
+		for i := range m.Spec.Endpoints {
+			cp.ScrapeJobs = append(cp.ScrapeJobs, newProvenanceEntry(serviceMonitorJobName(m, i), monitoringv1.ServiceMonitorsKind, m))
+		}
+	}
+
+	for _, k := range sortutil.SortedKeys(sCons) {
+		sc := sCons[k]
+		cp.ScrapeJobs = append(cp.ScrapeJobs, newProvenanceEntry(scrapeConfigJobName(sc), monitoringv1alpha1.ScrapeConfigsKind, sc))
+	}
+
+	for _, k := range sortutil.SortedKeys(rules) {
+		cp.RuleFiles = append(cp.RuleFiles, newProvenanceEntry(k, monitoringv1.PrometheusRuleKind, rules[k]))
+	}
+
+	kind := monitoringv1.PrometheusesKind
+	if _, ok := p.(*monitoringv1alpha1.PrometheusAgent); ok {
+		kind = monitoringv1alpha1.PrometheusAgentsKind
+	}
+
+	for _, rw := range p.GetCommonPrometheusFields().RemoteWrite {
+		name := ptr.Deref(rw.Name, "")
+		if name == "" {
+			name = rw.URL
+		}
+
+		cp.RemoteWrites = append(cp.RemoteWrites, newProvenanceEntry(name, kind, p.GetObjectMeta()))
+	}
+
+	return cp
+}
+
+// ProvenanceHandler serves the provenance document stored in the
+// configuration Secret of Prometheus and PrometheusAgent resources.
+type ProvenanceHandler struct {
+	logger  *slog.Logger
+	kclient kubernetes.Interface
+	get     func(namespace, name string) (monitoringv1.PrometheusInterface, error)
+}
+
+// NewProvenanceHandler returns a ProvenanceHandler. The get function returns
+// the resource identified by the namespace and name path values (nil if it
+// doesn't exist).
+func NewProvenanceHandler(logger *slog.Logger, kclient kubernetes.Interface, get func(namespace, name string) (monitoringv1.PrometheusInterface, error)) *ProvenanceHandler {
+	return &ProvenanceHandler{
+		logger:  logger,
+		kclient: kclient,
+		get:     get,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *ProvenanceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ns, name := r.PathValue("namespace"), r.PathValue("name")
+
+	p, err := h.get(ns, name)
+	if err != nil {
+		h.logger.Error("failed to get resource", "err", err, "namespace", ns, "name", name)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if p == nil {
+		http.Error(w, fmt.Sprintf("%s/%s not found", ns, name), http.StatusNotFound)
+		return
+	}
+
+	s, err := h.kclient.CoreV1().Secrets(ns).Get(r.Context(), ConfigSecretName(p), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, "configuration secret not found", http.StatusNotFound)
+			return
+		}
+
+		h.logger.Error("failed to get configuration secret", "err", err, "namespace", ns, "name", name)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	b, found := s.Data[ProvenanceFilename]
+	if !found {
+		http.Error(w, "provenance document not found", http.StatusNotFound)
+		return
+	}
+
+	doc, err := operator.GunzipConfig(b)
+	if err != nil {
+		h.logger.Error("failed to decompress provenance document", "err", err, "namespace", ns, "name", name)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(doc))
+}
+
+func marshalProvenance(cp *ConfigProvenance) ([]byte, error) {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal provenance document: %w", err)
+	}
+
+	return compress(b)
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func newTestObjectMeta(name, uid string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       "default",
+		UID:             types.UID("uid-" + uid),
+		ResourceVersion: "10",
+		Generation:      2,
+	}
+}
+
+func TestConfigProvenance(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: newTestObjectMeta("test", "prometheus"),
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				RemoteWrite: []monitoringv1.RemoteWriteSpec{
+					{URL: "http://example.com/api/v1/write"},
+					{URL: "http://example.com/api/v1/push", Name: ptr.To("push")},
+				},
+			},
+		},
+	}
+
+	cp := NewConfigProvenance(
+		p,
+		map[string]*monitoringv1.ServiceMonitor{
+			"default/smon": {
+				ObjectMeta: newTestObjectMeta("smon", "smon"),
+				Spec:       monitoringv1.ServiceMonitorSpec{Endpoints: []monitoringv1.Endpoint{{}, {}}},
+			},
+		},
+		map[string]*monitoringv1.PodMonitor{
+			"default/pmon": {
+				ObjectMeta: newTestObjectMeta("pmon", "pmon"),
+				Spec:       monitoringv1.PodMonitorSpec{PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{}}},
+			},
+		},
+		map[string]*monitoringv1.Probe{
+			"default/probe": {ObjectMeta: newTestObjectMeta("probe", "probe")},
+		},
+		map[string]*monitoringv1alpha1.ScrapeConfig{
+			"default/scon": {ObjectMeta: newTestObjectMeta("scon", "scon")},
+		},
+		map[string]*monitoringv1.PrometheusRule{
+			"default-rule-uid-rule.yaml": {ObjectMeta: newTestObjectMeta("rule", "rule")},
+		},
+	)
+
+	names := func(entries []ProvenanceEntry) []string {
+		var res []string
+		for _, e := range entries {
+			res = append(res, e.Name)
+		}
+		return res
+	}
+
+	require.Equal(t,
+		[]string{
+			"podMonitor/default/pmon/0",
+			"probe/default/probe",
+			"serviceMonitor/default/smon/0",
+			"serviceMonitor/default/smon/1",
+			"scrapeConfig/default/scon",
+		},
+		names(cp.ScrapeJobs),
+	)
+	require.Equal(t, []string{"default-rule-uid-rule.yaml"}, names(cp.RuleFiles))
+	require.Equal(t, []string{"http://example.com/api/v1/write", "push"}, names(cp.RemoteWrites))
+
+	require.Equal(t,
+		ProvenanceEntry{
+			Name:            "serviceMonitor/default/smon/1",
+			Kind:            monitoringv1.ServiceMonitorsKind,
+			Namespace:       "default",
+			ObjectName:      "smon",
+			UID:             "uid-smon",
+			ResourceVersion: "10",
+			Generation:      2,
+		},
+		cp.ScrapeJobs[3],
+	)
+	require.Equal(t, monitoringv1.PrometheusesKind, cp.RemoteWrites[0].Kind)
+	require.Equal(t, "test", cp.RemoteWrites[0].ObjectName)
+}
+
+func TestProvenanceHandler(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: newTestObjectMeta("test", "prometheus"),
+	}
+
+	cp := NewConfigProvenance(p, nil, nil, nil, nil, nil)
+	s, err := MakeConfigurationSecret(p, Config{}, []byte("global: {}"), cp)
+	require.NoError(t, err)
+	s.Namespace = "default"
+
+	h := NewProvenanceHandler(
+		promslog.NewNopLogger(),
+		fake.NewClientset(s),
+		func(namespace, name string) (monitoringv1.PrometheusInterface, error) {
+			if namespace != p.Namespace || name != p.Name {
+				return nil, nil
+			}
+			return p, nil
+		},
+	)
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v1/prometheuses/{namespace}/{name}/provenance", h)
+
+	for _, tc := range []struct {
+		path   string
+		status int
+	}{
+		{path: "/api/v1/prometheuses/default/test/provenance", status: http.StatusOK},
+		{path: "/api/v1/prometheuses/default/other/provenance", status: http.StatusNotFound},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			require.Equal(t, tc.status, w.Code)
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			var got ConfigProvenance
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+			require.Equal(t, *cp, got)
+		})
+	}
+}
@@ -54,9 +54,9 @@ const (
 var validationScheme model.ValidationScheme = model.LegacyValidation
 
 // ConfigurationResource is a type constraint that permits only the specific pointer types for configuration resources
-// selectable by Prometheus or PrometheusAgent.
+// selectable by Prometheus, PrometheusAgent or Alertmanager.
 type ConfigurationResource interface {
-	*monitoringv1.ServiceMonitor | *monitoringv1.PodMonitor | *monitoringv1.Probe | *monitoringv1alpha1.ScrapeConfig
+	*monitoringv1.ServiceMonitor | *monitoringv1.PodMonitor | *monitoringv1.Probe | *monitoringv1alpha1.ScrapeConfig | *monitoringv1alpha1.AlertmanagerConfig | *monitoringv1alpha1.AlertmanagerTemplate
 }
 
 // ResourceSelector knows how to select and verify scrape configuration
@@ -81,6 +81,22 @@ type TypedConfigurationResource[T ConfigurationResource] struct {
 	generation int64  // Generation of the desired state (spec).
 }
 
+// NewTypedConfigurationResource returns a configuration resource with its
+// validation status. The resource is accepted if err is nil.
+func NewTypedConfigurationResource[T ConfigurationResource](resource T, err error) TypedConfigurationResource[T] {
+	r := TypedConfigurationResource[T]{
+		resource:   resource,
+		err:        err,
+		generation: any(resource).(metav1.Object).GetGeneration(),
+	}
+
+	if err != nil {
+		r.reason = invalidConfiguration
+	}
+
+	return r
+}
+
 func (r *TypedConfigurationResource[T]) Resource() T {
 	return r.resource
 }
@@ -211,24 +227,17 @@ func selectObjects[T ConfigurationResource](
 	)
 
 	for namespaceAndName, obj := range objects {
-		var reason string
 		o := obj.(T)
 		err := checkFn(ctx, o)
 		if err != nil {
 			rejected++
-			reason = invalidConfiguration
 			logger.Warn("skipping object", "error", err.Error(), "object", namespaceAndName)
 			rs.eventRecorder.Eventf(obj, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingConfigurationResourcesAction, "%q was rejected due to invalid configuration: %v", namespaceAndName, err)
 		} else {
 			valid = append(valid, namespaceAndName)
 		}
 
-		res[namespaceAndName] = TypedConfigurationResource[T]{
-			resource:   o,
-			err:        err,
-			reason:     reason,
-			generation: obj.(metav1.Object).GetGeneration(),
-		}
+		res[namespaceAndName] = NewTypedConfigurationResource(o, err)
 	}
 
 	logger.Debug("valid objects selected", "objects", strings.Join(valid, ","))
@@ -261,7 +270,10 @@ func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn Li
 
 // checkServiceMonitor verifies that the ServiceMonitor object is valid.
 func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitoringv1.ServiceMonitor) error {
-	cpf := rs.p.GetCommonPrometheusFields()
+	var denyFSAccess bool
+	if rs.p != nil {
+		denyFSAccess = rs.p.GetCommonPrometheusFields().ArbitraryFSAccessThroughSMs.Deny
+	}
 
 	if _, err := metav1.LabelSelectorAsSelector(&sm.Spec.Selector); err != nil {
 		return err
@@ -276,7 +288,7 @@ func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitor
 
 		// If denied by the Prometheus spec, filter out all service monitors
 		// that access the file system.
-		if cpf.ArbitraryFSAccessThroughSMs.Deny {
+		if denyFSAccess {
 			if err := testForArbitraryFSAccess(endpoint); err != nil {
 				return fmt.Errorf("%w: %w", epErr, err)
 			}
@@ -357,6 +369,10 @@ func validateScrapeIntervalAndTimeout(p monitoringv1.PrometheusInterface, scrape
 		return nil
 	}
 	if scrapeInterval == "" {
+		if p == nil {
+			// The global scrape interval isn't known.
+			return nil
+		}
 		scrapeInterval = p.GetCommonPrometheusFields().ScrapeInterval
 	}
 	return CompareScrapeTimeoutToScrapeInterval(scrapeTimeout, scrapeInterval)
@@ -464,7 +480,8 @@ func (lcv *LabelConfigValidator) validate(rc monitoringv1.RelabelConfig) error {
 }
 
 func validateScrapeClass(p monitoringv1.PrometheusInterface, sc *string) error {
-	if ptr.Deref(sc, "") == "" {
+	// Without Prometheus object, the scrape classes aren't known.
+	if p == nil || ptr.Deref(sc, "") == "" {
 		return nil
 	}
 
@@ -0,0 +1,78 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/blang/semver/v4"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// ResourceValidator validates ServiceMonitor, PodMonitor, Probe and
+// ScrapeConfig objects independently of the Prometheus objects selecting
+// them (e.g. from the admission webhook).
+//
+// It runs the same checks as ResourceSelector except the ones which depend
+// on the Prometheus configuration: scrape classes, file system access
+// restrictions and comparison with the global scrape interval.
+type ResourceValidator struct {
+	rs *ResourceSelector
+}
+
+// NewResourceValidator returns a validator assuming the default Prometheus
+// version. The store is used to resolve the Secret and ConfigMap
+// references.
+func NewResourceValidator(l *slog.Logger, store *assets.StoreBuilder) (*ResourceValidator, error) {
+	version, err := semver.ParseTolerant(operator.DefaultPrometheusVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Prometheus version: %w", err)
+	}
+
+	return &ResourceValidator{
+		rs: &ResourceSelector{
+			l:        l,
+			version:  version,
+			store:    store,
+			accessor: operator.NewAccessor(l),
+		},
+	}, nil
+}
+
+// ValidateServiceMonitor returns an error if the ServiceMonitor is invalid.
+func (rv *ResourceValidator) ValidateServiceMonitor(ctx context.Context, sm *monitoringv1.ServiceMonitor) error {
+	return rv.rs.checkServiceMonitor(ctx, sm)
+}
+
+// ValidatePodMonitor returns an error if the PodMonitor is invalid.
+func (rv *ResourceValidator) ValidatePodMonitor(ctx context.Context, pm *monitoringv1.PodMonitor) error {
+	return rv.rs.checkPodMonitor(ctx, pm)
+}
+
+// ValidateProbe returns an error if the Probe is invalid.
+func (rv *ResourceValidator) ValidateProbe(ctx context.Context, probe *monitoringv1.Probe) error {
+	return rv.rs.checkProbe(ctx, probe)
+}
+
+// ValidateScrapeConfig returns an error if the ScrapeConfig is invalid.
+func (rv *ResourceValidator) ValidateScrapeConfig(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	return rv.rs.checkScrapeConfig(ctx, sc)
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// SelectionReport describes the resources selected by a Prometheus or
+// PrometheusAgent object during the last reconciliation.
+type SelectionReport struct {
+	// Timestamp is the time of the selection.
+	Timestamp time.Time `json:"timestamp"`
+
+	ServiceMonitors []SelectedResource `json:"serviceMonitors"`
+	PodMonitors     []SelectedResource `json:"podMonitors"`
+	Probes          []SelectedResource `json:"probes"`
+	ScrapeConfigs   []SelectedResource `json:"scrapeConfigs"`
+	// PrometheusRules is empty for PrometheusAgent objects.
+	PrometheusRules []SelectedResource `json:"prometheusRules"`
+}
+
+// SelectedResource is a resource matched by the selectors of a Prometheus or
+// PrometheusAgent object.
+type SelectedResource struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Accepted is false when the resource has been rejected because of an
+	// invalid configuration.
+	Accepted bool `json:"accepted"`
+	// Reason and Message explain why the resource has been rejected.
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func selectedResources[T ConfigurationResource](resources TypedResourcesSelection[T]) []SelectedResource {
+	res := make([]SelectedResource, 0, len(resources))
+	for _, k := range sortutil.SortedKeys(resources) {
+		r := resources[k]
+		o := any(r.resource).(metav1.Object)
+
+		sr := SelectedResource{
+			Namespace: o.GetNamespace(),
+			Name:      o.GetName(),
+			Accepted:  r.err == nil,
+		}
+		if r.err != nil {
+			sr.Reason = r.reason
+			sr.Message = r.err.Error()
+		}
+
+		res = append(res, sr)
+	}
+
+	return res
+}
+
+// NewSelectionReport returns a report from the selection results. The rules
+// argument can be nil.
+func NewSelectionReport(
+	sMons TypedResourcesSelection[*monitoringv1.ServiceMonitor],
+	pMons TypedResourcesSelection[*monitoringv1.PodMonitor],
+	probes TypedResourcesSelection[*monitoringv1.Probe],
+	sCons TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig],
+	rules *operator.PrometheusRuleSelection,
+) *SelectionReport {
+	report := &SelectionReport{
+		Timestamp:       time.Now().UTC(),
+		ServiceMonitors: selectedResources(sMons),
+		PodMonitors:     selectedResources(pMons),
+		Probes:          selectedResources(probes),
+		ScrapeConfigs:   selectedResources(sCons),
+		PrometheusRules: []SelectedResource{},
+	}
+
+	if rules == nil {
+		return report
+	}
+
+	selected := make(map[string]SelectedResource, len(rules.Sources)+len(rules.Rejected))
+	for _, pr := range rules.Sources {
+		selected[fmt.Sprintf("%s/%s", pr.Namespace, pr.Name)] = SelectedResource{
+			Namespace: pr.Namespace,
+			Name:      pr.Name,
+			Accepted:  true,
+		}
+	}
+
+	for k, err := range rules.Rejected {
+		ns, name, _ := strings.Cut(k, "/")
+		selected[k] = SelectedResource{
+			Namespace: ns,
+			Name:      name,
+			Reason:    invalidConfiguration,
+			Message:   err.Error(),
+		}
+	}
+
+	for _, k := range sortutil.SortedKeys(selected) {
+		report.PrometheusRules = append(report.PrometheusRules, selected[k])
+	}
+
+	return report
+}
+
+// SelectionTracker keeps the last selection report of each Prometheus or
+// PrometheusAgent object.
+type SelectionTracker struct {
+	mtx     sync.RWMutex
+	reports map[string]*SelectionReport
+}
+
+// NewSelectionTracker returns an empty SelectionTracker.
+func NewSelectionTracker() *SelectionTracker {
+	return &SelectionTracker{
+		reports: map[string]*SelectionReport{},
+	}
+}
+
+// Set records the report for the given `<namespace>/<name>` key.
+func (st *SelectionTracker) Set(key string, report *SelectionReport) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	st.reports[key] = report
+}
+
+// Delete removes the report for the given `<namespace>/<name>` key.
+func (st *SelectionTracker) Delete(key string) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	delete(st.reports, key)
+}
+
+// Get returns the report for the given `<namespace>/<name>` key (nil if it
+// doesn't exist).
+func (st *SelectionTracker) Get(key string) *SelectionReport {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	return st.reports[key]
+}
+
+// ServeHTTP implements the http.Handler interface. It expects the
+// `namespace` and `name` path values.
+func (st *SelectionTracker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ns, name := r.PathValue("namespace"), r.PathValue("name")
+
+	report := st.Get(fmt.Sprintf("%s/%s", ns, name))
+	if report == nil {
+		http.Error(w, fmt.Sprintf("no selection found for %s/%s", ns, name), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestSelectionReport(t *testing.T) {
+	report := NewSelectionReport(
+		TypedResourcesSelection[*monitoringv1.ServiceMonitor]{
+			"ns1/b": {resource: &monitoringv1.ServiceMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "b"}}},
+			"ns1/a": {
+				resource: &monitoringv1.ServiceMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "a"}},
+				err:      errors.New("invalid relabeling"),
+				reason:   invalidConfiguration,
+			},
+		},
+		nil,
+		nil,
+		nil,
+		&operator.PrometheusRuleSelection{
+			Sources: map[string]*monitoringv1.PrometheusRule{
+				"ns1-rule-uid.yaml": {ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "rule"}},
+			},
+			Rejected: map[string]error{
+				"ns2/bad": errors.New("invalid expression"),
+			},
+		},
+	)
+
+	require.Equal(t,
+		[]SelectedResource{
+			{Namespace: "ns1", Name: "a", Reason: invalidConfiguration, Message: "invalid relabeling"},
+			{Namespace: "ns1", Name: "b", Accepted: true},
+		},
+		report.ServiceMonitors,
+	)
+	require.Empty(t, report.PodMonitors)
+	require.NotNil(t, report.PodMonitors)
+	require.Equal(t,
+		[]SelectedResource{
+			{Namespace: "ns1", Name: "rule", Accepted: true},
+			{Namespace: "ns2", Name: "bad", Reason: invalidConfiguration, Message: "invalid expression"},
+		},
+		report.PrometheusRules,
+	)
+}
+
+func TestSelectionTracker(t *testing.T) {
+	st := NewSelectionTracker()
+	st.Set("default/test", NewSelectionReport(
+		TypedResourcesSelection[*monitoringv1.ServiceMonitor]{
+			"default/smon": {resource: &monitoringv1.ServiceMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "smon"}}},
+		},
+		nil, nil, nil, nil,
+	))
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v1/prometheuses/{namespace}/{name}/selected", st)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/prometheuses/default/test/selected", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var got SelectionReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	require.Equal(t, []SelectedResource{{Namespace: "default", Name: "smon", Accepted: true}}, got.ServiceMonitors)
+
+	st.Delete("default/test")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/prometheuses/default/test/selected", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -18,12 +18,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"reflect"
 	"strings"
 	"time"
 
 	"github.com/mitchellh/hashstructure"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -32,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
@@ -89,6 +92,8 @@ type Operator struct {
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
+	shardAutoscaler *prompkg.ShardAutoscaler
+	selections      *prompkg.SelectionTracker
 	statusReporter  prompkg.StatusReporter
 
 	endpointSliceSupported        bool
@@ -392,11 +397,23 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	o.shardAutoscaler = prompkg.NewShardAutoscaler(
+		o.logger,
+		o.kclient,
+		o.mclient,
+		o.ssetInfs,
+		prompkg.NewHTTPShardLoadGetter(o.kclient),
+		o.newEventRecorder,
+	)
+
+	o.selections = prompkg.NewSelectionTracker()
+
 	o.statusReporter = prompkg.StatusReporter{
 		Kclient:         o.kclient,
 		Reconciliations: o.reconciliations,
 		SsetInfs:        o.ssetInfs,
 		Rr:              o.rr,
+		ShardAutoscaler: o.shardAutoscaler,
 	}
 
 	return o, nil
@@ -559,6 +576,23 @@ func (c *Operator) addHandlers() {
 	})
 }
 
+// Register registers the HTTP handlers of the controller.
+func (c *Operator) Register(mux *http.ServeMux) {
+	mux.Handle("GET /api/v1/prometheuses/{namespace}/{name}/selected", c.selections)
+	mux.Handle("GET /api/v1/prometheuses/{namespace}/{name}/provenance", prompkg.NewProvenanceHandler(
+		c.logger,
+		c.kclient,
+		func(namespace, name string) (monitoringv1.PrometheusInterface, error) {
+			p, err := operator.GetObjectFromKey[*monitoringv1.Prometheus](c.promInfs, namespace+"/"+name)
+			if err != nil || p == nil {
+				return nil, err
+			}
+
+			return p, nil
+		},
+	))
+}
+
 // Run the controller.
 func (c *Operator) Run(ctx context.Context) error {
 	go c.rr.Run(ctx)
@@ -592,6 +626,13 @@ func (c *Operator) Run(ctx context.Context) error {
 
 	// TODO(simonpasquier): watch for Prometheus pods instead of polling.
 	go operator.StatusPoller(ctx, c)
+	go c.shardAutoscaler.Run(ctx, func(fn func(monitoringv1.PrometheusInterface)) {
+		if err := c.promInfs.ListAll(labels.Everything(), func(o any) {
+			fn(o.(*monitoringv1.Prometheus))
+		}); err != nil {
+			c.logger.Error("failed to list Prometheus objects", "err", err)
+		}
+	})
 
 	c.metrics.Ready().Set(1)
 	<-ctx.Done()
@@ -804,10 +845,12 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.selections.Delete(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
 
+	operator.SetSpanObject(ctx, p)
 	logger := c.logger.With("key", key)
 	c.logDeprecatedFields(logger, p)
 
@@ -828,6 +871,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.selections.Delete(key)
 		return nil
 	}
 
@@ -841,7 +885,9 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 
 	logger.Info("sync prometheus")
-	ruleConfigMapNames, err := c.createOrUpdateRuleConfigMaps(ctx, p)
+	spanCtx, span := operator.StartSpan(ctx, "select-rules")
+	ruleConfigMapNames, ruleSelection, err := c.createOrUpdateRuleConfigMaps(spanCtx, p)
+	operator.EndSpan(span, err)
 	if err != nil {
 		return err
 	}
@@ -857,17 +903,22 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return err
 	}
 
-	resources, err := c.getSelectedConfigResources(ctx, logger, p, assetStore)
+	spanCtx, span = operator.StartSpan(ctx, "select-resources")
+	resources, err := c.getSelectedConfigResources(spanCtx, logger, p, assetStore)
+	operator.EndSpan(span, err)
 	if err != nil {
 		return err
 	}
+	c.selections.Set(key, prompkg.NewSelectionReport(resources.sMons, resources.pMons, resources.bMons, resources.scrapeConfigs, ruleSelection))
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, assetStore, resources); err != nil {
+	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, ruleSelection.Sources, assetStore, resources); err != nil {
 		return fmt.Errorf("creating config failed: %w", err)
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
 
-	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
+	spanCtx, span = operator.StartSpan(ctx, "apply-tls-assets")
+	tlsAssets, err := operator.ReconcileShardedSecret(spanCtx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
+	operator.EndSpan(span, err)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
@@ -964,7 +1015,10 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 		if notFound {
 			logger.Debug("creating statefulset")
-			if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
+			spanCtx, span := operator.StartSpan(ctx, "create-statefulset", attribute.String("statefulset", ssetName))
+			_, err := ssetClient.Create(spanCtx, sset, metav1.CreateOptions{})
+			operator.EndSpan(span, err)
+			if err != nil {
 				return fmt.Errorf("creating statefulset failed: %w", err)
 			}
 			continue
@@ -981,7 +1035,9 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 			"existing_hash", existingStatefulSet.Annotations[operator.InputHashAnnotationKey],
 		)
 
-		err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
+		spanCtx, span := operator.StartSpan(ctx, "update-statefulset", attribute.String("statefulset", ssetName))
+		err = k8sutil.UpdateStatefulSet(spanCtx, ssetClient, sset)
+		operator.EndSpan(span, err)
 		sErr, ok := err.(*apierrors.StatusError)
 
 		if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
@@ -1182,7 +1238,7 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 		return fmt.Errorf("failed to create selector for prometheus scale status: %w", err)
 	}
 	p.Status.Selector = selector.String()
-	p.Status.Shards = ptr.Deref(p.Spec.Shards, 1)
+	p.Status.Shards = prompkg.ShardsNumber(p)
 
 	if _, err = c.mclient.MonitoringV1().Prometheuses(p.Namespace).ApplyStatus(ctx, prompkg.ApplyConfigurationFromPrometheus(p, true), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		c.logger.Info("failed to apply prometheus status subresource, trying again without scale fields", "err", err)
@@ -1321,12 +1377,12 @@ func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.
 	}, nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder, resources *selectedConfigResources) error {
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, ruleSources map[string]*monitoringv1.PrometheusRule, store *assets.StoreBuilder, resources *selectedConfigResources) error {
 	// If no service/pod monitor and probe selectors are configured, the user
 	// wants to manage configuration themselves. Let's create an empty Secret
 	// if it doesn't exist.
 	if c.unmanagedPrometheusConfiguration(p) {
-		s, err := prompkg.MakeConfigurationSecret(p, c.config, nil)
+		s, err := prompkg.MakeConfigurationSecret(p, c.config, nil, nil)
 		if err != nil {
 			return fmt.Errorf("failed to generate empty configuration secret: %w", err)
 		}
@@ -1345,16 +1401,117 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		return err
 	}
 
-	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
+	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
+	conf, err := GenerateConfiguration(
+		ctx,
+		logger,
+		sClient,
+		p,
+		cg,
+		store,
+		resources.sMons.ValidResources(),
+		resources.pMons.ValidResources(),
+		resources.bMons.ValidResources(),
+		resources.scrapeConfigs.ValidResources(),
+		ruleConfigMapNames,
+	)
+	if err != nil {
 		return err
 	}
 
+	provenance := prompkg.NewConfigProvenance(
+		p,
+		resources.sMons.ValidResources(),
+		resources.pMons.ValidResources(),
+		resources.bMons.ValidResources(),
+		resources.scrapeConfigs.ValidResources(),
+		ruleSources,
+	)
+
+	// Compress config to avoid 1mb secret limit for a while
+	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf, provenance)
+	if err != nil {
+		return fmt.Errorf("creating compressed secret failed: %w", err)
+	}
+
+	logger.Debug("updating Prometheus configuration secret")
+	ctx, span := operator.StartSpan(ctx, "apply-config-secret")
+	err = k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	operator.EndSpan(span, err)
+
+	return err
+}
+
+// GenerateConfiguration loads the Secrets and ConfigMaps referenced by the
+// Prometheus object into the store and returns the generated Prometheus
+// configuration for the given configuration resources.
+// It doesn't handle the unmanaged configuration case.
+func GenerateConfiguration(
+	ctx context.Context,
+	logger *slog.Logger,
+	sClient corev1client.SecretInterface,
+	p *monitoringv1.Prometheus,
+	cg *prompkg.ConfigGenerator,
+	store *assets.StoreBuilder,
+	sMons map[string]*monitoringv1.ServiceMonitor,
+	pMons map[string]*monitoringv1.PodMonitor,
+	probes map[string]*monitoringv1.Probe,
+	sCons map[string]*monitoringv1alpha1.ScrapeConfig,
+	ruleConfigMapNames []string,
+) ([]byte, error) {
+	spanCtx, span := operator.StartSpan(ctx, "load-secrets")
+	additional, err := loadConfigurationSecrets(spanCtx, logger, sClient, p, store)
+	operator.EndSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	_, span = operator.StartSpan(ctx, "generate-config")
+	conf, err := cg.GenerateServerConfiguration(
+		p,
+		sMons,
+		pMons,
+		probes,
+		sCons,
+		store,
+		additional.scrapeConfigs,
+		additional.alertRelabelConfigs,
+		additional.alertManagerConfigs,
+		ruleConfigMapNames,
+	)
+	operator.EndSpan(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("generating config failed: %w", err)
+	}
+
+	return conf, nil
+}
+
+type additionalConfigs struct {
+	scrapeConfigs       []byte
+	alertRelabelConfigs []byte
+	alertManagerConfigs []byte
+}
+
+// loadConfigurationSecrets loads the Secrets and ConfigMaps referenced by the
+// Prometheus object into the store and returns the additional configurations.
+func loadConfigurationSecrets(
+	ctx context.Context,
+	logger *slog.Logger,
+	sClient corev1client.SecretInterface,
+	p *monitoringv1.Prometheus,
+	store *assets.StoreBuilder,
+) (*additionalConfigs, error) {
+	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
+		return nil, err
+	}
+
 	if err := prompkg.AddRemoteWritesToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, err
 	}
 
 	if p.Spec.Alerting != nil {
@@ -1362,58 +1519,37 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 
 		for i, am := range ams {
 			if err := validateAlertmanagerEndpoints(p, am); err != nil {
-				return fmt.Errorf("alertmanager %d: %w", i, err)
+				return nil, fmt.Errorf("alertmanager %d: %w", i, err)
 			}
 		}
 
 		if err := addAlertmanagerEndpointsToStore(ctx, store, p.GetNamespace(), ams); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
-	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 	additionalAlertRelabelConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertRelabelConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
 	}
 	additionalAlertManagerConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertManagerConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
-	}
-
-	// Update secret based on the most recent configuration.
-	conf, err := cg.GenerateServerConfiguration(
-		p,
-		resources.sMons.ValidResources(),
-		resources.pMons.ValidResources(),
-		resources.bMons.ValidResources(),
-		resources.scrapeConfigs.ValidResources(),
-		store,
-		additionalScrapeConfigs,
-		additionalAlertRelabelConfigs,
-		additionalAlertManagerConfigs,
-		ruleConfigMapNames,
-	)
-	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
 	}
 
-	// Compress config to avoid 1mb secret limit for a while
-	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
-	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
-	}
-
-	logger.Debug("updating Prometheus configuration secret")
-	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	return &additionalConfigs{
+		scrapeConfigs:       additionalScrapeConfigs,
+		alertRelabelConfigs: additionalAlertRelabelConfigs,
+		alertManagerConfigs: additionalAlertManagerConfigs,
+	}, nil
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitoringv1.Prometheus) error {
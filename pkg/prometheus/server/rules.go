@@ -17,6 +17,7 @@ package prometheus
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"maps"
 	"reflect"
 	"strings"
@@ -27,57 +28,42 @@ import (
 	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
 	namespacelabeler "github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
-func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus) ([]string, error) {
+// createOrUpdateRuleConfigMaps returns the names of the rule ConfigMaps and
+// the result of the PrometheusRule selection.
+func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitoringv1.Prometheus) ([]string, *operator.PrometheusRuleSelection, error) {
 	cClient := c.kclient.CoreV1().ConfigMaps(p.Namespace)
 
 	namespaces, err := c.selectRuleNamespaces(p)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	excludedFromEnforcement := p.Spec.ExcludedFromEnforcement
-	// append the deprecated PrometheusRulesExcludedFromEnforce
-	for _, rule := range p.Spec.PrometheusRulesExcludedFromEnforce {
-		excludedFromEnforcement = append(excludedFromEnforcement,
-			monitoringv1.ObjectReference{
-				Namespace: rule.RuleNamespace,
-				Group:     monitoring.GroupName,
-				Resource:  monitoringv1.PrometheusRuleName,
-				Name:      rule.RuleName,
-			})
-	}
-	nsLabeler := namespacelabeler.New(
-		p.Spec.EnforcedNamespaceLabel,
-		excludedFromEnforcement,
-		true,
-	)
-
 	logger := c.logger.With("prometheus", p.Name, "namespace", p.Namespace)
-	promVersion := operator.StringValOrDefault(p.GetCommonPrometheusFields().Version, operator.DefaultPrometheusVersion)
-
-	promRuleSelector, err := operator.NewPrometheusRuleSelector(operator.PrometheusFormat, promVersion, p.Spec.RuleSelector, nsLabeler, c.ruleInfs, c.newEventRecorder(p), logger)
+	promRuleSelector, err := NewRuleSelector(p, c.ruleInfs, c.newEventRecorder(p), logger)
 	if err != nil {
-		return nil, fmt.Errorf("initializing PrometheusRules failed: %w", err)
+		return nil, nil, err
 	}
 
-	newRules, rejected, err := promRuleSelector.Select(namespaces)
+	ruleSelection, err := promRuleSelector.SelectRules(namespaces)
 	if err != nil {
-		return nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
+		return nil, nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
 	}
+	newRules := ruleSelection.RuleFiles
 
 	if pKey, ok := c.accessor.MetaNamespaceKey(p); ok {
 		c.metrics.SetSelectedResources(pKey, monitoringv1.PrometheusRuleKind, len(newRules))
-		c.metrics.SetRejectedResources(pKey, monitoringv1.PrometheusRuleKind, rejected)
+		c.metrics.SetRejectedResources(pKey, monitoringv1.PrometheusRuleKind, len(ruleSelection.Rejected))
 	}
 
 	currentConfigMapList, err := cClient.List(ctx, prometheusRulesConfigMapSelector(p.Name))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	currentConfigMaps := currentConfigMapList.Items
 
@@ -96,17 +82,17 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		for _, cm := range currentConfigMaps {
 			currentConfigMapNames = append(currentConfigMapNames, cm.Name)
 		}
-		return currentConfigMapNames, nil
+		return currentConfigMapNames, ruleSelection, nil
 	}
 
-	newConfigMaps, err := makeRulesConfigMaps(
+	newConfigMaps, err := MakeRulesConfigMaps(
 		p,
 		newRules,
 		operator.WithAnnotations(c.config.Annotations),
 		operator.WithLabels(c.config.Labels),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
+		return nil, nil, fmt.Errorf("failed to make rules ConfigMaps: %w", err)
 	}
 
 	newConfigMapNames := make([]string, 0, len(newConfigMaps))
@@ -122,10 +108,10 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 		for _, cm := range newConfigMaps {
 			_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 			if err != nil {
-				return nil, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
+				return nil, nil, fmt.Errorf("failed to create ConfigMap '%v': %w", cm.Name, err)
 			}
 		}
-		return newConfigMapNames, nil
+		return newConfigMapNames, ruleSelection, nil
 	}
 
 	// Simply deleting old ConfigMaps and creating new ones for now. Could be
@@ -133,7 +119,7 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 	for _, cm := range currentConfigMaps {
 		err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
+			return nil, nil, fmt.Errorf("failed to delete current ConfigMap '%v': %w", cm.Name, err)
 		}
 	}
 
@@ -144,11 +130,41 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 	for _, cm := range newConfigMaps {
 		_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
+			return nil, nil, fmt.Errorf("failed to create new ConfigMap '%v': %w", cm.Name, err)
 		}
 	}
 
-	return newConfigMapNames, nil
+	return newConfigMapNames, ruleSelection, nil
+}
+
+// NewRuleSelector returns a PrometheusRuleSelector which selects the
+// PrometheusRule objects matching the rule selector of the Prometheus object.
+func NewRuleSelector(p *monitoringv1.Prometheus, ruleInfs *informers.ForResource, eventRecorder *operator.EventRecorder, logger *slog.Logger) (*operator.PrometheusRuleSelector, error) {
+	excludedFromEnforcement := p.Spec.ExcludedFromEnforcement
+	// append the deprecated PrometheusRulesExcludedFromEnforce
+	for _, rule := range p.Spec.PrometheusRulesExcludedFromEnforce {
+		excludedFromEnforcement = append(excludedFromEnforcement,
+			monitoringv1.ObjectReference{
+				Namespace: rule.RuleNamespace,
+				Group:     monitoring.GroupName,
+				Resource:  monitoringv1.PrometheusRuleName,
+				Name:      rule.RuleName,
+			})
+	}
+	nsLabeler := namespacelabeler.New(
+		p.Spec.EnforcedNamespaceLabel,
+		excludedFromEnforcement,
+		true,
+	)
+
+	promVersion := operator.StringValOrDefault(p.GetCommonPrometheusFields().Version, operator.DefaultPrometheusVersion)
+
+	promRuleSelector, err := operator.NewPrometheusRuleSelector(operator.PrometheusFormat, promVersion, p.Spec.RuleSelector, nsLabeler, ruleInfs, eventRecorder, logger)
+	if err != nil {
+		return nil, fmt.Errorf("initializing PrometheusRules failed: %w", err)
+	}
+
+	return promRuleSelector, nil
 }
 
 func prometheusRulesConfigMapSelector(prometheusName string) metav1.ListOptions {
@@ -182,7 +198,7 @@ func (c *Operator) selectRuleNamespaces(p *monitoringv1.Prometheus) ([]string, e
 	return namespaces, nil
 }
 
-// makeRulesConfigMaps takes a Prometheus configuration and rule files and
+// MakeRulesConfigMaps takes a Prometheus configuration and rule files and
 // returns a list of Kubernetes ConfigMaps to be later on mounted into the
 // Prometheus instance.
 // If the total size of rule files exceeds the Kubernetes ConfigMap limit,
@@ -190,7 +206,7 @@ func (c *Operator) selectRuleNamespaces(p *monitoringv1.Prometheus) ([]string, e
 // future this can be replaced by a more sophisticated algorithm, but for now
 // simplicity should be sufficient.
 // [1] https://en.wikipedia.org/wiki/Bin_packing_problem#First-fit_algorithm
-func makeRulesConfigMaps(p *monitoringv1.Prometheus, ruleFiles map[string]string, opts ...operator.ObjectOption) ([]v1.ConfigMap, error) {
+func MakeRulesConfigMaps(p *monitoringv1.Prometheus, ruleFiles map[string]string, opts ...operator.ObjectOption) ([]v1.ConfigMap, error) {
 
 	buckets := []map[string]string{
 		{},
@@ -30,14 +30,14 @@ func TestMakeRulesConfigMaps(t *testing.T) {
 	t.Run("ShouldSplitUpLargeSmallIntoTwo", shouldSplitUpLargeSmallIntoTwo)
 }
 
-// makeRulesConfigMaps should return at least one ConfigMap even if it is empty
+// MakeRulesConfigMaps should return at least one ConfigMap even if it is empty
 // when there are no rules. Otherwise adding a rule to a Prometheus without rules
 // would change the statefulset definition and thereby force Prometheus to
 // restart.
 func shouldReturnAtLeastOneConfigMap(t *testing.T) {
 	ruleFiles := map[string]string{}
 
-	configMaps, err := makeRulesConfigMaps(&monitoringv1.Prometheus{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, ruleFiles)
+	configMaps, err := MakeRulesConfigMaps(&monitoringv1.Prometheus{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, ruleFiles)
 	require.NoError(t, err)
 
 	require.Len(t, configMaps, 1, "expected one ConfigMaps but got %v", len(configMaps))
@@ -49,7 +49,7 @@ func shouldSplitUpLargeSmallIntoTwo(t *testing.T) {
 	ruleFiles["first"] = strings.Repeat("a", operator.MaxConfigMapDataSize)
 	ruleFiles["second"] = "a"
 
-	configMaps, err := makeRulesConfigMaps(&monitoringv1.Prometheus{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, ruleFiles)
+	configMaps, err := MakeRulesConfigMaps(&monitoringv1.Prometheus{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, ruleFiles)
 	require.NoError(t, err)
 
 	require.Len(t, configMaps, 2, "expected rule files to be split up into two ConfigMaps, but got '%v' instead", len(configMaps))
@@ -0,0 +1,676 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+const (
+	// ShardsScaledReason is the reason of the events emitted when the shard
+	// autoscaler changes the number of shards.
+	ShardsScaledReason = "ShardsScaled"
+
+	defaultScaleDownStabilizationWindow = 5 * time.Minute
+
+	// shardAutoscalingTolerance is the relative deviation from the target
+	// under which the autoscaler doesn't change the number of shards.
+	shardAutoscalingTolerance = 0.1
+
+	shardAutoscalingInterval = time.Minute
+
+	// saturatedScaleUpCooldown is the minimum duration between 2 scaling
+	// decisions when the decision is driven by saturated shards. It gives
+	// time to the shards to redistribute the series after a scale-up.
+	saturatedScaleUpCooldown = 5 * time.Minute
+
+	// podStartupGracePeriod is the duration during which a pod which hasn't
+	// restarted isn't considered as saturated when it isn't ready (e.g. while
+	// replaying the WAL).
+	podStartupGracePeriod = 5 * time.Minute
+)
+
+// ShardLoad represents the load of a shard.
+type ShardLoad struct {
+	// HeadSeries is the number of head series (active series for
+	// PrometheusAgent).
+	HeadSeries int64
+	// MemoryBytes is the resident memory size in bytes.
+	MemoryBytes int64
+}
+
+// ShardLoadGetter returns the load of a Prometheus pod.
+type ShardLoadGetter interface {
+	GetShardLoad(ctx context.Context, p monitoringv1.PrometheusInterface, pod *v1.Pod) (ShardLoad, error)
+}
+
+// HTTPShardLoadGetter retrieves the load of the Prometheus pods from their
+// HTTP API.
+type HTTPShardLoadGetter struct {
+	kclient kubernetes.Interface
+}
+
+// NewHTTPShardLoadGetter returns a ShardLoadGetter querying the Prometheus
+// pods over HTTP.
+func NewHTTPShardLoadGetter(kclient kubernetes.Interface) *HTTPShardLoadGetter {
+	return &HTTPShardLoadGetter{
+		kclient: kclient,
+	}
+}
+
+// GetShardLoad implements the ShardLoadGetter interface.
+//
+// For Prometheus servers, the number of head series is read from the
+// `/api/v1/status/tsdb` endpoint. For Prometheus agents, the number of active
+// series is read from the `prometheus_agent_active_series` metric.
+func (g *HTTPShardLoadGetter) GetShardLoad(ctx context.Context, p monitoringv1.PrometheusInterface, pod *v1.Pod) (ShardLoad, error) {
+	cpf := p.GetCommonPrometheusFields()
+	if cpf.ListenLocal {
+		return ShardLoad{}, fmt.Errorf("pod %s listens on localhost only", pod.Name)
+	}
+
+	if pod.Status.PodIP == "" {
+		return ShardLoad{}, fmt.Errorf("pod %s has no IP address", pod.Name)
+	}
+
+	port, err := webPort(cpf, pod)
+	if err != nil {
+		return ShardLoad{}, err
+	}
+
+	var tlsConfig *monitoringv1.WebTLSConfig
+	if cpf.Web != nil {
+		tlsConfig = cpf.Web.TLSConfig
+	}
+
+	// When the web server is configured with TLS, the server's certificate
+	// is verified against the certificate defined in the web TLS
+	// configuration and it must be valid for the pod's DNS name
+	// (`<pod>.<governing service>.<namespace>.svc`).
+	ep, err := webconfig.PodEndpoint(ctx, g.kclient, pod, port, tlsConfig, nil)
+	if err != nil {
+		return ShardLoad{}, fmt.Errorf("pod %s: %w", pod.Name, err)
+	}
+	defer ep.Client.CloseIdleConnections()
+
+	client, base := ep.Client, ep.URL
+	base.Path = cpf.WebRoutePrefix()
+
+	metrics, err := getMetrics(ctx, client, base)
+	if err != nil {
+		return ShardLoad{}, err
+	}
+
+	var load ShardLoad
+	load.MemoryBytes, err = gaugeValue(metrics, "process_resident_memory_bytes")
+	if err != nil {
+		return ShardLoad{}, err
+	}
+
+	if _, ok := p.(*monitoringv1alpha1.PrometheusAgent); ok {
+		load.HeadSeries, err = gaugeValue(metrics, "prometheus_agent_active_series")
+		if err != nil {
+			return ShardLoad{}, err
+		}
+
+		return load, nil
+	}
+
+	load.HeadSeries, err = getHeadSeries(ctx, client, base)
+	if err != nil {
+		return ShardLoad{}, err
+	}
+
+	return load, nil
+}
+
+// webPort returns the container port of the Prometheus web server.
+func webPort(cpf monitoringv1.CommonPrometheusFields, pod *v1.Pod) (int32, error) {
+	portName := DefaultPortName
+	if cpf.PortName != "" {
+		portName = cpf.PortName
+	}
+
+	for _, c := range pod.Spec.Containers {
+		for _, port := range c.Ports {
+			if port.Name == portName {
+				return port.ContainerPort, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("pod %s has no container port named %q", pod.Name, portName)
+}
+
+func get(ctx context.Context, client *http.Client, base url.URL, p string) (*http.Response, error) {
+	u := base
+	u.Path = path.Join(base.Path, p)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s: unexpected status code %d", u.String(), resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+func getMetrics(ctx context.Context, client *http.Client, base url.URL) (map[string]float64, error) {
+	resp, err := get(ctx, client, base, "/metrics")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	metrics := make(map[string]float64, len(families))
+	for name, mf := range families {
+		if len(mf.GetMetric()) != 1 || mf.GetMetric()[0].GetGauge() == nil {
+			continue
+		}
+
+		metrics[name] = mf.GetMetric()[0].GetGauge().GetValue()
+	}
+
+	return metrics, nil
+}
+
+func getHeadSeries(ctx context.Context, client *http.Client, base url.URL) (int64, error) {
+	resp, err := get(ctx, client, base, "/api/v1/status/tsdb")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var tsdbStatus struct {
+		Data struct {
+			HeadStats struct {
+				NumSeries int64 `json:"numSeries"`
+			} `json:"headStats"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tsdbStatus); err != nil {
+		return 0, fmt.Errorf("failed to decode TSDB status: %w", err)
+	}
+
+	return tsdbStatus.Data.HeadStats.NumSeries, nil
+}
+
+func gaugeValue(metrics map[string]float64, name string) (int64, error) {
+	v, found := metrics[name]
+	if !found {
+		return 0, fmt.Errorf("metric %q not found", name)
+	}
+
+	return int64(v), nil
+}
+
+type recommendation struct {
+	shards    int32
+	timestamp time.Time
+}
+
+// ShardAutoscaler adjusts the number of shards of the Prometheus and
+// PrometheusAgent resources which define a shard autoscaling policy.
+//
+// The number of shards is stored in the resource's
+// AutoscaledShardsAnnotationName annotation which is read by
+// ShardsNumber(). The controller then creates or deletes the StatefulSets as
+// if the number of shards had been changed by the user.
+type ShardAutoscaler struct {
+	logger           *slog.Logger
+	kclient          kubernetes.Interface
+	mclient          monitoringclient.Interface
+	ssetInfs         *informers.ForResource
+	getter           ShardLoadGetter
+	newEventRecorder operator.NewEventRecorderFunc
+	now              func() time.Time
+
+	mtx             sync.Mutex
+	recommendations map[string][]recommendation
+	loads           map[string]map[int]ShardLoad
+	decisions       map[string]monitoringv1.ShardScalingDecision
+}
+
+// NewShardAutoscaler returns a new ShardAutoscaler.
+func NewShardAutoscaler(
+	logger *slog.Logger,
+	kclient kubernetes.Interface,
+	mclient monitoringclient.Interface,
+	ssetInfs *informers.ForResource,
+	getter ShardLoadGetter,
+	newEventRecorder operator.NewEventRecorderFunc,
+) *ShardAutoscaler {
+	return &ShardAutoscaler{
+		logger:           logger.With("component", "shard-autoscaler"),
+		kclient:          kclient,
+		mclient:          mclient,
+		ssetInfs:         ssetInfs,
+		getter:           getter,
+		newEventRecorder: newEventRecorder,
+		now:              time.Now,
+		recommendations:  map[string][]recommendation{},
+		loads:            map[string]map[int]ShardLoad{},
+		decisions:        map[string]monitoringv1.ShardScalingDecision{},
+	}
+}
+
+// Run evaluates periodically the resources returned by the list function
+// until the context is canceled.
+func (sa *ShardAutoscaler) Run(ctx context.Context, list func(func(monitoringv1.PrometheusInterface))) {
+	ticker := time.NewTicker(shardAutoscalingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			list(func(p monitoringv1.PrometheusInterface) {
+				if err := sa.Autoscale(ctx, p); err != nil {
+					sa.logger.Warn("shard autoscaling failed",
+						"err", err,
+						"namespace", p.GetObjectMeta().GetNamespace(),
+						"name", p.GetObjectMeta().GetName(),
+					)
+				}
+			})
+		}
+	}
+}
+
+// ShardLoads returns the shard loads observed during the last evaluation of
+// the given resource.
+func (sa *ShardAutoscaler) ShardLoads(p monitoringv1.PrometheusInterface) map[int]ShardLoad {
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	return sa.loads[autoscalerKey(p)]
+}
+
+// LastScalingDecision returns the last change of the number of shards
+// decided for the given resource since the autoscaler started.
+func (sa *ShardAutoscaler) LastScalingDecision(p monitoringv1.PrometheusInterface) *monitoringv1.ShardScalingDecision {
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	d, found := sa.decisions[autoscalerKey(p)]
+	if !found {
+		return nil
+	}
+
+	return &d
+}
+
+// Autoscale evaluates the load of the resource's shards and updates the
+// number of shards if needed.
+func (sa *ShardAutoscaler) Autoscale(ctx context.Context, p monitoringv1.PrometheusInterface) error {
+	key := autoscalerKey(p)
+	cpf := p.GetCommonPrometheusFields()
+
+	if cpf.ShardAutoscaling == nil || cpf.Paused || p.GetObjectMeta().GetDeletionTimestamp() != nil {
+		sa.forget(key)
+		return nil
+	}
+
+	if a, ok := p.(*monitoringv1alpha1.PrometheusAgent); ok && ptr.Deref(a.Spec.Mode, "") == monitoringv1alpha1.DaemonSetPrometheusAgentMode {
+		sa.forget(key)
+		return nil
+	}
+
+	current := ShardsNumber(p)
+	eval, err := sa.shardLoads(ctx, p, current)
+	if err != nil {
+		return err
+	}
+
+	sa.mtx.Lock()
+	sa.loads[key] = eval.loads
+	lastDecision, hasDecision := sa.decisions[key]
+	sa.mtx.Unlock()
+
+	if eval.pending {
+		// Don't take any decision while some shards are being created or
+		// rolled out.
+		return nil
+	}
+
+	if len(eval.saturated) > 0 {
+		if hasDecision && sa.now().Sub(lastDecision.Time.Time) < saturatedScaleUpCooldown {
+			return nil
+		}
+
+		sa.logger.Debug("saturated shards",
+			"namespace", p.GetObjectMeta().GetNamespace(),
+			"name", p.GetObjectMeta().GetName(),
+			"shards", eval.saturated,
+		)
+	}
+
+	desired := desiredShards(cpf.ShardAutoscaling, current, eval.loads, len(eval.saturated))
+	desired = sa.stabilize(key, cpf.ShardAutoscaling, current, desired)
+	if desired == current {
+		return nil
+	}
+
+	if err := sa.patchShards(ctx, p, desired); err != nil {
+		return fmt.Errorf("failed to update the number of shards: %w", err)
+	}
+
+	sa.mtx.Lock()
+	sa.decisions[key] = monitoringv1.ShardScalingDecision{
+		PreviousShards: current,
+		Shards:         desired,
+		Time:           metav1.NewTime(sa.now().UTC()),
+	}
+	sa.mtx.Unlock()
+
+	sa.logger.Info("number of shards updated",
+		"namespace", p.GetObjectMeta().GetNamespace(),
+		"name", p.GetObjectMeta().GetName(),
+		"from", current,
+		"to", desired,
+	)
+	obj, ok := p.(runtime.Object)
+	if !ok {
+		return nil
+	}
+	sa.newEventRecorder(obj).Eventf(
+		obj,
+		v1.EventTypeNormal,
+		ShardsScaledReason,
+		"Scale",
+		"Number of shards changed from %d to %d (total head series: %d, total memory: %s, saturated shards: %d)",
+		current,
+		desired,
+		totalLoad(eval.loads).HeadSeries,
+		resource.NewQuantity(totalLoad(eval.loads).MemoryBytes, resource.BinarySI).String(),
+		len(eval.saturated),
+	)
+
+	return nil
+}
+
+// shardsEvaluation is the result of the evaluation of the shards.
+type shardsEvaluation struct {
+	// loads of the shards which have all their pods ready and reachable.
+	// The load of a shard is the maximum load of its pods.
+	loads map[int]ShardLoad
+	// saturated lists the shards which have pods not ready or unreachable
+	// (e.g. crash-looping because of out-of-memory errors).
+	saturated []int
+	// pending is true when some shards are being created or rolled out.
+	pending bool
+}
+
+// shardLoads evaluates the shards of the resource.
+func (sa *ShardAutoscaler) shardLoads(ctx context.Context, p monitoringv1.PrometheusInterface, shards int32) (shardsEvaluation, error) {
+	eval := shardsEvaluation{loads: make(map[int]ShardLoad, shards)}
+
+	var lastErr error
+	for shard := range int(shards) {
+		ssetName := statefulSetKeyFromPrometheusName(p, shard)
+		obj, err := sa.ssetInfs.Get(ssetName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				eval.pending = true
+				continue
+			}
+
+			return shardsEvaluation{}, fmt.Errorf("failed to retrieve statefulset %s: %w", ssetName, err)
+		}
+
+		sset := obj.(*appsv1.StatefulSet)
+		if sset.Status.ObservedGeneration < sset.Generation || sset.Status.UpdateRevision != sset.Status.CurrentRevision {
+			eval.pending = true
+			continue
+		}
+
+		stsReporter, err := operator.NewStatefulSetReporter(ctx, sa.kclient, sset)
+		if err != nil {
+			return shardsEvaluation{}, fmt.Errorf("failed to retrieve statefulset state: %w", err)
+		}
+
+		if len(stsReporter.Pods) < int(ptr.Deref(sset.Spec.Replicas, 1)) {
+			eval.pending = true
+			continue
+		}
+
+		var (
+			load      ShardLoad
+			saturated bool
+		)
+		for _, pod := range stsReporter.Pods {
+			if !pod.Ready() {
+				if sa.starting(pod) {
+					eval.pending = true
+				} else {
+					saturated = true
+				}
+				continue
+			}
+
+			l, err := sa.getter.GetShardLoad(ctx, p, (*v1.Pod)(pod))
+			if err != nil {
+				lastErr = fmt.Errorf("failed to get the load of pod %s: %w", pod.Name, err)
+				saturated = true
+				continue
+			}
+
+			load.HeadSeries = max(load.HeadSeries, l.HeadSeries)
+			load.MemoryBytes = max(load.MemoryBytes, l.MemoryBytes)
+		}
+
+		if saturated {
+			eval.saturated = append(eval.saturated, shard)
+			continue
+		}
+
+		eval.loads[shard] = load
+	}
+
+	// When no shard is reachable, the configuration is more likely broken
+	// than all the shards saturated.
+	if lastErr != nil && len(eval.loads) == 0 {
+		return shardsEvaluation{}, lastErr
+	}
+
+	return eval, nil
+}
+
+// starting returns true if the pod has been started recently and none of
+// its containers has restarted.
+func (sa *ShardAutoscaler) starting(pod *operator.Pod) bool {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.RestartCount > 0 {
+			return false
+		}
+	}
+
+	return sa.now().Sub(pod.CreationTimestamp.Time) < podStartupGracePeriod
+}
+
+// stabilize returns the highest recommendation within the scale-down
+// stabilization window.
+func (sa *ShardAutoscaler) stabilize(key string, policy *monitoringv1.ShardAutoscaling, current, desired int32) int32 {
+	window := defaultScaleDownStabilizationWindow
+	if policy.ScaleDownStabilizationWindow != nil {
+		if d, err := model.ParseDuration(string(*policy.ScaleDownStabilizationWindow)); err == nil {
+			window = time.Duration(d)
+		}
+	}
+
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	now := sa.now()
+	recommendations := []recommendation{{shards: desired, timestamp: now}}
+	for _, r := range sa.recommendations[key] {
+		if now.Sub(r.timestamp) < window {
+			recommendations = append(recommendations, r)
+		}
+	}
+	sa.recommendations[key] = recommendations
+
+	if desired >= current {
+		return desired
+	}
+
+	stabilized := desired
+	for _, r := range recommendations {
+		stabilized = max(stabilized, r.shards)
+	}
+
+	return min(stabilized, current)
+}
+
+func (sa *ShardAutoscaler) forget(key string) {
+	sa.mtx.Lock()
+	defer sa.mtx.Unlock()
+
+	delete(sa.recommendations, key)
+	delete(sa.loads, key)
+	delete(sa.decisions, key)
+}
+
+func (sa *ShardAutoscaler) patchShards(ctx context.Context, p monitoringv1.PrometheusInterface, shards int32) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				AutoscaledShardsAnnotationName: strconv.Itoa(int(shards)),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		ns   = p.GetObjectMeta().GetNamespace()
+		name = p.GetObjectMeta().GetName()
+	)
+	switch p.(type) {
+	case *monitoringv1.Prometheus:
+		_, err = sa.mclient.MonitoringV1().Prometheuses(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: operator.PrometheusOperatorFieldManager})
+	case *monitoringv1alpha1.PrometheusAgent:
+		_, err = sa.mclient.MonitoringV1alpha1().PrometheusAgents(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: operator.PrometheusOperatorFieldManager})
+	default:
+		err = fmt.Errorf("unsupported type %T", p)
+	}
+
+	return err
+}
+
+// desiredShards returns the number of shards needed to meet the targets of
+// the policy. Saturated shards are considered over the targets: when there's
+// at least one, the number of shards is increased by one at least.
+func desiredShards(policy *monitoringv1.ShardAutoscaling, current int32, loads map[int]ShardLoad, saturated int) int32 {
+	total := totalLoad(loads)
+
+	desired := int32(-1)
+	if policy.TargetHeadSeries != nil {
+		desired = max(desired, desiredShardsForTarget(current, float64(total.HeadSeries), float64(*policy.TargetHeadSeries)))
+	}
+
+	if policy.TargetMemory != nil {
+		desired = max(desired, desiredShardsForTarget(current, float64(total.MemoryBytes), policy.TargetMemory.AsApproximateFloat64()))
+	}
+
+	if desired < 0 {
+		desired = current
+	}
+
+	if saturated > 0 {
+		desired = max(desired, current+1)
+	}
+
+	return min(max(desired, policy.MinShards, 1), max(policy.MaxShards, 1))
+}
+
+func desiredShardsForTarget(current int32, total, target float64) int32 {
+	if target <= 0 {
+		return current
+	}
+
+	// Like the HorizontalPodAutoscaler, don't scale when the average usage
+	// is close to the target.
+	ratio := total / (target * float64(current))
+	if math.Abs(ratio-1) <= shardAutoscalingTolerance {
+		return current
+	}
+
+	return int32(math.Ceil(total / target))
+}
+
+func totalLoad(loads map[int]ShardLoad) ShardLoad {
+	var total ShardLoad
+	for _, l := range loads {
+		total.HeadSeries += l.HeadSeries
+		total.MemoryBytes += l.MemoryBytes
+	}
+
+	return total
+}
+
+func autoscalerKey(p monitoringv1.PrometheusInterface) string {
+	return fmt.Sprintf("%T/%s/%s", p, p.GetObjectMeta().GetNamespace(), p.GetObjectMeta().GetName())
+}
+
+func statefulSetKeyFromPrometheusName(p monitoringv1.PrometheusInterface, shard int) string {
+	return fmt.Sprintf("%s/%s", p.GetObjectMeta().GetNamespace(), statefulSetNameFromPrometheusName(p, p.GetObjectMeta().GetName(), shard))
+}
@@ -0,0 +1,460 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestDesiredShards(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		policy    monitoringv1.ShardAutoscaling
+		loads     []ShardLoad
+		saturated int
+		desired   int32
+	}{
+		{
+			name:    "scale up on head series",
+			policy:  monitoringv1.ShardAutoscaling{MinShards: 1, MaxShards: 10, TargetHeadSeries: ptr.To(int64(1000))},
+			loads:   []ShardLoad{{HeadSeries: 1500}, {HeadSeries: 1600}},
+			desired: 4,
+		},
+		{
+			name:    "scale down on head series",
+			policy:  monitoringv1.ShardAutoscaling{MinShards: 1, MaxShards: 10, TargetHeadSeries: ptr.To(int64(1000))},
+			loads:   []ShardLoad{{HeadSeries: 200}, {HeadSeries: 300}, {HeadSeries: 100}},
+			desired: 1,
+		},
+		{
+			name:    "within tolerance",
+			policy:  monitoringv1.ShardAutoscaling{MinShards: 1, MaxShards: 10, TargetHeadSeries: ptr.To(int64(1000))},
+			loads:   []ShardLoad{{HeadSeries: 1050}, {HeadSeries: 1000}},
+			desired: 2,
+		},
+		{
+			name: "highest recommendation across targets",
+			policy: monitoringv1.ShardAutoscaling{
+				MinShards:        1,
+				MaxShards:        10,
+				TargetHeadSeries: ptr.To(int64(1000)),
+				TargetMemory:     ptr.To(resource.MustParse("1Gi")),
+			},
+			loads:   []ShardLoad{{HeadSeries: 500, MemoryBytes: 3 << 30}},
+			desired: 3,
+		},
+		{
+			name:    "bounded by maxShards",
+			policy:  monitoringv1.ShardAutoscaling{MinShards: 1, MaxShards: 3, TargetHeadSeries: ptr.To(int64(1000))},
+			loads:   []ShardLoad{{HeadSeries: 10000}},
+			desired: 3,
+		},
+		{
+			name:    "bounded by minShards",
+			policy:  monitoringv1.ShardAutoscaling{MinShards: 2, MaxShards: 3, TargetHeadSeries: ptr.To(int64(1000))},
+			loads:   []ShardLoad{{HeadSeries: 10}, {HeadSeries: 10}, {HeadSeries: 10}},
+			desired: 2,
+		},
+		{
+			name:      "saturated shard",
+			policy:    monitoringv1.ShardAutoscaling{MinShards: 1, MaxShards: 10, TargetHeadSeries: ptr.To(int64(1000))},
+			loads:     []ShardLoad{{HeadSeries: 200}},
+			saturated: 1,
+			desired:   3,
+		},
+		{
+			name:      "saturated shard bounded by maxShards",
+			policy:    monitoringv1.ShardAutoscaling{MinShards: 1, MaxShards: 2, TargetHeadSeries: ptr.To(int64(1000))},
+			loads:     []ShardLoad{{HeadSeries: 200}},
+			saturated: 1,
+			desired:   2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			loads := make(map[int]ShardLoad, len(tc.loads))
+			for i, l := range tc.loads {
+				loads[i] = l
+			}
+
+			require.Equal(t, tc.desired, desiredShards(&tc.policy, int32(len(tc.loads)+tc.saturated), loads, tc.saturated))
+		})
+	}
+}
+
+func TestShardAutoscalerStabilize(t *testing.T) {
+	var (
+		now    = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		policy = &monitoringv1.ShardAutoscaling{
+			MinShards:                    1,
+			MaxShards:                    10,
+			ScaleDownStabilizationWindow: ptr.To(monitoringv1.Duration("5m")),
+		}
+		sa = &ShardAutoscaler{
+			now:             func() time.Time { return now },
+			recommendations: map[string][]recommendation{},
+		}
+	)
+
+	// Scaling up is immediate.
+	require.Equal(t, int32(5), sa.stabilize("key", policy, 3, 5))
+
+	// Scaling down waits for the stabilization window to expire.
+	now = now.Add(time.Minute)
+	require.Equal(t, int32(5), sa.stabilize("key", policy, 5, 2))
+
+	now = now.Add(3 * time.Minute)
+	require.Equal(t, int32(5), sa.stabilize("key", policy, 5, 3))
+
+	now = now.Add(2 * time.Minute)
+	require.Equal(t, int32(3), sa.stabilize("key", policy, 5, 2))
+
+	now = now.Add(5 * time.Minute)
+	require.Equal(t, int32(2), sa.stabilize("key", policy, 3, 2))
+}
+
+func TestAutoscaledShardsNumber(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		shards      *int32
+		annotations map[string]string
+		expected    int32
+	}{
+		{
+			name:     "no annotation",
+			shards:   ptr.To(int32(3)),
+			expected: 3,
+		},
+		{
+			name:     "no annotation and shards below minShards",
+			expected: 2,
+		},
+		{
+			name:        "annotation",
+			shards:      ptr.To(int32(3)),
+			annotations: map[string]string{AutoscaledShardsAnnotationName: "4"},
+			expected:    4,
+		},
+		{
+			name:        "annotation above maxShards",
+			annotations: map[string]string{AutoscaledShardsAnnotationName: "10"},
+			expected:    5,
+		},
+		{
+			name:        "invalid annotation",
+			shards:      ptr.To(int32(3)),
+			annotations: map[string]string{AutoscaledShardsAnnotationName: "foo"},
+			expected:    3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Shards: tc.shards,
+						ShardAutoscaling: &monitoringv1.ShardAutoscaling{
+							MinShards:        2,
+							MaxShards:        5,
+							TargetHeadSeries: ptr.To(int64(1000)),
+						},
+					},
+				},
+			}
+
+			require.Equal(t, tc.expected, ShardsNumber(p))
+			require.Len(t, ExpectedStatefulSetShardNames(p), int(tc.expected))
+		})
+	}
+}
+
+func TestShardAutoscalerPatchShards(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			Namespace:   "default",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+	}
+	mclient := fake.NewSimpleClientset(p)
+	sa := &ShardAutoscaler{mclient: mclient}
+
+	require.NoError(t, sa.patchShards(context.Background(), p, 3))
+
+	p, err := mclient.MonitoringV1().Prometheuses("default").Get(context.Background(), "test", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"foo": "bar", AutoscaledShardsAnnotationName: "3"}, p.Annotations)
+}
+
+type fakeShardLoadGetter map[string]ShardLoad
+
+func (g fakeShardLoadGetter) GetShardLoad(_ context.Context, _ monitoringv1.PrometheusInterface, pod *v1.Pod) (ShardLoad, error) {
+	l, found := g[pod.Name]
+	if !found {
+		return ShardLoad{}, fmt.Errorf("pod %s unreachable", pod.Name)
+	}
+
+	return l, nil
+}
+
+func TestShardAutoscalerAutoscale(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newStatefulSet := func(name, shard string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "default",
+				Generation: 1,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To(int32(1)),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"shard": shard}},
+			},
+			Status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 1,
+				CurrentRevision:    "rev",
+				UpdateRevision:     "rev",
+			},
+		}
+	}
+
+	newPod := func(ssetName, shard string, ready bool, restarts int32, age time.Duration) *v1.Pod {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              ssetName + "-0",
+				Namespace:         "default",
+				Labels:            map[string]string{"shard": shard},
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				OwnerReferences:   []metav1.OwnerReference{{Kind: "StatefulSet", Name: ssetName}},
+			},
+			Status: v1.PodStatus{
+				Phase:             v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{{Name: "prometheus", RestartCount: restarts}},
+			},
+		}
+		if ready {
+			pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}
+		}
+
+		return pod
+	}
+
+	for _, tc := range []struct {
+		name         string
+		shard1Pod    *v1.Pod
+		getter       fakeShardLoadGetter
+		lastDecision *monitoringv1.ShardScalingDecision
+		expected     int32
+	}{
+		{
+			name:      "all shards below the target",
+			shard1Pod: newPod("prometheus-test-shard-1", "1", true, 0, time.Hour),
+			getter: fakeShardLoadGetter{
+				"prometheus-test-0":         {HeadSeries: 900},
+				"prometheus-test-shard-1-0": {HeadSeries: 1000},
+			},
+			expected: 2,
+		},
+		{
+			name:      "one shard not ready",
+			shard1Pod: newPod("prometheus-test-shard-1", "1", false, 5, time.Hour),
+			getter: fakeShardLoadGetter{
+				"prometheus-test-0": {HeadSeries: 900},
+			},
+			expected: 3,
+		},
+		{
+			name:      "one shard unreachable",
+			shard1Pod: newPod("prometheus-test-shard-1", "1", true, 0, time.Hour),
+			getter: fakeShardLoadGetter{
+				"prometheus-test-0": {HeadSeries: 900},
+			},
+			expected: 3,
+		},
+		{
+			name:      "one shard starting",
+			shard1Pod: newPod("prometheus-test-shard-1", "1", false, 0, time.Minute),
+			getter: fakeShardLoadGetter{
+				"prometheus-test-0": {HeadSeries: 900},
+			},
+			expected: 2,
+		},
+		{
+			name:      "one shard not ready during the cooldown",
+			shard1Pod: newPod("prometheus-test-shard-1", "1", false, 5, time.Hour),
+			getter: fakeShardLoadGetter{
+				"prometheus-test-0": {HeadSeries: 900},
+			},
+			lastDecision: &monitoringv1.ShardScalingDecision{PreviousShards: 1, Shards: 2, Time: metav1.NewTime(now.Add(-time.Minute))},
+			expected:     2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Shards: ptr.To(int32(2)),
+						ShardAutoscaling: &monitoringv1.ShardAutoscaling{
+							MinShards:        1,
+							MaxShards:        10,
+							TargetHeadSeries: ptr.To(int64(1000)),
+						},
+					},
+				},
+			}
+
+			kclient := kubefake.NewSimpleClientset(
+				newStatefulSet("prometheus-test", "0"),
+				newStatefulSet("prometheus-test-shard-1", "1"),
+				newPod("prometheus-test", "0", true, 0, time.Hour),
+				tc.shard1Pod,
+			)
+			ssetInfs, err := informers.NewInformersForResource(
+				informers.NewKubeInformerFactories(map[string]struct{}{v1.NamespaceAll: {}}, nil, kclient, 0, nil),
+				appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+			)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			ssetInfs.Start(ctx.Done())
+			require.Eventually(t, ssetInfs.HasSynced, time.Minute, 10*time.Millisecond)
+
+			mclient := fake.NewSimpleClientset(p)
+			sa := NewShardAutoscaler(
+				promslog.NewNopLogger(),
+				kclient,
+				mclient,
+				ssetInfs,
+				tc.getter,
+				func(obj runtime.Object) *operator.EventRecorder { return operator.NewFakeRecorder(10, obj) },
+			)
+			sa.now = func() time.Time { return now }
+			if tc.lastDecision != nil {
+				sa.decisions[autoscalerKey(p)] = *tc.lastDecision
+			}
+
+			require.NoError(t, sa.Autoscale(ctx, p))
+
+			p, err = mclient.MonitoringV1().Prometheuses("default").Get(ctx, "test", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ShardsNumber(p))
+
+			if tc.expected == 2 {
+				require.Equal(t, tc.lastDecision, sa.LastScalingDecision(p))
+				return
+			}
+
+			require.Equal(t, &monitoringv1.ShardScalingDecision{
+				PreviousShards: 2,
+				Shards:         tc.expected,
+				Time:           metav1.NewTime(now),
+			}, sa.LastScalingDecision(p))
+		})
+	}
+}
+
+func TestShardAutoscalerAllShardsUnreachable(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ShardAutoscaling: &monitoringv1.ShardAutoscaling{
+					MinShards:        1,
+					MaxShards:        10,
+					TargetHeadSeries: ptr.To(int64(1000)),
+				},
+			},
+		},
+	}
+
+	kclient := kubefake.NewSimpleClientset(
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "prometheus-test", Namespace: "default"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To(int32(1)),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "prometheus"}},
+			},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "prometheus-test-0",
+				Namespace:       "default",
+				Labels:          map[string]string{"app": "prometheus"},
+				OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "prometheus-test"}},
+			},
+			Status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+			},
+		},
+	)
+	ssetInfs, err := informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(map[string]struct{}{v1.NamespaceAll: {}}, nil, kclient, 0, nil),
+		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ssetInfs.Start(ctx.Done())
+	require.Eventually(t, ssetInfs.HasSynced, time.Minute, 10*time.Millisecond)
+
+	sa := NewShardAutoscaler(promslog.NewNopLogger(), kclient, fake.NewSimpleClientset(p), ssetInfs, fakeShardLoadGetter{}, nil)
+
+	// A broken configuration shouldn't be mistaken for saturated shards.
+	require.Error(t, sa.Autoscale(ctx, p))
+}
+
+func TestWebPort(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus-test-0"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "config-reloader", Ports: []v1.ContainerPort{{Name: "reloader-web", ContainerPort: 8080}}},
+				{Name: "prometheus", Ports: []v1.ContainerPort{{Name: "custom", ContainerPort: 9091}}},
+			},
+		},
+	}
+
+	_, err := webPort(monitoringv1.CommonPrometheusFields{}, pod)
+	require.Error(t, err)
+
+	port, err := webPort(monitoringv1.CommonPrometheusFields{PortName: "custom"}, pod)
+	require.NoError(t, err)
+	require.Equal(t, int32(9091), port)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"strconv"
+	"strings"
+)
+
+// rendezvousSlots is the number of slots used by the rendezvous sharding
+// strategy. It must never change, otherwise all the targets would be
+// reassigned.
+const rendezvousSlots = 256
+
+// rendezvousShardSlots returns the regular expressions matching the slots
+// assigned to each shard.
+//
+// Each slot is assigned to the shard with the highest score for this slot
+// (also known as highest random weight hashing). When a shard is added, it
+// only takes the slots for which it has the highest score and the other slots
+// stay on the same shards.
+func rendezvousShardSlots(shards int32) []string {
+	slots := make([][]string, shards)
+	for slot := range uint64(rendezvousSlots) {
+		var (
+			best      int32
+			bestScore uint64
+		)
+		for shard := range shards {
+			if score := rendezvousScore(slot, uint64(shard)); shard == 0 || score > bestScore {
+				best, bestScore = shard, score
+			}
+		}
+
+		slots[best] = append(slots[best], strconv.FormatUint(slot, 10))
+	}
+
+	regexps := make([]string, shards)
+	for shard := range slots {
+		regexps[shard] = strings.Join(slots[shard], "|")
+	}
+
+	return regexps
+}
+
+// rendezvousScore returns a pseudo-random score for the given slot and shard
+// using the SplitMix64 finalizer.
+func rendezvousScore(slot, shard uint64) uint64 {
+	z := slot<<32 | shard
+	z += 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
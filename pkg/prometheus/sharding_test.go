@@ -0,0 +1,59 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRendezvousShardSlots(t *testing.T) {
+	// slotOwners returns the shard owning each slot.
+	slotOwners := func(shards int32) map[string]int {
+		owners := make(map[string]int, rendezvousSlots)
+		for shard, slots := range rendezvousShardSlots(shards) {
+			if slots == "" {
+				continue
+			}
+			for _, slot := range strings.Split(slots, "|") {
+				owners[slot] = shard
+			}
+		}
+
+		require.Len(t, owners, rendezvousSlots)
+		return owners
+	}
+
+	for shards := int32(1); shards < 32; shards++ {
+		before := slotOwners(shards)
+		after := slotOwners(shards + 1)
+
+		var moved int
+		for slot, shard := range before {
+			if after[slot] == shard {
+				continue
+			}
+
+			// The slots can only move to the new shard.
+			require.Equal(t, int(shards), after[slot], "slot %s moved from shard %d to shard %d", slot, shard, after[slot])
+			moved++
+		}
+
+		// Allow some deviation from the 1/(N+1) ideal ratio.
+		require.Less(t, moved, 2*rendezvousSlots/int(shards+1)+8, "%d shards -> %d shards: %d slots moved", shards, shards+1, moved)
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruletest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	resyncPeriod   = 5 * time.Minute
+	controllerName = "prometheusruletest-controller"
+
+	testsFailedReason      = "TestsFailed"
+	evaluationFailedReason = "EvaluationFailed"
+)
+
+// Operator runs the unit tests defined by PrometheusRuleTest objects and
+// reports the results in their status subresource.
+type Operator struct {
+	mclient monitoringclient.Interface
+
+	logger   *slog.Logger
+	accessor *operator.Accessor
+
+	controllerID string
+
+	ruleTestInfs *informers.ForResource
+	ruleInfs     *informers.ForResource
+
+	rr *operator.ResourceReconciler
+
+	metrics *operator.Metrics
+
+	newEventRecorder operator.NewEventRecorderFunc
+}
+
+// New creates a new controller.
+func New(restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer) (*Operator, error) {
+	logger = logger.With("component", controllerName)
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
+	}
+
+	mclient, err := monitoringclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
+	}
+
+	// All the metrics exposed by the controller get the controller="prometheusruletest" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "prometheusruletest"}, r)
+
+	o := &Operator{
+		mclient:          mclient,
+		logger:           logger,
+		accessor:         operator.NewAccessor(logger),
+		metrics:          operator.NewMetrics(r),
+		newEventRecorder: c.EventRecorderFactory(client, controllerName),
+		controllerID:     c.ControllerID,
+	}
+
+	o.ruleTestInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.AllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.PrometheusRuleTestName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheusruletest informers: %w", err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.ruleTestInfs,
+		o.metrics,
+		monitoringv1alpha1.PrometheusRuleTestsKind,
+		r,
+		o.controllerID,
+	)
+
+	o.ruleInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.AllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheusrule informers: %w", err)
+	}
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{"PrometheusRuleTest", o.ruleTestInfs},
+		{"PrometheusRule", o.ruleInfs},
+	} {
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, "prometheusruletest", o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// addHandlers adds the eventhandlers to the informers.
+func (o *Operator) addHandlers() {
+	o.ruleTestInfs.AddEventHandler(o.rr)
+
+	o.ruleInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.PrometheusRuleKind,
+		o.enqueueForNamespace,
+		operator.WithFilter(
+			operator.AnyFilter(
+				operator.GenerationChanged,
+				operator.LabelsChanged,
+			),
+		),
+	))
+}
+
+// Run the controller.
+func (o *Operator) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.ruleTestInfs.Start(ctx.Done())
+	go o.ruleInfs.Start(ctx.Done())
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	o.addHandlers()
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// enqueueForNamespace enqueues all the PrometheusRuleTest objects from the
+// given namespace.
+func (o *Operator) enqueueForNamespace(ns string) {
+	err := o.ruleTestInfs.ListAllByNamespace(ns, labels.Everything(), func(obj any) {
+		o.rr.EnqueueForReconciliation(obj.(*monitoringv1alpha1.PrometheusRuleTest))
+	})
+	if err != nil {
+		o.logger.Error("listing all PrometheusRuleTest instances from cache failed",
+			"err", err,
+			"namespace", ns,
+		)
+	}
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *Operator) Sync(ctx context.Context, key string) error {
+	prt, err := operator.GetObjectFromKey[*monitoringv1alpha1.PrometheusRuleTest](o.ruleTestInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if prt == nil {
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(prt) {
+		return nil
+	}
+
+	operator.SetSpanObject(ctx, prt)
+	logger := o.logger.With("key", key)
+	logger.Info("sync prometheusruletest")
+
+	ruleNames, err := o.selectRuleNames(prt)
+	if err != nil {
+		return err
+	}
+
+	condition := monitoringv1.Condition{
+		Type:               monitoringv1alpha1.RuleTestPassed,
+		Status:             monitoringv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: prt.Generation,
+	}
+
+	spanCtx, span := operator.StartSpan(ctx, "run-tests")
+	results, err := o.runTests(spanCtx, logger, prt)
+	operator.EndSpan(span, err)
+	switch {
+	case err != nil:
+		condition.Status = monitoringv1.ConditionFalse
+		condition.Reason = evaluationFailedReason
+		condition.Message = err.Error()
+
+	default:
+		var failed []string
+		for _, res := range results {
+			if !res.Passed {
+				failed = append(failed, fmt.Sprintf("%q", res.Name))
+			}
+		}
+
+		if len(failed) > 0 {
+			condition.Status = monitoringv1.ConditionFalse
+			condition.Reason = testsFailedReason
+			condition.Message = fmt.Sprintf("%d out of %d test groups failed: %s", len(failed), len(results), strings.Join(failed, ", "))
+		}
+	}
+
+	prt.Status.Conditions = operator.UpdateConditions(prt.Status.Conditions, condition)
+	prt.Status.Rules = ruleNames
+	prt.Status.Tests = results
+
+	if _, err = o.mclient.MonitoringV1alpha1().PrometheusRuleTests(prt.Namespace).ApplyStatus(ctx, applyConfigurationFromPrometheusRuleTest(prt), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+//
+// The status is updated by Sync() since the test results only depend on the
+// resources' definitions.
+func (o *Operator) UpdateStatus(_ context.Context, _ string) error {
+	return nil
+}
+
+func (o *Operator) runTests(ctx context.Context, logger *slog.Logger, prt *monitoringv1alpha1.PrometheusRuleTest) ([]monitoringv1alpha1.RuleTestGroupResult, error) {
+	prs, err := operator.NewPrometheusRuleSelector(
+		operator.PrometheusFormat,
+		operator.DefaultPrometheusVersion,
+		prt.Spec.RuleSelector,
+		namespacelabeler.New("", nil, true),
+		o.ruleInfs,
+		o.newEventRecorder(prt),
+		logger,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the rule selector: %w", err)
+	}
+
+	ruleFiles, _, err := prs.Select([]string{prt.Namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to select the rules: %w", err)
+	}
+
+	return Run(ctx, logger, prt.Spec, ruleFiles)
+}
+
+// selectRuleNames returns the names of the PrometheusRule objects selected by
+// the test.
+func (o *Operator) selectRuleNames(prt *monitoringv1alpha1.PrometheusRuleTest) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(prt.Spec.RuleSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the rule selector: %w", err)
+	}
+
+	var names []string
+	err = o.ruleInfs.ListAllByNamespace(prt.Namespace, selector, func(obj any) {
+		names = append(names, obj.(*monitoringv1.PrometheusRule).Name)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prometheus rules in namespace %s: %w", prt.Namespace, err)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+func applyConfigurationFromPrometheusRuleTest(prt *monitoringv1alpha1.PrometheusRuleTest) *monitoringv1alpha1ac.PrometheusRuleTestApplyConfiguration {
+	sac := monitoringv1alpha1ac.PrometheusRuleTestStatus().
+		WithRules(prt.Status.Rules...)
+
+	for _, condition := range prt.Status.Conditions {
+		sac.WithConditions(
+			monitoringv1ac.Condition().
+				WithType(condition.Type).
+				WithStatus(condition.Status).
+				WithLastTransitionTime(condition.LastTransitionTime).
+				WithReason(condition.Reason).
+				WithMessage(condition.Message).
+				WithObservedGeneration(condition.ObservedGeneration),
+		)
+	}
+
+	for _, res := range prt.Status.Tests {
+		sac.WithTests(
+			monitoringv1alpha1ac.RuleTestGroupResult().
+				WithName(res.Name).
+				WithPassed(res.Passed).
+				WithFailures(res.Failures...),
+		)
+	}
+
+	return monitoringv1alpha1ac.PrometheusRuleTest(prt.Name, prt.Namespace).WithStatus(sac)
+}
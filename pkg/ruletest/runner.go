@@ -0,0 +1,515 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruletest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/rulefmt"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/promql/promqltest"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/prometheus/prometheus/storage"
+	"k8s.io/utils/ptr"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const (
+	defaultEvaluationInterval = time.Minute
+
+	// maxEvaluations is the maximum number of rule evaluations per test
+	// group. It prevents a single test from monopolizing the operator.
+	maxEvaluations = 10000
+)
+
+// groupLoader implements rules.GroupLoader for rule files held in memory.
+type groupLoader map[string]string
+
+func (gl groupLoader) Load(identifier string, ignoreUnknownFields bool) (*rulefmt.RuleGroups, []error) {
+	content, found := gl[identifier]
+	if !found {
+		return nil, []error{fmt.Errorf("rule file %q not found", identifier)}
+	}
+
+	return rulefmt.Parse([]byte(content), ignoreUnknownFields)
+}
+
+func (groupLoader) Parse(query string) (parser.Expr, error) {
+	return parser.ParseExpr(query)
+}
+
+// Run evaluates the unit tests against the rule files (indexed by file name)
+// and returns the result of each test group.
+//
+// The logic follows `promtool test rules`.
+func Run(ctx context.Context, logger *slog.Logger, spec monitoringv1alpha1.PrometheusRuleTestSpec, ruleFiles map[string]string) ([]monitoringv1alpha1.RuleTestGroupResult, error) {
+	evalInterval := defaultEvaluationInterval
+	if spec.EvaluationInterval != nil {
+		d, err := model.ParseDuration(string(*spec.EvaluationInterval))
+		if err != nil {
+			return nil, fmt.Errorf("invalid evaluation interval: %w", err)
+		}
+		evalInterval = time.Duration(d)
+	}
+
+	if evalInterval <= 0 {
+		return nil, errors.New("evaluation interval must be greater than 0")
+	}
+
+	groupOrder := make(map[string]int, len(spec.GroupEvalOrder))
+	for i, g := range spec.GroupEvalOrder {
+		groupOrder[g] = i
+	}
+
+	fileNames := make([]string, 0, len(ruleFiles))
+	for name := range ruleFiles {
+		fileNames = append(fileNames, name)
+	}
+	slices.Sort(fileNames)
+
+	tr := &testRunner{
+		logger:       logger,
+		evalInterval: evalInterval,
+		groupOrder:   groupOrder,
+		fuzzyCompare: ptr.Deref(spec.FuzzyCompare, false),
+		loader:       groupLoader(ruleFiles),
+		fileNames:    fileNames,
+	}
+
+	results := make([]monitoringv1alpha1.RuleTestGroupResult, 0, len(spec.Tests))
+	for _, tg := range spec.Tests {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var failures []string
+		for _, err := range tr.run(ctx, tg) {
+			failures = append(failures, err.Error())
+		}
+
+		results = append(results, monitoringv1alpha1.RuleTestGroupResult{
+			Name:     tg.Name,
+			Passed:   len(failures) == 0,
+			Failures: failures,
+		})
+	}
+
+	return results, nil
+}
+
+type testRunner struct {
+	logger       *slog.Logger
+	evalInterval time.Duration
+	groupOrder   map[string]int
+	fuzzyCompare bool
+	loader       groupLoader
+	fileNames    []string
+}
+
+func (tr *testRunner) run(ctx context.Context, tg monitoringv1alpha1.RuleTestGroup) (errs []error) {
+	interval := tr.evalInterval
+	if tg.Interval != nil {
+		d, err := model.ParseDuration(string(*tg.Interval))
+		if err != nil {
+			return []error{fmt.Errorf("invalid interval: %w", err)}
+		}
+		interval = time.Duration(d)
+	}
+
+	alertTests := make(map[time.Duration][]monitoringv1alpha1.AlertRuleTest)
+	var maxEvalTime time.Duration
+	for _, at := range tg.AlertRuleTests {
+		d, err := model.ParseDuration(string(at.EvalTime))
+		if err != nil {
+			return []error{fmt.Errorf("alertname %q: invalid evaluation time: %w", at.Alertname, err)}
+		}
+
+		alertTests[time.Duration(d)] = append(alertTests[time.Duration(d)], at)
+		maxEvalTime = max(maxEvalTime, time.Duration(d))
+	}
+
+	exprTestTimes := make([]time.Duration, len(tg.PromQLExprTests))
+	for i, et := range tg.PromQLExprTests {
+		d, err := model.ParseDuration(string(et.EvalTime))
+		if err != nil {
+			return []error{fmt.Errorf("expr %q: invalid evaluation time: %w", et.Expr, err)}
+		}
+
+		exprTestTimes[i] = time.Duration(d)
+		maxEvalTime = max(maxEvalTime, time.Duration(d))
+	}
+
+	if maxEvalTime/tr.evalInterval > maxEvaluations {
+		return []error{fmt.Errorf("too many evaluations: the maximum evaluation time (%s) divided by the evaluation interval (%s) must be less than %d", maxEvalTime, tr.evalInterval, maxEvaluations)}
+	}
+
+	suite, err := promqltest.NewLazyLoader(seriesLoadingString(interval, tg.InputSeries), promqltest.LazyLoaderOpts{
+		EnableAtModifier:     true,
+		EnableNegativeOffset: true,
+	})
+	if err != nil {
+		return []error{err}
+	}
+	defer func() {
+		if err := suite.Close(); err != nil {
+			tr.logger.Warn("failed to close the test storage", "err", err)
+		}
+	}()
+	suite.SubqueryInterval = tr.evalInterval
+
+	m := rules.NewManager(&rules.ManagerOptions{
+		QueryFunc:   rules.EngineQueryFunc(suite.QueryEngine(), suite.Storage()),
+		Appendable:  suite.Storage(),
+		Context:     ctx,
+		NotifyFunc:  func(context.Context, string, ...*rules.Alert) {},
+		Logger:      tr.logger,
+		GroupLoader: tr.loader,
+	})
+
+	groupsMap, errs := m.LoadGroups(tr.evalInterval, labels.FromMap(tg.ExternalLabels), tg.ExternalURL, nil, false, tr.fileNames...)
+	if errs != nil {
+		return errs
+	}
+	groups := orderedGroups(groupsMap, tr.groupOrder)
+
+	for _, g := range groups {
+		for _, r := range g.Rules() {
+			if ar, ok := r.(*rules.AlertingRule); ok {
+				// Mark alerting rules as restored, to ensure the ALERTS
+				// timeseries is created when they run.
+				ar.SetRestored(true)
+			}
+		}
+	}
+
+	alertEvalTimes := make([]time.Duration, 0, len(alertTests))
+	for t := range alertTests {
+		alertEvalTimes = append(alertEvalTimes, t)
+	}
+	slices.Sort(alertEvalTimes)
+
+	// Unlike promtool, NaN values are considered equal so that tests can
+	// expect NaN results.
+	compareFloat64 := cmp.Comparer(func(x, y float64) bool {
+		if x == y || (math.IsNaN(x) && math.IsNaN(y)) {
+			return true
+		}
+
+		return tr.fuzzyCompare && (math.Nextafter(x, math.Inf(-1)) == y || math.Nextafter(x, math.Inf(1)) == y)
+	})
+
+	mint := time.Unix(0, 0).UTC()
+	maxt := mint.Add(maxEvalTime)
+	curr := 0
+
+	for ts := mint; !ts.After(maxt); ts = ts.Add(tr.evalInterval) {
+		if err := ctx.Err(); err != nil {
+			return append(errs, err)
+		}
+
+		var evalErrs []error
+		suite.WithSamplesTill(ts, func(err error) {
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+
+			for _, g := range groups {
+				g.Eval(suite.Context(), ts)
+				for _, r := range g.Rules() {
+					if r.LastError() != nil {
+						evalErrs = append(evalErrs, fmt.Errorf("rule: %s, time: %s, err: %w", r.Name(), ts.Sub(mint), r.LastError()))
+					}
+				}
+			}
+		})
+
+		// Stop testing if errors occurred during the evaluation.
+		if len(evalErrs) > 0 {
+			return append(errs, evalErrs...)
+		}
+
+		// Check the alerts if ts <= eval_time < ts+evalInterval.
+		for curr < len(alertEvalTimes) && ts.Sub(mint) <= alertEvalTimes[curr] && alertEvalTimes[curr] < ts.Add(tr.evalInterval).Sub(mint) {
+			errs = append(errs, checkAlerts(groups, alertTests[alertEvalTimes[curr]], compareFloat64)...)
+			curr++
+		}
+	}
+
+	for i, et := range tg.PromQLExprTests {
+		if err := checkExpression(suite, et, mint.Add(exprTestTimes[i]), compareFloat64); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func checkAlerts(groups []*rules.Group, tests []monitoringv1alpha1.AlertRuleTest, compareFloat64 cmp.Option) []error {
+	// The same alert name can be present in multiple groups.
+	got := make(map[string]labelsAndAnnotations)
+	for _, g := range groups {
+		for _, r := range g.Rules() {
+			ar, ok := r.(*rules.AlertingRule)
+			if !ok {
+				continue
+			}
+
+			for _, a := range ar.ActiveAlerts() {
+				if a.State != rules.StateFiring {
+					continue
+				}
+
+				got[ar.Name()] = append(got[ar.Name()], labelAndAnnotation{
+					Labels:      a.Labels.Copy(),
+					Annotations: a.Annotations.Copy(),
+				})
+			}
+		}
+	}
+
+	var errs []error
+	for _, at := range tests {
+		gotAlerts := got[at.Alertname]
+
+		var expAlerts labelsAndAnnotations
+		for _, a := range at.ExpAlerts {
+			// The alertname label is added by Prometheus during the
+			// evaluation.
+			lbls := make(map[string]string, len(a.ExpLabels)+1)
+			for k, v := range a.ExpLabels {
+				lbls[k] = v
+			}
+			lbls[labels.AlertName] = at.Alertname
+
+			expAlerts = append(expAlerts, labelAndAnnotation{
+				Labels:      labels.FromMap(lbls),
+				Annotations: labels.FromMap(a.ExpAnnotations),
+			})
+		}
+
+		sort.Sort(gotAlerts)
+		sort.Sort(expAlerts)
+
+		if !cmp.Equal(expAlerts, gotAlerts, cmp.Comparer(labels.Equal), compareFloat64) {
+			errs = append(errs, fmt.Errorf("alertname: %s, time: %s, exp: %s, got: %s", at.Alertname, at.EvalTime, expAlerts, gotAlerts))
+		}
+	}
+
+	return errs
+}
+
+func checkExpression(suite *promqltest.LazyLoader, et monitoringv1alpha1.PromQLExprTest, ts time.Time, compareFloat64 cmp.Option) error {
+	got, err := query(suite.Context(), et.Expr, ts, suite.QueryEngine(), suite.Queryable())
+	if err != nil {
+		return fmt.Errorf("expr: %q, time: %s, err: %w", et.Expr, et.EvalTime, err)
+	}
+
+	var gotSamples []parsedSample
+	for _, s := range got {
+		gotSamples = append(gotSamples, parsedSample{
+			Labels:    s.Metric.Copy(),
+			Value:     s.F,
+			Histogram: promqltest.HistogramTestExpression(s.H),
+		})
+	}
+
+	var expSamples []parsedSample
+	for _, s := range et.ExpSamples {
+		ps, err := parseSample(s)
+		if err != nil {
+			return fmt.Errorf("expr: %q, time: %s, err: labels %q: %w", et.Expr, et.EvalTime, s.Labels, err)
+		}
+		expSamples = append(expSamples, ps)
+	}
+
+	sort.Slice(expSamples, func(i, j int) bool {
+		return labels.Compare(expSamples[i].Labels, expSamples[j].Labels) <= 0
+	})
+	sort.Slice(gotSamples, func(i, j int) bool {
+		return labels.Compare(gotSamples[i].Labels, gotSamples[j].Labels) <= 0
+	})
+
+	if !cmp.Equal(expSamples, gotSamples, cmp.Comparer(labels.Equal), compareFloat64) {
+		return fmt.Errorf("expr: %q, time: %s, exp: %s, got: %s", et.Expr, et.EvalTime, parsedSamplesString(expSamples), parsedSamplesString(gotSamples))
+	}
+
+	return nil
+}
+
+func parseSample(s monitoringv1alpha1.ExpectedSample) (parsedSample, error) {
+	lbls, err := parser.ParseMetric(s.Labels)
+	if err != nil {
+		return parsedSample{}, err
+	}
+
+	ps := parsedSample{Labels: lbls}
+
+	if s.Histogram != "" {
+		_, values, err := parser.ParseSeriesDesc("{} " + s.Histogram)
+		switch {
+		case err != nil:
+			return parsedSample{}, err
+		case len(values) != 1:
+			return parsedSample{}, fmt.Errorf("expected 1 value, got %d", len(values))
+		case values[0].Histogram == nil:
+			return parsedSample{}, fmt.Errorf("expected histogram, got %v", values[0])
+		}
+
+		ps.Histogram = promqltest.HistogramTestExpression(values[0].Histogram)
+		return ps, nil
+	}
+
+	if s.Value != "" {
+		ps.Value, err = strconv.ParseFloat(s.Value, 64)
+		if err != nil {
+			return parsedSample{}, fmt.Errorf("invalid value: %w", err)
+		}
+	}
+
+	return ps, nil
+}
+
+func query(ctx context.Context, qs string, t time.Time, engine *promql.Engine, qu storage.Queryable) (promql.Vector, error) {
+	q, err := engine.NewInstantQuery(ctx, qu, nil, qs, t)
+	if err != nil {
+		return nil, err
+	}
+
+	res := q.Exec(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	switch v := res.Value.(type) {
+	case promql.Vector:
+		return v, nil
+	case promql.Scalar:
+		return promql.Vector{promql.Sample{
+			T:      v.T,
+			F:      v.V,
+			Metric: labels.Labels{},
+		}}, nil
+	default:
+		return nil, errors.New("rule result is not a vector or scalar")
+	}
+}
+
+// seriesLoadingString returns the input series in the PromQL test notation.
+func seriesLoadingString(interval time.Duration, series []monitoringv1alpha1.RuleTestInputSeries) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "load %v\n", model.Duration(interval))
+	for _, s := range series {
+		fmt.Fprintf(&sb, "  %v %v\n", s.Series, s.Values)
+	}
+
+	return sb.String()
+}
+
+// orderedGroups returns the groups sorted according to the evaluation order.
+// The groups which aren't listed are evaluated last.
+func orderedGroups(groupsMap map[string]*rules.Group, groupOrder map[string]int) []*rules.Group {
+	groups := make([]*rules.Group, 0, len(groupsMap))
+	for _, g := range groupsMap {
+		groups = append(groups, g)
+	}
+
+	position := func(g *rules.Group) int {
+		if i, found := groupOrder[g.Name()]; found {
+			return i
+		}
+		return len(groupOrder)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if position(groups[i]) != position(groups[j]) {
+			return position(groups[i]) < position(groups[j])
+		}
+		return groups[i].File()+groups[i].Name() < groups[j].File()+groups[j].Name()
+	})
+
+	return groups
+}
+
+type labelAndAnnotation struct {
+	Labels      labels.Labels
+	Annotations labels.Labels
+}
+
+func (la labelAndAnnotation) String() string {
+	return fmt.Sprintf("{labels: %s, annotations: %s}", la.Labels, la.Annotations)
+}
+
+type labelsAndAnnotations []labelAndAnnotation
+
+func (la labelsAndAnnotations) Len() int      { return len(la) }
+func (la labelsAndAnnotations) Swap(i, j int) { la[i], la[j] = la[j], la[i] }
+func (la labelsAndAnnotations) Less(i, j int) bool {
+	diff := labels.Compare(la[i].Labels, la[j].Labels)
+	if diff != 0 {
+		return diff < 0
+	}
+	return labels.Compare(la[i].Annotations, la[j].Annotations) < 0
+}
+
+func (la labelsAndAnnotations) String() string {
+	s := make([]string, 0, len(la))
+	for _, l := range la {
+		s = append(s, l.String())
+	}
+
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// parsedSample is a sample with parsed labels.
+type parsedSample struct {
+	Labels    labels.Labels
+	Value     float64
+	Histogram string // TestExpression() of histogram.FloatHistogram
+}
+
+func (ps parsedSample) String() string {
+	if ps.Histogram != "" {
+		return ps.Labels.String() + " " + ps.Histogram
+	}
+	return ps.Labels.String() + " " + strconv.FormatFloat(ps.Value, 'E', -1, 64)
+}
+
+func parsedSamplesString(pss []parsedSample) string {
+	if len(pss) == 0 {
+		return "nil"
+	}
+
+	s := make([]string, 0, len(pss))
+	for _, ps := range pss {
+		s = append(s, ps.String())
+	}
+
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruletest
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const ruleFile = `groups:
+- name: example
+  rules:
+  - record: job:up:sum
+    expr: sum by (job) (up)
+  - alert: InstanceDown
+    expr: up == 0
+    for: 5m
+    labels:
+      severity: critical
+    annotations:
+      summary: 'Instance {{ $labels.instance }} of job {{ $labels.job }} is down'
+`
+
+func TestRun(t *testing.T) {
+	inputSeries := []monitoringv1alpha1.RuleTestInputSeries{
+		{Series: `up{job="prometheus",instance="localhost:9090"}`, Values: "0 0 0 0 0 0 0 0 0 0 0"},
+		{Series: `up{job="node",instance="localhost:9100"}`, Values: "1+0x10"},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		test     monitoringv1alpha1.RuleTestGroup
+		expected monitoringv1alpha1.RuleTestGroupResult
+	}{
+		{
+			name: "passing tests",
+			test: monitoringv1alpha1.RuleTestGroup{
+				Name:        "passing",
+				InputSeries: inputSeries,
+				AlertRuleTests: []monitoringv1alpha1.AlertRuleTest{
+					{
+						EvalTime:  "4m",
+						Alertname: "InstanceDown",
+					},
+					{
+						EvalTime:  "10m",
+						Alertname: "InstanceDown",
+						ExpAlerts: []monitoringv1alpha1.ExpectedAlert{{
+							ExpLabels: map[string]string{
+								"severity": "critical",
+								"job":      "prometheus",
+								"instance": "localhost:9090",
+							},
+							ExpAnnotations: map[string]string{
+								"summary": "Instance localhost:9090 of job prometheus is down",
+							},
+						}},
+					},
+				},
+				PromQLExprTests: []monitoringv1alpha1.PromQLExprTest{
+					{
+						Expr:     "job:up:sum",
+						EvalTime: "5m",
+						ExpSamples: []monitoringv1alpha1.ExpectedSample{
+							{Labels: `job:up:sum{job="node"}`, Value: "1"},
+							{Labels: `job:up:sum{job="prometheus"}`},
+						},
+					},
+					{
+						Expr:       `up{job="foo"}`,
+						EvalTime:   "5m",
+						ExpSamples: nil,
+					},
+					{
+						Expr:     "0/0",
+						EvalTime: "0m",
+						ExpSamples: []monitoringv1alpha1.ExpectedSample{
+							{Value: "NaN"},
+						},
+					},
+				},
+			},
+			expected: monitoringv1alpha1.RuleTestGroupResult{
+				Name:   "passing",
+				Passed: true,
+			},
+		},
+		{
+			name: "failing tests",
+			test: monitoringv1alpha1.RuleTestGroup{
+				Name:        "failing",
+				InputSeries: inputSeries,
+				AlertRuleTests: []monitoringv1alpha1.AlertRuleTest{
+					{
+						EvalTime:  "4m",
+						Alertname: "InstanceDown",
+						ExpAlerts: []monitoringv1alpha1.ExpectedAlert{{
+							ExpLabels: map[string]string{
+								"severity": "critical",
+								"job":      "prometheus",
+								"instance": "localhost:9090",
+							},
+						}},
+					},
+				},
+				PromQLExprTests: []monitoringv1alpha1.PromQLExprTest{
+					{
+						Expr:     `job:up:sum{job="node"}`,
+						EvalTime: "5m",
+						ExpSamples: []monitoringv1alpha1.ExpectedSample{
+							{Labels: `job:up:sum{job="node"}`, Value: "2"},
+						},
+					},
+				},
+			},
+			expected: monitoringv1alpha1.RuleTestGroupResult{
+				Name:   "failing",
+				Passed: false,
+				Failures: []string{
+					`alertname: InstanceDown, time: 4m, exp: [{labels: {alertname="InstanceDown", instance="localhost:9090", job="prometheus", severity="critical"}, annotations: {}}], got: []`,
+					`expr: "job:up:sum{job=\"node\"}", time: 5m, exp: {__name__="job:up:sum", job="node"} 2E+00, got: {__name__="job:up:sum", job="node"} 1E+00`,
+				},
+			},
+		},
+		{
+			name: "invalid sample value",
+			test: monitoringv1alpha1.RuleTestGroup{
+				Name:        "invalid",
+				InputSeries: inputSeries,
+				PromQLExprTests: []monitoringv1alpha1.PromQLExprTest{
+					{
+						Expr:     "vector(1)",
+						EvalTime: "0m",
+						ExpSamples: []monitoringv1alpha1.ExpectedSample{
+							{Value: "one"},
+						},
+					},
+				},
+			},
+			expected: monitoringv1alpha1.RuleTestGroupResult{
+				Name:   "invalid",
+				Passed: false,
+				Failures: []string{
+					`expr: "vector(1)", time: 0m, err: labels "": invalid value: strconv.ParseFloat: parsing "one": invalid syntax`,
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := Run(
+				context.Background(),
+				slog.New(slog.DiscardHandler),
+				monitoringv1alpha1.PrometheusRuleTestSpec{
+					EvaluationInterval: ptr.To(monitoringv1.Duration("1m")),
+					Tests:              []monitoringv1alpha1.RuleTestGroup{tc.test},
+				},
+				map[string]string{"default-example.yaml": ruleFile},
+			)
+			require.NoError(t, err)
+			require.Equal(t, []monitoringv1alpha1.RuleTestGroupResult{tc.expected}, results)
+		})
+	}
+}
+
+func TestRunInvalidRules(t *testing.T) {
+	results, err := Run(
+		context.Background(),
+		slog.New(slog.DiscardHandler),
+		monitoringv1alpha1.PrometheusRuleTestSpec{
+			Tests: []monitoringv1alpha1.RuleTestGroup{{Name: "invalid"}},
+		},
+		map[string]string{"default-example.yaml": "groups:\n- name: example\n  rules:\n  - record: foo\n    expr: sum(\n"},
+	)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Passed)
+	require.Len(t, results[0].Failures, 1)
+}
+
+func TestRunTooManyEvaluations(t *testing.T) {
+	results, err := Run(
+		context.Background(),
+		slog.New(slog.DiscardHandler),
+		monitoringv1alpha1.PrometheusRuleTestSpec{
+			EvaluationInterval: ptr.To(monitoringv1.Duration("1s")),
+			Tests: []monitoringv1alpha1.RuleTestGroup{{
+				Name: "long",
+				PromQLExprTests: []monitoringv1alpha1.PromQLExprTest{{
+					Expr:     "vector(1)",
+					EvalTime: "1d",
+				}},
+			}},
+		},
+		map[string]string{"default-example.yaml": ruleFile},
+	)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Passed)
+	require.Contains(t, results[0].Failures[0], "too many evaluations")
+}
@@ -0,0 +1,397 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+// bucketStatusRefreshInterval is the interval at which the status of the
+// ThanosCompactor and ThanosStore objects is refreshed. The bucket conditions
+// may change without any update of the pods (e.g. the compactor halts but
+// its pods remain ready).
+const bucketStatusRefreshInterval = time.Minute
+
+// bucketStatusPoller refreshes the status of all the objects at every
+// interval. Unlike operator.StatusPoller, it also refreshes the objects which
+// are already available so that the bucket conditions reflect the latest
+// metrics of the pods.
+func bucketStatusPoller(ctx context.Context, sr operator.StatusReconciler, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sr.Iterate(func(obj metav1.Object, _ []monitoringv1.Condition) {
+				sr.RefreshStatusFor(obj)
+			})
+		}
+	}
+}
+
+// bucketOperator holds the clients and the state shared by the controllers
+// of the Thanos components operating on an object storage bucket.
+type bucketOperator struct {
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+
+	logger   *slog.Logger
+	accessor *operator.Accessor
+
+	controllerID string
+	// application is the value of the "app.kubernetes.io/name" label of
+	// the managed statefulsets.
+	application string
+
+	ssetInfs *informers.ForResource
+
+	rr *operator.ResourceReconciler
+
+	metrics             *operator.Metrics
+	reconciliations     *operator.ReconciliationTracker
+	canReadStorageClass bool
+
+	bucketStatusClient BucketStatusClient
+	buckets            *bucketTracker
+
+	config Config
+}
+
+type BucketControllerOption func(*bucketOperator)
+
+// WithBucketStorageClassValidation tells that the controller should verify
+// that the storage spec references a valid StorageClass name.
+func WithBucketStorageClassValidation() BucketControllerOption {
+	return func(o *bucketOperator) {
+		o.canReadStorageClass = true
+	}
+}
+
+func newBucketOperator(restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, application string, options ...BucketControllerOption) (*bucketOperator, error) {
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
+	}
+
+	mclient, err := monitoringclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
+	}
+
+	o := &bucketOperator{
+		kclient:            client,
+		mclient:            mclient,
+		logger:             logger,
+		accessor:           operator.NewAccessor(logger),
+		metrics:            operator.NewMetrics(r),
+		reconciliations:    &operator.ReconciliationTracker{},
+		controllerID:       c.ControllerID,
+		application:        application,
+		bucketStatusClient: NewHTTPBucketStatusClient(),
+		buckets:            newBucketTracker(),
+		config: Config{
+			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+			Annotations:            c.Annotations,
+			Labels:                 c.Labels,
+			LocalHost:              c.LocalHost,
+		},
+	}
+
+	for _, opt := range options {
+		opt(o)
+	}
+
+	o.ssetInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = fmt.Sprintf("%s,%s=%s", operator.ManagedByOperatorLabelSelector(), operator.ApplicationNameLabelKey, application)
+			},
+		),
+		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
+	}
+
+	return o, nil
+}
+
+// syncComponent reconciles the secrets, the governing service and the
+// statefulset of the component.
+func (o *bucketOperator) syncComponent(ctx context.Context, key string, c *bucketComponent, tlsAssets *operator.ShardedSecret) error {
+	logger := o.logger.With("key", key)
+
+	if err := o.createOrUpdateWebConfigSecret(ctx, c); err != nil {
+		return fmt.Errorf("failed to synchronize web config secret: %w", err)
+	}
+
+	svcClient := o.kclient.CoreV1().Services(c.owner.GetNamespace())
+	if _, err := k8sutil.CreateOrUpdateService(ctx, svcClient, makeBucketService(c, o.config)); err != nil {
+		return fmt.Errorf("synchronizing governing service failed: %w", err)
+	}
+
+	existingStatefulSet, err := o.getStatefulSetFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	ssetClient := o.kclient.AppsV1().StatefulSets(c.owner.GetNamespace())
+	if existingStatefulSet == nil {
+		sset, err := makeBucketStatefulSet(c, o.config, "", tlsAssets)
+		if err != nil {
+			return fmt.Errorf("making %s statefulset config failed: %w", c.application, err)
+		}
+
+		operator.SanitizeSTS(sset)
+		spanCtx, span := operator.StartSpan(ctx, "create-statefulset", attribute.String("statefulset", sset.Name))
+		_, err = ssetClient.Create(spanCtx, sset, metav1.CreateOptions{})
+		operator.EndSpan(span, err)
+		if err != nil {
+			return fmt.Errorf("creating %s statefulset failed: %w", c.application, err)
+		}
+
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(existingStatefulSet) {
+		return nil
+	}
+
+	newSSetInputHash, err := createBucketInputHash(c.owner, o.config, tlsAssets, existingStatefulSet.Spec)
+	if err != nil {
+		return err
+	}
+
+	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
+		logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+		return nil
+	}
+
+	sset, err := makeBucketStatefulSet(c, o.config, newSSetInputHash, tlsAssets)
+	if err != nil {
+		return fmt.Errorf("failed to generate statefulset: %w", err)
+	}
+
+	operator.SanitizeSTS(sset)
+
+	logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationKey])
+	spanCtx, span := operator.StartSpan(ctx, "update-statefulset", attribute.String("statefulset", sset.Name))
+	err = k8sutil.UpdateStatefulSet(spanCtx, ssetClient, sset)
+	operator.EndSpan(span, err)
+	sErr, ok := err.(*apierrors.StatusError)
+
+	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
+		o.metrics.StsDeleteCreateCounter().Inc()
+
+		// Gather only reason for failed update
+		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
+		for i, cause := range sErr.ErrStatus.Details.Causes {
+			failMsg[i] = cause.Message
+		}
+
+		logger.Info("recreating StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		propagationPolicy := metav1.DeletePropagationForeground
+		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating StatefulSet failed: %w", err)
+	}
+
+	return nil
+}
+
+// getStatefulSetFromKey returns a copy of the StatefulSet object
+// corresponding to the object identified by key.
+// If the object is not found, it returns a nil pointer without error.
+func (o *bucketOperator) getStatefulSetFromKey(key string) (*appsv1.StatefulSet, error) {
+	keyParts := strings.Split(key, "/")
+	ssetName := keyParts[0] + "/" + bucketPrefixedName(o.application, keyParts[1])
+
+	obj, err := o.ssetInfs.Get(ssetName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("StatefulSet not found", "key", ssetName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve StatefulSet from informer: %w", err)
+	}
+
+	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
+}
+
+// updateStatus updates the replica counters and returns the conditions of
+// the object. It returns false if the status shouldn't be updated.
+//
+// Besides the Available and Reconciled conditions, the BucketSynced
+// condition (and the CompactionHealthy condition when compaction is true) is
+// computed from the bucket status reported by the ready pods.
+func (o *bucketOperator) updateStatus(ctx context.Context, key string, c *bucketComponent, gObj operator.GoverningObject, conditions []monitoringv1.Condition, compaction bool) ([]monitoringv1.Condition, bool, error) {
+	sset, err := o.getStatefulSetFromKey(key)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get StatefulSet: %w", err)
+	}
+
+	if sset != nil && o.rr.DeletionInProgress(sset) {
+		return nil, false, nil
+	}
+
+	stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to retrieve statefulset state: %w", err)
+	}
+
+	generation := c.owner.GetGeneration()
+	newConditions := []monitoringv1.Condition{
+		stsReporter.Update(gObj),
+		o.reconciliations.GetCondition(key, generation),
+	}
+
+	states := o.buckets.Update(key, collectBucketStatus(ctx, o.logger, o.bucketStatusClient, stsReporter.ReadyPods(), func(pod *v1.Pod) (webconfig.Endpoint, error) {
+		return o.bucketStatusEndpoint(ctx, c, pod)
+	}))
+	newConditions = append(newConditions, bucketSyncedCondition(states, generation))
+	if compaction {
+		newConditions = append(newConditions, compactionHealthyCondition(states, generation))
+	}
+
+	return operator.UpdateConditions(conditions, newConditions...), true, nil
+}
+
+// bucketStatusEndpoint returns the endpoint of the component's web server for
+// the given pod. The HTTP client is configured from the web TLS configuration
+// and the operator's client configuration of the component.
+func (o *bucketOperator) bucketStatusEndpoint(ctx context.Context, c *bucketComponent, pod *v1.Pod) (webconfig.Endpoint, error) {
+	var (
+		tlsConfig    *monitoringv1.WebTLSConfig
+		clientConfig *monitoringv1.WebClientConfig
+	)
+	if c.isHTTPS() {
+		tlsConfig = c.common.Web.TLSConfig
+	}
+	if c.common.Web != nil {
+		clientConfig = c.common.Web.OperatorClientConfig
+	}
+
+	return webconfig.PodEndpoint(ctx, o.kclient, pod, httpPort, tlsConfig, clientConfig)
+}
+
+// forget removes the state associated to the object identified by key.
+func (o *bucketOperator) forget(key string) {
+	o.reconciliations.ForgetObject(key)
+	o.buckets.Delete(key)
+}
+
+func (o *bucketOperator) createOrUpdateWebConfigSecret(ctx context.Context, c *bucketComponent) error {
+	var fields monitoringv1.WebConfigFileFields
+	if c.common.Web != nil {
+		fields = c.common.Web.WebConfigFileFields
+	}
+
+	webConfig, err := webconfig.New(
+		webConfigDir,
+		bucketWebConfigSecretName(c.application, c.owner.GetName()),
+		fields,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize the web config: %w", err)
+	}
+
+	s := &v1.Secret{}
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(o.config.Labels),
+		operator.WithAnnotations(o.config.Annotations),
+		operator.WithManagingOwner(c.owner),
+	)
+
+	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, o.kclient.CoreV1().Secrets(c.owner.GetNamespace()), s); err != nil {
+		return fmt.Errorf("failed to update the web config secret: %w", err)
+	}
+
+	return nil
+}
+
+func newBucketTLSAssetSecret(c *bucketComponent, config Config) *v1.Secret {
+	s := &v1.Secret{
+		Data: map[string][]byte{},
+	}
+
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithManagingOwner(c.owner),
+		operator.WithName(bucketTLSAssetsSecretName(c.application, c.owner.GetName())),
+		operator.WithNamespace(c.owner.GetNamespace()),
+	)
+
+	return s
+}
+
+func applyConfigurationFromBucketStatus(status *monitoringv1alpha1.ThanosBucketStatus) *monitoringv1alpha1ac.ThanosBucketStatusApplyConfiguration {
+	sac := monitoringv1alpha1ac.ThanosBucketStatus().
+		WithPaused(status.Paused).
+		WithReplicas(status.Replicas).
+		WithAvailableReplicas(status.AvailableReplicas).
+		WithUpdatedReplicas(status.UpdatedReplicas).
+		WithUnavailableReplicas(status.UnavailableReplicas)
+
+	for _, condition := range status.Conditions {
+		sac.WithConditions(
+			monitoringv1ac.Condition().
+				WithType(condition.Type).
+				WithStatus(condition.Status).
+				WithLastTransitionTime(condition.LastTransitionTime).
+				WithReason(condition.Reason).
+				WithMessage(condition.Message).
+				WithObservedGeneration(condition.ObservedGeneration),
+		)
+	}
+
+	return sac
+}
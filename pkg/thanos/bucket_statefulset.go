@@ -0,0 +1,398 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/blang/semver/v4"
+	"github.com/mitchellh/hashstructure"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+const (
+	compactorApplicationNameLabelValue = "thanos-compactor"
+	storeApplicationNameLabelValue     = "thanos-store"
+)
+
+// bucketObject is implemented by the ThanosCompactor and ThanosStore
+// objects.
+type bucketObject interface {
+	metav1.Object
+	operator.Owner
+}
+
+// bucketComponent describes the statefulset of a Thanos component operating
+// on an object storage bucket.
+type bucketComponent struct {
+	// application is the value of the "app.kubernetes.io/name" label. It is
+	// also used as the container name and the prefix of the resource names.
+	application string
+	// command is the Thanos subcommand (e.g. "compact").
+	command string
+
+	owner    bucketObject
+	common   *monitoringv1alpha1.CommonThanosBucketFields
+	replicas *int32
+
+	// args, volumes and volumeMounts are specific to the component.
+	args         []monitoringv1.Argument
+	volumes      []v1.Volume
+	volumeMounts []v1.VolumeMount
+	// grpc is true if the component exposes the StoreAPI.
+	grpc bool
+}
+
+func (c *bucketComponent) prefixedName() string {
+	return bucketPrefixedName(c.application, c.owner.GetName())
+}
+
+func (c *bucketComponent) selectorLabels() map[string]string {
+	return makeBucketSelectorLabels(c.application, c.owner.GetName())
+}
+
+// isHTTPS returns true if the web server of the component uses TLS.
+func (c *bucketComponent) isHTTPS() bool {
+	if c.common.Web == nil || c.common.Web.TLSConfig == nil {
+		return false
+	}
+
+	version, err := semver.ParseTolerant(operator.StringValOrDefault(ptr.Deref(c.common.Version, ""), operator.DefaultThanosVersion))
+	if err != nil {
+		return false
+	}
+
+	return version.GTE(semver.MustParse("0.21.0"))
+}
+
+func makeBucketStatefulSet(c *bucketComponent, config Config, inputHash string, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSet, error) {
+	spec, err := makeBucketStatefulSetSpec(c, config, tlsSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulset := &appsv1.StatefulSet{Spec: *spec}
+	operator.UpdateObject(
+		statefulset,
+		operator.WithName(c.prefixedName()),
+		operator.WithAnnotations(c.owner.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithInputHashAnnotation(inputHash),
+		operator.WithLabels(c.owner.GetLabels()),
+		operator.WithSelectorLabels(spec.Selector),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(c.owner),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	addStorageVolume(statefulset, c.common.Storage, bucketVolumeName(c.application, c.owner.GetName()))
+
+	statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, c.common.Volumes...)
+
+	return statefulset, nil
+}
+
+func makeBucketStatefulSetSpec(c *bucketComponent, config Config, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSetSpec, error) {
+	common := c.common
+
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(common.Version, ""), operator.DefaultThanosVersion)
+	version, err := semver.ParseTolerant(thanosVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Thanos version %q: %w", thanosVersion, err)
+	}
+
+	imagePath, err := operator.BuildImagePath(
+		ptr.Deref(common.Image, ""),
+		operator.StringValOrDefault(config.ThanosDefaultBaseImage, operator.DefaultThanosBaseImage),
+		thanosVersion,
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image path: %w", err)
+	}
+
+	cliArgs := []monitoringv1.Argument{
+		{Name: "data-dir", Value: storageDir},
+	}
+
+	if common.LogLevel != "" && common.LogLevel != "info" {
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "log.level", Value: common.LogLevel})
+	}
+	if common.LogFormat != "" {
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "log.format", Value: common.LogFormat})
+	}
+
+	var (
+		volumes      []v1.Volume
+		volumeMounts []v1.VolumeMount
+		fullPath     string
+	)
+	switch {
+	case common.ObjectStorageConfigFile != nil:
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: *common.ObjectStorageConfigFile})
+	case common.ObjectStorageConfig != nil:
+		volumes, volumeMounts, fullPath = mountSecretKey(volumes, volumeMounts, common.ObjectStorageConfig, "objstorage-config")
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: fullPath})
+	default:
+		return nil, errors.New(c.owner.GetName() + ": either objectStorageConfig or objectStorageConfigFile must be defined")
+	}
+
+	cliArgs = append(cliArgs, c.args...)
+	volumes = append(volumes, c.volumes...)
+	volumeMounts = append(volumeMounts, c.volumeMounts...)
+
+	if tlsSecrets != nil {
+		volumes = append(volumes, tlsSecrets.Volume("tls-assets"))
+		volumeMounts = append(volumeMounts, v1.VolumeMount{
+			Name:      "tls-assets",
+			ReadOnly:  true,
+			MountPath: tlsAssetsDir,
+		})
+	}
+
+	if version.GTE(semver.MustParse("0.21.0")) {
+		var fields monitoringv1.WebConfigFileFields
+		if common.Web != nil {
+			fields = common.Web.WebConfigFileFields
+		}
+
+		webConfig, err := webconfig.New(webConfigDir, bucketWebConfigSecretName(c.application, c.owner.GetName()), fields)
+		if err != nil {
+			return nil, err
+		}
+
+		confArg, configVol, configMount, err := webConfig.GetMountParameters()
+		if err != nil {
+			return nil, err
+		}
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "http.config", Value: confArg.Value})
+		volumes = append(volumes, configVol...)
+		volumeMounts = append(volumeMounts, configMount...)
+	}
+
+	containerArgs, err := operator.BuildArgs(cliArgs, common.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first argument to thanos must be the name of the component, e.g. "thanos compact --data-dir=..."
+	containerArgs = append([]string{c.command}, containerArgs...)
+
+	volumeMounts = append(volumeMounts, v1.VolumeMount{
+		Name:      storageVolumeName(common.Storage, bucketVolumeName(c.application, c.owner.GetName())),
+		MountPath: storageDir,
+	})
+	volumeMounts = append(volumeMounts, common.VolumeMounts...)
+
+	ports := []v1.ContainerPort{
+		{
+			Name:          defaultPortName,
+			ContainerPort: httpPort,
+			Protocol:      v1.ProtocolTCP,
+		},
+	}
+	if c.grpc {
+		ports = append(ports, v1.ContainerPort{
+			Name:          "grpc",
+			ContainerPort: grpcPort,
+			Protocol:      v1.ProtocolTCP,
+		})
+	}
+
+	podAnnotations := map[string]string{}
+	podLabels := map[string]string{}
+	if common.PodMetadata != nil {
+		maps.Copy(podLabels, common.PodMetadata.Labels)
+		maps.Copy(podAnnotations, common.PodMetadata.Annotations)
+	}
+
+	// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+	// We should try to avoid removing such immutable fields whenever possible since doing
+	// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+	// The requirement to make a change here should be carefully evaluated.
+	selectorLabels := c.selectorLabels()
+
+	finalLabels := config.Labels.Merge(podLabels)
+	maps.Copy(finalLabels, selectorLabels)
+
+	podAnnotations[operator.DefaultContainerAnnotationKey] = c.application
+
+	operatorContainers := []v1.Container{
+		{
+			Name:                     c.application,
+			Image:                    imagePath,
+			ImagePullPolicy:          common.ImagePullPolicy,
+			Args:                     containerArgs,
+			VolumeMounts:             volumeMounts,
+			Resources:                common.Resources,
+			Ports:                    ports,
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &v1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				Capabilities: &v1.Capabilities{
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+		},
+	}
+
+	containers, err := k8sutil.MergePatchContainers(operatorContainers, common.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
+	}
+
+	return &appsv1.StatefulSetSpec{
+		ServiceName: c.prefixedName(),
+		Replicas:    c.replicas,
+		// PodManagementPolicy is set to Parallel to mitigate issues in kubernetes: https://github.com/kubernetes/kubernetes/issues/60164
+		// This is also mentioned as one of limitations of StatefulSets: https://kubernetes.io/docs/concepts/workloads/controllers/statefulset/#limitations
+		PodManagementPolicy: appsv1.ParallelPodManagement,
+		UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		},
+		Selector: &metav1.LabelSelector{
+			MatchLabels: selectorLabels,
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      finalLabels,
+				Annotations: podAnnotations,
+			},
+			Spec: v1.PodSpec{
+				NodeSelector:              common.NodeSelector,
+				PriorityClassName:         common.PriorityClassName,
+				ServiceAccountName:        common.ServiceAccountName,
+				Containers:                containers,
+				Volumes:                   volumes,
+				SecurityContext:           common.SecurityContext,
+				Tolerations:               common.Tolerations,
+				Affinity:                  common.Affinity,
+				TopologySpreadConstraints: common.TopologySpreadConstraints,
+				ImagePullSecrets:          common.ImagePullSecrets,
+			},
+		},
+	}, nil
+}
+
+// makeBucketService returns the governing service of the statefulset.
+func makeBucketService(c *bucketComponent, config Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			ClusterIP: "None",
+			Ports: []v1.ServicePort{
+				{
+					Name:       defaultPortName,
+					Port:       httpPort,
+					TargetPort: intstr.FromString(defaultPortName),
+					Protocol:   v1.ProtocolTCP,
+				},
+			},
+			Selector: c.selectorLabels(),
+		},
+	}
+
+	if c.grpc {
+		svc.Spec.Ports = append(svc.Spec.Ports, v1.ServicePort{
+			Name:       "grpc",
+			Port:       grpcPort,
+			TargetPort: intstr.FromString("grpc"),
+			Protocol:   v1.ProtocolTCP,
+		})
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(c.prefixedName()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(map[string]string{operator.ApplicationNameLabelKey: c.application}),
+		operator.WithLabels(config.Labels),
+		operator.WithOwner(c.owner),
+	)
+
+	return svc
+}
+
+func createBucketInputHash(obj metav1.Object, c Config, tlsAssets *operator.ShardedSecret, ss appsv1.StatefulSetSpec) (string, error) {
+	// The controller should ignore any changes to RevisionHistoryLimit field because
+	// it may be modified by external actors.
+	// See https://github.com/prometheus-operator/prometheus-operator/issues/5712
+	ss.RevisionHistoryLimit = nil
+
+	hash, err := hashstructure.Hash(struct {
+		Labels          map[string]string
+		Annotations     map[string]string
+		Generation      int64
+		Config          Config
+		StatefulSetSpec appsv1.StatefulSetSpec
+		ShardedSecret   *operator.ShardedSecret
+	}{
+		Labels:          obj.GetLabels(),
+		Annotations:     obj.GetAnnotations(),
+		Generation:      obj.GetGeneration(),
+		Config:          c,
+		StatefulSetSpec: ss,
+		ShardedSecret:   tlsAssets,
+	},
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate combined hash: %w", err)
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
+// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+// We should try to avoid removing such immutable fields whenever possible since doing
+// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+// The requirement to make a change here should be carefully evaluated.
+func makeBucketSelectorLabels(application, name string) map[string]string {
+	return map[string]string{
+		operator.ApplicationNameLabelKey:     application,
+		operator.ManagedByLabelKey:           operator.ManagedByLabelValue,
+		operator.ApplicationInstanceLabelKey: name,
+		application:                          name,
+	}
+}
+
+func bucketPrefixedName(application, name string) string {
+	return fmt.Sprintf("%s-%s", application, name)
+}
+
+func bucketVolumeName(application, name string) string {
+	return fmt.Sprintf("%s-data", bucketPrefixedName(application, name))
+}
+
+func bucketTLSAssetsSecretName(application, name string) string {
+	return fmt.Sprintf("%s-tls-assets", bucketPrefixedName(application, name))
+}
+
+func bucketWebConfigSecretName(application, name string) string {
+	return fmt.Sprintf("%s-web-config", bucketPrefixedName(application, name))
+}
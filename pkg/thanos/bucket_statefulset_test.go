@@ -0,0 +1,186 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestMakeCompactorStatefulSet(t *testing.T) {
+	tc := &monitoringv1alpha1.ThanosCompactor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1alpha1.ThanosCompactorSpec{
+			CommonThanosBucketFields: monitoringv1alpha1.CommonThanosBucketFields{
+				ObjectStorageConfig: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "objstore"},
+					Key:                  "thanos.yaml",
+				},
+				Storage: &monitoringv1.StorageSpec{
+					VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+						Spec: v1.PersistentVolumeClaimSpec{
+							Resources: v1.VolumeResourceRequirements{
+								Requests: v1.ResourceList{
+									v1.ResourceStorage: resource.MustParse("10Gi"),
+								},
+							},
+						},
+					},
+				},
+			},
+			RetentionResolution: &monitoringv1alpha1.ThanosRetentionResolution{
+				Raw:     ptr.To(monitoringv1.Duration("30d")),
+				OneHour: ptr.To(monitoringv1.Duration("1y")),
+			},
+			DisableDownsampling:        true,
+			DeduplicationReplicaLabels: []string{"replica", "prometheus_replica"},
+			DeleteDelay:                ptr.To(monitoringv1.Duration("12h")),
+		},
+	}
+
+	sset, err := makeBucketStatefulSet(makeCompactorComponent(tc), Config{}, "", nil)
+	require.NoError(t, err)
+
+	require.Equal(t, "thanos-compactor-main", sset.Name)
+	require.Equal(t, "thanos-compactor-main", sset.Spec.ServiceName)
+	require.Equal(t, int32(1), *sset.Spec.Replicas)
+	require.Equal(t, "main", sset.Spec.Selector.MatchLabels["thanos-compactor"])
+
+	require.Len(t, sset.Spec.Template.Spec.Containers, 1)
+	container := sset.Spec.Template.Spec.Containers[0]
+	require.Equal(t, "thanos-compactor", container.Name)
+	require.Equal(t, "compact", container.Args[0])
+	for _, arg := range []string{
+		"--data-dir=/thanos/data",
+		"--objstore.config-file=/etc/thanos/config/objstorage-config/thanos.yaml",
+		"--wait",
+		"--retention.resolution-raw=30d",
+		"--retention.resolution-1h=1y",
+		"--downsampling.disable",
+		"--deduplication.replica-label=replica",
+		"--deduplication.replica-label=prometheus_replica",
+		"--delete-delay=12h",
+	} {
+		require.Contains(t, container.Args, arg)
+	}
+	require.NotContains(t, container.Args, "--retention.resolution-5m")
+
+	// The data volume is provided by the volume claim template.
+	require.Len(t, sset.Spec.VolumeClaimTemplates, 1)
+	require.Equal(t, "thanos-compactor-main-data", sset.Spec.VolumeClaimTemplates[0].Name)
+	require.Contains(t, container.VolumeMounts, v1.VolumeMount{
+		Name:      "thanos-compactor-main-data",
+		MountPath: storageDir,
+	})
+
+	// The compactor doesn't expose the StoreAPI.
+	require.Len(t, container.Ports, 1)
+	svc := makeBucketService(makeCompactorComponent(tc), Config{})
+	require.Len(t, svc.Spec.Ports, 1)
+}
+
+func TestMakeStoreStatefulSet(t *testing.T) {
+	ts := &monitoringv1alpha1.ThanosStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1alpha1.ThanosStoreSpec{
+			CommonThanosBucketFields: monitoringv1alpha1.CommonThanosBucketFields{
+				ObjectStorageConfigFile: ptr.To("/etc/thanos/objstore.yaml"),
+			},
+			Replicas: ptr.To(int32(2)),
+			MinTime:  "-2w",
+			IndexCacheConfig: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "cache"},
+				Key:                  "index.yaml",
+			},
+			GRPCServerTLSConfig: &monitoringv1.TLSConfig{
+				CertFile: "/etc/tls/cert.pem",
+				KeyFile:  "/etc/tls/key.pem",
+			},
+		},
+	}
+
+	c := makeStoreComponent(ts, assets.NewTestStoreBuilder().ForNamespace(ts.Namespace))
+	sset, err := makeBucketStatefulSet(c, Config{}, "", &operator.ShardedSecret{})
+	require.NoError(t, err)
+
+	require.Equal(t, "thanos-store-main", sset.Name)
+	require.Equal(t, int32(2), *sset.Spec.Replicas)
+
+	container := sset.Spec.Template.Spec.Containers[0]
+	require.Equal(t, "store", container.Args[0])
+	for _, arg := range []string{
+		"--objstore.config-file=/etc/thanos/objstore.yaml",
+		"--min-time=-2w",
+		"--index-cache.config-file=/etc/thanos/config/index-cache-config/index.yaml",
+		"--grpc-server-tls-cert=/etc/tls/cert.pem",
+		"--grpc-server-tls-key=/etc/tls/key.pem",
+	} {
+		require.Contains(t, container.Args, arg)
+	}
+
+	// Without storage, the data directory is an emptyDir volume.
+	var found bool
+	for _, vol := range sset.Spec.Template.Spec.Volumes {
+		if vol.Name == "thanos-store-main-data" {
+			require.NotNil(t, vol.EmptyDir)
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	svc := makeBucketService(c, Config{})
+	require.Len(t, svc.Spec.Ports, 2)
+	require.Equal(t, "grpc", svc.Spec.Ports[1].Name)
+}
+
+func TestMakeBucketStatefulSetWithoutObjectStorage(t *testing.T) {
+	tc := &monitoringv1alpha1.ThanosCompactor{
+		ObjectMeta: metav1.ObjectMeta{Name: "main"},
+	}
+
+	_, err := makeBucketStatefulSet(makeCompactorComponent(tc), Config{}, "", nil)
+	require.Error(t, err)
+}
+
+func TestMakeBucketStatefulSetAdditionalArgsConflict(t *testing.T) {
+	tc := &monitoringv1alpha1.ThanosCompactor{
+		ObjectMeta: metav1.ObjectMeta{Name: "main"},
+		Spec: monitoringv1alpha1.ThanosCompactorSpec{
+			CommonThanosBucketFields: monitoringv1alpha1.CommonThanosBucketFields{
+				ObjectStorageConfigFile: ptr.To("/etc/thanos/objstore.yaml"),
+				AdditionalArgs:          []monitoringv1.Argument{{Name: "wait"}},
+			},
+		},
+	}
+
+	_, err := makeBucketStatefulSet(makeCompactorComponent(tc), Config{}, "", nil)
+	require.Error(t, err)
+}
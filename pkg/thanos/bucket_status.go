@@ -0,0 +1,325 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+// BucketStatus is the state of the object storage bucket as seen by a Thanos
+// compactor or store gateway pod.
+type BucketStatus struct {
+	// Syncs is the number of block metadata synchronizations.
+	Syncs float64
+	// SyncFailures is the number of failed block metadata synchronizations.
+	SyncFailures float64
+	// LoadedBlocks is the number of blocks loaded by the last successful
+	// synchronization.
+	LoadedBlocks float64
+	// Halted is true if the compactor has halted.
+	Halted bool
+}
+
+// BucketStatusClient retrieves the bucket status from a Thanos compactor or
+// store gateway pod.
+type BucketStatusClient interface {
+	BucketStatus(ctx context.Context, ep webconfig.Endpoint) (BucketStatus, error)
+}
+
+// HTTPBucketStatusClient implements BucketStatusClient by scraping the
+// metrics of the Thanos components.
+type HTTPBucketStatusClient struct{}
+
+// NewHTTPBucketStatusClient returns a BucketStatusClient querying the Thanos
+// components over HTTP.
+func NewHTTPBucketStatusClient() *HTTPBucketStatusClient {
+	return &HTTPBucketStatusClient{}
+}
+
+// BucketStatus implements the BucketStatusClient interface.
+func (c *HTTPBucketStatusClient) BucketStatus(ctx context.Context, ep webconfig.Endpoint) (BucketStatus, error) {
+	u := ep.URL
+	u.Path = "/metrics"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return BucketStatus{}, err
+	}
+
+	resp, err := ep.Client.Do(req)
+	if err != nil {
+		return BucketStatus{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return BucketStatus{}, fmt.Errorf("GET %s: unexpected status code %d", u.String(), resp.StatusCode)
+	}
+
+	return parseBucketStatus(resp.Body)
+}
+
+// parseBucketStatus extracts the bucket status from the metrics of a Thanos
+// component.
+func parseBucketStatus(r io.Reader) (BucketStatus, error) {
+	parser := expfmt.NewTextParser(model.LegacyValidation)
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return BucketStatus{}, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+
+	counter := func(name string) (float64, bool) {
+		mf, found := families[name]
+		if !found || len(mf.GetMetric()) == 0 || mf.GetMetric()[0].GetCounter() == nil {
+			return 0, false
+		}
+		return mf.GetMetric()[0].GetCounter().GetValue(), true
+	}
+
+	var (
+		st    BucketStatus
+		found bool
+	)
+	st.Syncs, found = counter("thanos_blocks_meta_syncs_total")
+	if !found {
+		return BucketStatus{}, fmt.Errorf("metric %q not found", "thanos_blocks_meta_syncs_total")
+	}
+	st.SyncFailures, _ = counter("thanos_blocks_meta_sync_failures_total")
+
+	if mf, found := families["thanos_blocks_meta_synced"]; found {
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "state" && l.GetValue() == "loaded" {
+					st.LoadedBlocks = m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+
+	if mf, found := families["thanos_compact_halted"]; found && len(mf.GetMetric()) > 0 {
+		st.Halted = mf.GetMetric()[0].GetGauge().GetValue() == 1
+	}
+
+	return st, nil
+}
+
+// podBucketState is the bucket state of a pod derived from successive
+// observations.
+type podBucketState struct {
+	// syncs and syncFailures are the counter values at the last observation
+	// where new synchronizations happened.
+	syncs        float64
+	syncFailures float64
+	// synced is true once at least one synchronization has been observed.
+	synced bool
+	// failing is true if all the synchronizations which happened between
+	// the last 2 observations failed.
+	failing bool
+
+	loadedBlocks float64
+	halted       bool
+}
+
+// update returns the new state of the pod given the current bucket status.
+func (prev podBucketState) update(st BucketStatus) podBucketState {
+	// Counters start from zero when the process restarts.
+	if st.Syncs < prev.syncs || st.SyncFailures < prev.syncFailures {
+		prev = podBucketState{}
+	}
+
+	next := prev
+	next.loadedBlocks = st.LoadedBlocks
+	next.halted = st.Halted
+
+	syncs, failures := st.Syncs-prev.syncs, st.SyncFailures-prev.syncFailures
+	if syncs == 0 {
+		// No synchronization since the last observation.
+		return next
+	}
+
+	next.syncs, next.syncFailures = st.Syncs, st.SyncFailures
+	next.synced = true
+	next.failing = failures >= syncs
+
+	return next
+}
+
+// bucketTracker keeps the last bucket state observed for the pods of each
+// ThanosCompactor and ThanosStore object.
+type bucketTracker struct {
+	mtx    sync.Mutex
+	states map[string]map[string]podBucketState
+}
+
+func newBucketTracker() *bucketTracker {
+	return &bucketTracker{
+		states: map[string]map[string]podBucketState{},
+	}
+}
+
+// Update records the bucket status of the pods for the given
+// `<namespace>/<name>` key and returns the resulting states. The pods which
+// aren't part of the status anymore are forgotten.
+func (t *bucketTracker) Update(key string, statuses map[string]BucketStatus) map[string]podBucketState {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	prev := t.states[key]
+	states := make(map[string]podBucketState, len(statuses))
+	for pod, st := range statuses {
+		states[pod] = prev[pod].update(st)
+	}
+	t.states[key] = states
+
+	return states
+}
+
+// Delete removes the bucket states for the given `<namespace>/<name>` key.
+func (t *bucketTracker) Delete(key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.states, key)
+}
+
+// collectBucketStatus retrieves the bucket status from the ready pods of the
+// statefulset. Pods which can't be queried are omitted.
+func collectBucketStatus(ctx context.Context, logger *slog.Logger, client BucketStatusClient, pods []*operator.Pod, endpoint func(*v1.Pod) (webconfig.Endpoint, error)) map[string]BucketStatus {
+	statuses := map[string]BucketStatus{}
+	for _, p := range pods {
+		pod := (*v1.Pod)(p)
+		if pod.Status.PodIP == "" {
+			continue
+		}
+
+		st, err := func() (BucketStatus, error) {
+			ep, err := endpoint(pod)
+			if err != nil {
+				return BucketStatus{}, fmt.Errorf("failed to create the HTTP client: %w", err)
+			}
+			defer ep.Client.CloseIdleConnections()
+
+			return client.BucketStatus(ctx, ep)
+		}()
+		if err != nil {
+			logger.Warn("failed to retrieve the bucket status", "err", err, "pod", pod.Name, "namespace", pod.Namespace)
+			continue
+		}
+
+		statuses[pod.Name] = st
+	}
+
+	return statuses
+}
+
+// bucketSyncedCondition returns the BucketSynced condition from the pod
+// states.
+func bucketSyncedCondition(states map[string]podBucketState, generation int64) monitoringv1.Condition {
+	condition := monitoringv1.Condition{
+		Type:   monitoringv1alpha1.BucketSynced,
+		Status: monitoringv1.ConditionTrue,
+		LastTransitionTime: metav1.Time{
+			Time: time.Now().UTC(),
+		},
+		ObservedGeneration: generation,
+	}
+
+	if len(states) == 0 {
+		condition.Status = monitoringv1.ConditionUnknown
+		condition.Reason = "NoPodObserved"
+		condition.Message = "the bucket status couldn't be retrieved from any pod"
+		return condition
+	}
+
+	var (
+		failing, pending []string
+		loaded           float64
+	)
+	for _, pod := range sortutil.SortedKeys(states) {
+		st := states[pod]
+		switch {
+		case !st.synced:
+			pending = append(pending, pod)
+		case st.failing:
+			failing = append(failing, pod)
+		default:
+			loaded = max(loaded, st.loadedBlocks)
+		}
+	}
+
+	switch {
+	case len(failing) > 0:
+		condition.Status = monitoringv1.ConditionFalse
+		condition.Reason = "SyncFailed"
+		condition.Message = fmt.Sprintf("the synchronization of the block metadata is failing for pods: %s", strings.Join(failing, ", "))
+	case len(pending) > 0:
+		condition.Status = monitoringv1.ConditionUnknown
+		condition.Reason = "SyncPending"
+		condition.Message = fmt.Sprintf("no synchronization of the block metadata observed yet for pods: %s", strings.Join(pending, ", "))
+	default:
+		condition.Message = fmt.Sprintf("%d blocks loaded", int64(loaded))
+	}
+
+	return condition
+}
+
+// compactionHealthyCondition returns the CompactionHealthy condition from the
+// pod states.
+func compactionHealthyCondition(states map[string]podBucketState, generation int64) monitoringv1.Condition {
+	condition := monitoringv1.Condition{
+		Type:   monitoringv1alpha1.CompactionHealthy,
+		Status: monitoringv1.ConditionTrue,
+		LastTransitionTime: metav1.Time{
+			Time: time.Now().UTC(),
+		},
+		ObservedGeneration: generation,
+	}
+
+	if len(states) == 0 {
+		condition.Status = monitoringv1.ConditionUnknown
+		condition.Reason = "NoPodObserved"
+		condition.Message = "the compactor status couldn't be retrieved from any pod"
+		return condition
+	}
+
+	for _, pod := range sortutil.SortedKeys(states) {
+		if states[pod].halted {
+			condition.Status = monitoringv1.ConditionFalse
+			condition.Reason = "Halted"
+			condition.Message = fmt.Sprintf("pod %s: the compactor has halted because of a critical error, check the logs for details", pod)
+			break
+		}
+	}
+
+	return condition
+}
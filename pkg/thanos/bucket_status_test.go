@@ -0,0 +1,378 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+func TestParseBucketStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		metrics  string
+		expected BucketStatus
+		err      bool
+	}{
+		{
+			name: "store",
+			metrics: `# TYPE thanos_blocks_meta_syncs_total counter
+thanos_blocks_meta_syncs_total 12
+# TYPE thanos_blocks_meta_sync_failures_total counter
+thanos_blocks_meta_sync_failures_total 2
+# TYPE thanos_blocks_meta_synced gauge
+thanos_blocks_meta_synced{state="loaded"} 42
+thanos_blocks_meta_synced{state="failed"} 1
+`,
+			expected: BucketStatus{Syncs: 12, SyncFailures: 2, LoadedBlocks: 42},
+		},
+		{
+			name: "halted compactor",
+			metrics: `# TYPE thanos_blocks_meta_syncs_total counter
+thanos_blocks_meta_syncs_total 3
+# TYPE thanos_compact_halted gauge
+thanos_compact_halted 1
+`,
+			expected: BucketStatus{Syncs: 3, Halted: true},
+		},
+		{
+			name: "missing sync metric",
+			metrics: `# TYPE thanos_compact_halted gauge
+thanos_compact_halted 0
+`,
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			st, err := parseBucketStatus(strings.NewReader(tc.metrics))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, st)
+		})
+	}
+}
+
+func TestBucketTracker(t *testing.T) {
+	tracker := newBucketTracker()
+
+	// First observation: pod-1 has synchronized, pod-0 hasn't yet.
+	states := tracker.Update("ns/main", map[string]BucketStatus{
+		"pod-0": {},
+		"pod-1": {Syncs: 2, LoadedBlocks: 10},
+	})
+	require.False(t, states["pod-0"].synced)
+	require.True(t, states["pod-1"].synced)
+	require.False(t, states["pod-1"].failing)
+
+	cond := bucketSyncedCondition(states, 1)
+	require.Equal(t, monitoringv1.ConditionUnknown, cond.Status)
+	require.Equal(t, "SyncPending", cond.Reason)
+
+	// All the synchronizations of pod-1 failed since the last observation.
+	states = tracker.Update("ns/main", map[string]BucketStatus{
+		"pod-0": {Syncs: 1, LoadedBlocks: 10},
+		"pod-1": {Syncs: 4, SyncFailures: 2, LoadedBlocks: 10},
+	})
+	require.True(t, states["pod-1"].failing)
+
+	cond = bucketSyncedCondition(states, 1)
+	require.Equal(t, monitoringv1.ConditionFalse, cond.Status)
+	require.Equal(t, "SyncFailed", cond.Reason)
+	require.Contains(t, cond.Message, "pod-1")
+
+	// No new synchronization: the previous state is kept.
+	states = tracker.Update("ns/main", map[string]BucketStatus{
+		"pod-0": {Syncs: 1, LoadedBlocks: 10},
+		"pod-1": {Syncs: 4, SyncFailures: 2, LoadedBlocks: 10},
+	})
+	require.True(t, states["pod-1"].failing)
+
+	// pod-1 has restarted and synchronized successfully.
+	states = tracker.Update("ns/main", map[string]BucketStatus{
+		"pod-0": {Syncs: 2, LoadedBlocks: 12},
+		"pod-1": {Syncs: 1, LoadedBlocks: 12},
+	})
+	require.False(t, states["pod-1"].failing)
+
+	cond = bucketSyncedCondition(states, 1)
+	require.Equal(t, monitoringv1.ConditionTrue, cond.Status)
+	require.Equal(t, "12 blocks loaded", cond.Message)
+
+	// Pods which aren't observed anymore are forgotten.
+	states = tracker.Update("ns/main", map[string]BucketStatus{
+		"pod-0": {Syncs: 3, LoadedBlocks: 12},
+	})
+	require.Len(t, states, 1)
+
+	tracker.Delete("ns/main")
+	states = tracker.Update("ns/main", map[string]BucketStatus{})
+	cond = bucketSyncedCondition(states, 1)
+	require.Equal(t, monitoringv1.ConditionUnknown, cond.Status)
+	require.Equal(t, "NoPodObserved", cond.Reason)
+}
+
+func TestCompactionHealthyCondition(t *testing.T) {
+	cond := compactionHealthyCondition(map[string]podBucketState{}, 2)
+	require.Equal(t, monitoringv1.ConditionUnknown, cond.Status)
+
+	cond = compactionHealthyCondition(map[string]podBucketState{"pod-0": {synced: true}}, 2)
+	require.Equal(t, monitoringv1.ConditionTrue, cond.Status)
+	require.Equal(t, int64(2), cond.ObservedGeneration)
+
+	cond = compactionHealthyCondition(map[string]podBucketState{"pod-0": {synced: true, halted: true}}, 2)
+	require.Equal(t, monitoringv1.ConditionFalse, cond.Status)
+	require.Equal(t, "Halted", cond.Reason)
+}
+
+// fakeCompactorStatusReconciler updates the conditions of a ThanosCompactor
+// from the bucket status of its single pod.
+type fakeCompactorStatusReconciler struct {
+	mtx     sync.Mutex
+	tc      *monitoringv1alpha1.ThanosCompactor
+	status  BucketStatus
+	tracker *bucketTracker
+
+	refreshed chan monitoringv1.Condition
+}
+
+func (r *fakeCompactorStatusReconciler) setStatus(st BucketStatus) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.status = st
+}
+
+func (r *fakeCompactorStatusReconciler) Iterate(processFn func(metav1.Object, []monitoringv1.Condition)) {
+	r.mtx.Lock()
+	tc := r.tc.DeepCopy()
+	r.mtx.Unlock()
+
+	processFn(tc, tc.Status.Conditions)
+}
+
+func (r *fakeCompactorStatusReconciler) RefreshStatusFor(metav1.Object) {
+	r.mtx.Lock()
+	states := r.tracker.Update("ns/test", map[string]BucketStatus{"thanos-compactor-test-0": r.status})
+	cond := compactionHealthyCondition(states, r.tc.Generation)
+	r.tc.Status.Conditions = []monitoringv1.Condition{
+		{Type: monitoringv1.Available, Status: monitoringv1.ConditionTrue},
+		cond,
+	}
+	r.mtx.Unlock()
+
+	select {
+	case r.refreshed <- cond:
+	default:
+	}
+}
+
+// TestBucketStatusPollerAfterAvailable verifies that the bucket conditions of
+// an object which is already available are refreshed.
+func TestBucketStatusPollerAfterAvailable(t *testing.T) {
+	r := &fakeCompactorStatusReconciler{
+		tc: &monitoringv1alpha1.ThanosCompactor{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns", Generation: 1},
+			Status: monitoringv1alpha1.ThanosBucketStatus{
+				Conditions: []monitoringv1.Condition{
+					{Type: monitoringv1.Available, Status: monitoringv1.ConditionTrue},
+				},
+			},
+		},
+		status:    BucketStatus{Syncs: 1},
+		tracker:   newBucketTracker(),
+		refreshed: make(chan monitoringv1.Condition, 1),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go bucketStatusPoller(ctx, r, 10*time.Millisecond)
+
+	waitFor := func(status monitoringv1.ConditionStatus) monitoringv1.Condition {
+		t.Helper()
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case cond := <-r.refreshed:
+				if cond.Status == status {
+					return cond
+				}
+			case <-timeout:
+				t.Fatalf("timeout waiting for the CompactionHealthy condition to be %s", status)
+			}
+		}
+	}
+
+	waitFor(monitoringv1.ConditionTrue)
+
+	// The compactor halts while the object is available.
+	r.setStatus(BucketStatus{Syncs: 2, Halted: true})
+
+	cond := waitFor(monitoringv1.ConditionFalse)
+	require.Equal(t, "Halted", cond.Reason)
+}
+
+type fakeBucketStatusClient map[string]BucketStatus
+
+func (c fakeBucketStatusClient) BucketStatus(_ context.Context, ep webconfig.Endpoint) (BucketStatus, error) {
+	st, found := c[ep.URL.Host]
+	if !found {
+		return BucketStatus{}, fmt.Errorf("connection refused")
+	}
+
+	return st, nil
+}
+
+func TestCollectBucketStatus(t *testing.T) {
+	newPod := func(name, ip string) *operator.Pod {
+		return &operator.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     v1.PodStatus{PodIP: ip},
+		}
+	}
+
+	client := fakeBucketStatusClient{
+		"10.0.0.1:10902": {Syncs: 1},
+		"10.0.0.3:10902": {Syncs: 1},
+	}
+
+	statuses := collectBucketStatus(
+		context.Background(),
+		slog.New(slog.DiscardHandler),
+		client,
+		[]*operator.Pod{
+			newPod("pod-0", "10.0.0.1"),
+			newPod("pod-1", "10.0.0.2"),
+			newPod("pod-2", ""),
+			newPod("pod-3", "10.0.0.3"),
+		},
+		func(pod *v1.Pod) (webconfig.Endpoint, error) {
+			if pod.Name == "pod-3" {
+				return webconfig.Endpoint{}, fmt.Errorf("secret not found")
+			}
+
+			return webconfig.Endpoint{
+				URL:    url.URL{Scheme: "http", Host: pod.Status.PodIP + ":10902"},
+				Client: &http.Client{},
+			}, nil
+		},
+	)
+
+	require.Equal(t, map[string]BucketStatus{"pod-0": {Syncs: 1}}, statuses)
+}
+
+func TestBucketStatusEndpoint(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "thanos-compactor-test-0", Namespace: "ns"},
+		Spec:       v1.PodSpec{Hostname: "thanos-compactor-test-0", Subdomain: "thanos-compactor-test"},
+		Status:     v1.PodStatus{PodIP: "10.0.0.1"},
+	}
+
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic-auth", Namespace: "ns"},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("secret"),
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		web    *monitoringv1alpha1.ThanosBucketWebSpec
+		scheme string
+		err    bool
+	}{
+		{
+			name:   "no web configuration",
+			scheme: "http",
+		},
+		{
+			name: "basic auth",
+			web: &monitoringv1alpha1.ThanosBucketWebSpec{
+				OperatorClientConfig: &monitoringv1.WebClientConfig{
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "basic-auth"}, Key: "username"},
+						Password: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "basic-auth"}, Key: "password"},
+					},
+				},
+			},
+			scheme: "http",
+		},
+		{
+			name: "missing basic auth secret",
+			web: &monitoringv1alpha1.ThanosBucketWebSpec{
+				OperatorClientConfig: &monitoringv1.WebClientConfig{
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "missing"}, Key: "username"},
+						Password: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "missing"}, Key: "password"},
+					},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "certificate file without CA",
+			web: &monitoringv1alpha1.ThanosBucketWebSpec{
+				WebConfigFileFields: monitoringv1.WebConfigFileFields{
+					TLSConfig: &monitoringv1.WebTLSConfig{
+						CertFile: ptr.To("/etc/tls/tls.crt"),
+						KeyFile:  ptr.To("/etc/tls/tls.key"),
+					},
+				},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &bucketOperator{kclient: fake.NewSimpleClientset(secret)}
+			c := &bucketComponent{
+				owner: &monitoringv1alpha1.ThanosCompactor{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+				},
+				common: &monitoringv1alpha1.CommonThanosBucketFields{Web: tc.web},
+			}
+
+			ep, err := o.bucketStatusEndpoint(context.Background(), c, pod)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, url.URL{Scheme: tc.scheme, Host: "10.0.0.1:10902"}, ep.URL)
+			require.NotNil(t, ep.Client)
+		})
+	}
+}
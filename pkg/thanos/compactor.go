@@ -0,0 +1,261 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const compactorControllerName = "thanos-compactor-controller"
+
+// CompactorOperator manages the life cycle of Thanos Compactor statefulsets.
+type CompactorOperator struct {
+	*bucketOperator
+
+	compactorInfs *informers.ForResource
+}
+
+// NewCompactorOperator creates a new controller for ThanosCompactor objects.
+func NewCompactorOperator(restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...BucketControllerOption) (*CompactorOperator, error) {
+	logger = logger.With("component", compactorControllerName)
+
+	// All the metrics exposed by the controller get the controller="thanos-compactor" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "thanos-compactor"}, r)
+
+	bo, err := newBucketOperator(restConfig, c, logger, r, compactorApplicationNameLabelValue, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &CompactorOperator{bucketOperator: bo}
+
+	o.compactorInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ThanosCompactorName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanoscompactor informers: %w", err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.compactorInfs,
+		o.metrics,
+		monitoringv1alpha1.ThanosCompactorKind,
+		r,
+		o.controllerID,
+	)
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *CompactorOperator) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{"ThanosCompactor", o.compactorInfs},
+		{"StatefulSet", o.ssetInfs},
+	} {
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, "thanos-compactor", o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// Run the controller.
+func (o *CompactorOperator) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.compactorInfs.Start(ctx.Done())
+	go o.ssetInfs.Start(ctx.Done())
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	// Refresh the status of the existing ThanosCompactor objects.
+	_ = o.compactorInfs.ListAll(labels.Everything(), func(obj any) {
+		o.rr.EnqueueForStatus(obj.(*monitoringv1alpha1.ThanosCompactor))
+	})
+
+	o.compactorInfs.AddEventHandler(o.rr)
+	o.ssetInfs.AddEventHandler(o.rr)
+
+	go bucketStatusPoller(ctx, o, bucketStatusRefreshInterval)
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// Iterate implements the operator.StatusReconciler interface.
+func (o *CompactorOperator) Iterate(processFn func(metav1.Object, []monitoringv1.Condition)) {
+	if err := o.compactorInfs.ListAll(labels.Everything(), func(o any) {
+		tc := o.(*monitoringv1alpha1.ThanosCompactor)
+		processFn(tc, tc.Status.Conditions)
+	}); err != nil {
+		o.logger.Error("failed to list ThanosCompactor objects", "err", err)
+	}
+}
+
+// RefreshStatusFor implements the operator.StatusReconciler interface.
+func (o *CompactorOperator) RefreshStatusFor(obj metav1.Object) {
+	o.rr.EnqueueForStatus(obj)
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *CompactorOperator) Sync(ctx context.Context, key string) error {
+	err := o.sync(ctx, key)
+	o.reconciliations.SetStatus(key, err)
+
+	return err
+}
+
+func (o *CompactorOperator) sync(ctx context.Context, key string) error {
+	tc, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosCompactor](o.compactorInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if tc == nil {
+		o.forget(key)
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(tc) {
+		return nil
+	}
+
+	if tc.Spec.Paused {
+		return nil
+	}
+
+	operator.SetSpanObject(ctx, tc)
+	o.logger.Info("sync thanos-compactor", "key", key)
+
+	if err := operator.CheckStorageClass(ctx, o.canReadStorageClass, o.kclient, tc.Spec.Storage); err != nil {
+		return err
+	}
+
+	// The compactor doesn't need any TLS asset.
+	return o.syncComponent(ctx, key, makeCompactorComponent(tc), nil)
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+func (o *CompactorOperator) UpdateStatus(ctx context.Context, key string) error {
+	tc, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosCompactor](o.compactorInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if tc == nil {
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(tc) {
+		return nil
+	}
+
+	conditions, ok, err := o.updateStatus(ctx, key, makeCompactorComponent(tc), tc, tc.Status.Conditions, true)
+	if err != nil || !ok {
+		return err
+	}
+
+	tc.Status.Conditions = conditions
+	tc.Status.Paused = tc.Spec.Paused
+
+	tcac := monitoringv1alpha1ac.ThanosCompactor(tc.Name, tc.Namespace).WithStatus(applyConfigurationFromBucketStatus(&tc.Status))
+	if _, err = o.mclient.MonitoringV1alpha1().ThanosCompactors(tc.Namespace).ApplyStatus(ctx, tcac, metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+// makeCompactorComponent returns the statefulset description of the
+// ThanosCompactor object.
+func makeCompactorComponent(tc *monitoringv1alpha1.ThanosCompactor) *bucketComponent {
+	args := []monitoringv1.Argument{
+		// Run the compactor as a service instead of exiting after one
+		// compaction iteration.
+		{Name: "wait"},
+	}
+
+	if rr := tc.Spec.RetentionResolution; rr != nil {
+		for _, r := range []struct {
+			name  string
+			value *monitoringv1.Duration
+		}{
+			{"retention.resolution-raw", rr.Raw},
+			{"retention.resolution-5m", rr.FiveMinutes},
+			{"retention.resolution-1h", rr.OneHour},
+		} {
+			if r.value != nil {
+				args = append(args, monitoringv1.Argument{Name: r.name, Value: string(*r.value)})
+			}
+		}
+	}
+
+	if tc.Spec.DisableDownsampling {
+		args = append(args, monitoringv1.Argument{Name: "downsampling.disable"})
+	}
+
+	for _, label := range tc.Spec.DeduplicationReplicaLabels {
+		args = append(args, monitoringv1.Argument{Name: "deduplication.replica-label", Value: label})
+	}
+
+	if tc.Spec.DeleteDelay != nil {
+		args = append(args, monitoringv1.Argument{Name: "delete-delay", Value: string(*tc.Spec.DeleteDelay)})
+	}
+
+	return &bucketComponent{
+		application: compactorApplicationNameLabelValue,
+		command:     "compact",
+		owner:       tc,
+		common:      &tc.Spec.CommonThanosBucketFields,
+		// Only one compactor may run against a bucket.
+		replicas: ptr.To(int32(1)),
+		args:     args,
+	}
+}
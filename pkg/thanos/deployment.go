@@ -0,0 +1,47 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// makeDeployment returns the deployment running the given shard of a
+// ThanosRuler object in stateless mode.
+//
+// The pod template is identical to the one of the statefulset except that the
+// data directory is never backed by a persistent volume claim.
+func makeDeployment(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, inputHash string, shard int32, tlsSecrets *operator.ShardedSecret) (*appsv1.Deployment, error) {
+	sset, err := makeStatefulSet(tr, config, ruleConfigMapNames, inputHash, shard, tlsSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: sset.ObjectMeta,
+		Spec: appsv1.DeploymentSpec{
+			Replicas:        sset.Spec.Replicas,
+			MinReadySeconds: sset.Spec.MinReadySeconds,
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+			},
+			Selector: sset.Spec.Selector,
+			Template: sset.Spec.Template,
+		},
+	}, nil
+}
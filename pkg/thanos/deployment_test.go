@@ -0,0 +1,241 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestMakeDeployment(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+			Replicas:       ptr.To(int32(3)),
+			Shards:         ptr.To(int32(2)),
+			RemoteWrite: []monitoringv1.RemoteWriteSpec{
+				{URL: "http://example.com/api/v1/write"},
+			},
+			ObjectStorageConfig: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "objstore"},
+				Key:                  "thanos.yaml",
+			},
+			Storage: &monitoringv1.StorageSpec{
+				VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+					EmbeddedObjectMetadata: monitoringv1.EmbeddedObjectMetadata{Name: "custom"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		shard int32
+		name  string
+	}{
+		{shard: 0, name: "thanos-ruler-test"},
+		{shard: 1, name: "thanos-ruler-test-shard-1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			deploy, err := makeDeployment(tr, defaultTestConfig, []string{"rules-0"}, "abc", tc.shard, &operator.ShardedSecret{})
+			require.NoError(t, err)
+
+			require.Equal(t, tc.name, deploy.Name)
+			require.Equal(t, "abc", deploy.Annotations[operator.InputHashAnnotationKey])
+			require.Equal(t, int32(3), *deploy.Spec.Replicas)
+			require.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, deploy.Spec.Strategy.Type)
+
+			// The selector always includes the shard label.
+			selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+			require.NoError(t, err)
+			require.True(t, selector.Matches(labels.Set(deploy.Spec.Template.Labels)))
+			require.Equal(t, deploy.Spec.Template.Labels["operator.prometheus.io/shard"], deploy.Spec.Selector.MatchLabels["operator.prometheus.io/shard"])
+
+			// Each replica has its own replica label.
+			container := deploy.Spec.Template.Spec.Containers[0]
+			require.Contains(t, container.Args, `--label=thanos_ruler_replica="$(POD_NAME)"`)
+
+			// The object storage isn't configured.
+			for _, arg := range container.Args {
+				require.False(t, strings.HasPrefix(arg, "--objstore.config-file"), arg)
+			}
+			require.Contains(t, container.Args, "--remote-write.config-file=/etc/thanos/config/remote-write-config/remote-write.yaml")
+
+			// The data directory is an emptyDir volume.
+			var found bool
+			for _, vol := range deploy.Spec.Template.Spec.Volumes {
+				if vol.Name == "thanos-ruler-test-data" {
+					require.NotNil(t, vol.EmptyDir)
+					found = true
+				}
+			}
+			require.True(t, found)
+			require.Contains(t, container.VolumeMounts, v1.VolumeMount{
+				Name:      "thanos-ruler-test-data",
+				MountPath: storageDir,
+			})
+		})
+	}
+}
+
+// newTestOperator returns an operator with started informers for the
+// statefulsets and deployments of the fake client.
+func newTestOperator(t *testing.T, kclient *fake.Clientset) *Operator {
+	t.Helper()
+
+	factory := informers.NewKubeInformerFactories(map[string]struct{}{v1.NamespaceAll: {}}, nil, kclient, 0, nil)
+	ssetInfs, err := informers.NewInformersForResource(factory, appsv1.SchemeGroupVersion.WithResource("statefulsets"))
+	require.NoError(t, err)
+	deployInfs, err := informers.NewInformersForResource(factory, appsv1.SchemeGroupVersion.WithResource("deployments"))
+	require.NoError(t, err)
+
+	o := &Operator{
+		kclient:    kclient,
+		logger:     promslog.NewNopLogger(),
+		config:     defaultTestConfig,
+		ssetInfs:   ssetInfs,
+		deployInfs: deployInfs,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	o.ssetInfs.Start(ctx.Done())
+	o.deployInfs.Start(ctx.Done())
+	require.Eventually(t, func() bool {
+		return o.ssetInfs.HasSynced() && o.deployInfs.HasSynced()
+	}, time.Minute, 10*time.Millisecond)
+
+	return o
+}
+
+func TestDeleteStatefulSetOnceDeploymentIsAvailable(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+			RemoteWrite: []monitoringv1.RemoteWriteSpec{
+				{URL: "http://example.com/api/v1/write"},
+			},
+		},
+	}
+
+	kclient := fake.NewClientset(
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "thanos-ruler-test",
+				Namespace: "ns",
+				Labels:    map[string]string{applicationNameLabelValue: "test"},
+			},
+		},
+	)
+	o := newTestOperator(t, kclient)
+	ctx := context.Background()
+
+	// The deployment is created while the statefulset of the stateful mode
+	// still exists.
+	require.NoError(t, o.createOrUpdateDeployment(ctx, tr, "ns/test", 0, []string{"rules-0"}, &operator.ShardedSecret{}))
+	deploy, err := kclient.AppsV1().Deployments("ns").Get(ctx, "thanos-ruler-test", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		d, err := o.getDeploymentFromThanosRulerKey("ns/test", 0)
+		return err == nil && d != nil
+	}, time.Minute, 10*time.Millisecond)
+
+	// The statefulset isn't deleted until the deployment is available.
+	expected := []string{"thanos-ruler-test"}
+	require.NoError(t, o.deleteUnexpectedStatefulSets(ctx, tr, nil, expected))
+	_, err = kclient.AppsV1().StatefulSets("ns").Get(ctx, "thanos-ruler-test", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	deploy.Status = appsv1.DeploymentStatus{
+		UpdatedReplicas:   1,
+		AvailableReplicas: 1,
+	}
+	_, err = kclient.AppsV1().Deployments("ns").UpdateStatus(ctx, deploy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return o.deploymentAvailable("ns", "thanos-ruler-test")
+	}, time.Minute, 10*time.Millisecond)
+
+	require.NoError(t, o.deleteUnexpectedStatefulSets(ctx, tr, nil, expected))
+	_, err = kclient.AppsV1().StatefulSets("ns").Get(ctx, "thanos-ruler-test", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestRecreateDeploymentOnSelectorChange(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+			RemoteWrite: []monitoringv1.RemoteWriteSpec{
+				{URL: "http://example.com/api/v1/write"},
+			},
+		},
+	}
+
+	kclient := fake.NewClientset()
+	o := newTestOperator(t, kclient)
+	ctx := context.Background()
+
+	require.NoError(t, o.createOrUpdateDeployment(ctx, tr, "ns/test", 0, []string{"rules-0"}, &operator.ShardedSecret{}))
+	require.Eventually(t, func() bool {
+		d, err := o.getDeploymentFromThanosRulerKey("ns/test", 0)
+		return err == nil && d != nil
+	}, time.Minute, 10*time.Millisecond)
+
+	// Changing the pod labels changes the (immutable) selector: the
+	// deployment is deleted instead of being updated.
+	tr.Spec.PodMetadata = &monitoringv1.EmbeddedObjectMetadata{
+		Labels: map[string]string{"team": "a"},
+	}
+	require.NoError(t, o.createOrUpdateDeployment(ctx, tr, "ns/test", 0, []string{"rules-0"}, &operator.ShardedSecret{}))
+	_, err := kclient.AppsV1().Deployments("ns").Get(ctx, "thanos-ruler-test", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+	require.Eventually(t, func() bool {
+		d, err := o.getDeploymentFromThanosRulerKey("ns/test", 0)
+		return err == nil && d == nil
+	}, time.Minute, 10*time.Millisecond)
+
+	// The deployment is recreated with the new selector.
+	require.NoError(t, o.createOrUpdateDeployment(ctx, tr, "ns/test", 0, []string{"rules-0"}, &operator.ShardedSecret{}))
+	deploy, err := kclient.AppsV1().Deployments("ns").Get(ctx, "thanos-ruler-test", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "a", deploy.Spec.Selector.MatchLabels["team"])
+}
@@ -16,21 +16,26 @@ package thanos
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"maps"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/mitchellh/hashstructure"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v2"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -74,6 +79,8 @@ type Operator struct {
 	cmapInfs        *informers.ForResource
 	ruleInfs        *informers.ForResource
 	ssetInfs        *informers.ForResource
+	deployInfs      *informers.ForResource
+	svcInfs         *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
@@ -83,6 +90,7 @@ type Operator struct {
 	metrics             *operator.Metrics
 	reconciliations     *operator.ReconciliationTracker
 	canReadStorageClass bool
+	clusterDomain       string
 
 	newEventRecorder operator.NewEventRecorderFunc
 
@@ -144,6 +152,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		newEventRecorder: c.EventRecorderFactory(client, controllerName),
 		reconciliations:  &operator.ReconciliationTracker{},
 		controllerID:     c.ControllerID,
+		clusterDomain:    c.ClusterDomain,
 		config: Config{
 			ReloaderConfig:         c.ReloaderConfig,
 			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
@@ -245,6 +254,37 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
 	}
 
+	o.deployInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = fmt.Sprintf("%s,%s=%s", operator.ManagedByOperatorLabelSelector(), operator.ApplicationNameLabelKey, applicationNameLabelValue)
+			},
+		),
+		appsv1.SchemeGroupVersion.WithResource("deployments"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating deployment informers: %w", err)
+	}
+
+	// The query Services can live in any namespace watched for rules.
+	o.svcInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.AllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			nil,
+		),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceServices)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating service informers: %w", err)
+	}
+
 	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
@@ -294,6 +334,8 @@ func (o *Operator) waitForCacheSync(ctx context.Context) error {
 		{"ConfigMap", o.cmapInfs},
 		{"PrometheusRule", o.ruleInfs},
 		{"StatefulSet", o.ssetInfs},
+		{"Deployment", o.deployInfs},
+		{"Service", o.svcInfs},
 	} {
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "thanos", o.logger.With("informer", infs.name), inf.Informer()) {
@@ -322,6 +364,7 @@ func (o *Operator) waitForCacheSync(ctx context.Context) error {
 func (o *Operator) addHandlers() {
 	o.thanosRulerInfs.AddEventHandler(o.rr)
 	o.ssetInfs.AddEventHandler(o.rr)
+	o.deployInfs.AddEventHandler(o.rr)
 
 	o.cmapInfs.AddEventHandler(operator.NewEventHandler(
 		o.logger,
@@ -346,6 +389,15 @@ func (o *Operator) addHandlers() {
 		),
 	))
 
+	o.svcInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		"Service",
+		o.enqueueForServiceNamespace,
+		operator.WithFilter(operator.ResourceVersionChanged),
+	))
+
 	// The controller needs to watch the namespaces in which the rules live
 	// because a label change on a namespace may trigger a configuration
 	// change.
@@ -369,6 +421,8 @@ func (o *Operator) Run(ctx context.Context) error {
 		go o.nsThanosRulerInf.Run(ctx.Done())
 	}
 	go o.ssetInfs.Start(ctx.Done())
+	go o.deployInfs.Start(ctx.Done())
+	go o.svcInfs.Start(ctx.Done())
 	if err := o.waitForCacheSync(ctx); err != nil {
 		return err
 	}
@@ -403,9 +457,9 @@ func (o *Operator) RefreshStatusFor(obj metav1.Object) {
 	o.rr.EnqueueForStatus(obj)
 }
 
-func thanosKeyToStatefulSetKey(key string) string {
+func thanosKeyToStatefulSetKey(key string, shard int32) string {
 	keyParts := strings.Split(key, "/")
-	return keyParts[0] + "/thanos-ruler-" + keyParts[1]
+	return keyParts[0] + "/" + statefulSetNameByShard(keyParts[1], shard)
 }
 
 func (o *Operator) handleNamespaceUpdate(oldo, curo any) {
@@ -439,6 +493,25 @@ func (o *Operator) handleNamespaceUpdate(oldo, curo any) {
 
 		if sync {
 			o.rr.EnqueueForReconciliation(tr)
+			return
+		}
+
+		// Check for ThanosRuler instances discovering query Services in the namespace.
+		if qd := tr.Spec.QueryDiscovery; qd != nil && qd.ServiceSelector != nil && qd.ServiceNamespaceSelector != nil {
+			sync, err = k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, qd.ServiceNamespaceSelector)
+			if err != nil {
+				o.logger.Error(
+					"failed to detect label selection change",
+					"err", err,
+					"name", tr.Name,
+					"namespace", tr.Namespace,
+				)
+				return
+			}
+
+			if sync {
+				o.rr.EnqueueForReconciliation(tr)
+			}
 		}
 	})
 	if err != nil {
@@ -478,25 +551,33 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
+	operator.SetSpanObject(ctx, tr)
 	logger := o.logger.With("key", key)
 	logger.Info("sync thanos-ruler")
 
-	if err := operator.CheckStorageClass(ctx, o.canReadStorageClass, o.kclient, tr.Spec.Storage); err != nil {
+	if err := operator.CheckStorageClass(ctx, o.canReadStorageClass, o.kclient, dataStorage(tr)); err != nil {
 		return err
 	}
 
-	ruleConfigMapNames, err := o.createOrUpdateRuleConfigMaps(ctx, tr)
+	spanCtx, span := operator.StartSpan(ctx, "select-rules")
+	ruleConfigMapNames, err := o.createOrUpdateRuleConfigMaps(spanCtx, tr)
+	operator.EndSpan(span, err)
 	if err != nil {
 		return err
 	}
 
 	assetStore := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
 
-	if err := o.createOrUpdateRulerConfigSecret(ctx, assetStore, tr); err != nil {
+	spanCtx, span = operator.StartSpan(ctx, "apply-config-secret")
+	err = o.createOrUpdateRulerConfigSecret(spanCtx, assetStore, tr)
+	operator.EndSpan(span, err)
+	if err != nil {
 		return fmt.Errorf("failed to synchronize ruler config secret: %w", err)
 	}
 
-	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), o.kclient, newTLSAssetSecret(tr, o.config))
+	spanCtx, span = operator.StartSpan(ctx, "apply-tls-assets")
+	tlsAssets, err := operator.ReconcileShardedSecret(spanCtx, assetStore.TLSAssets(), o.kclient, newTLSAssetSecret(tr, o.config))
+	operator.EndSpan(span, err)
 	if err != nil {
 		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
@@ -518,82 +599,353 @@ func (o *Operator) sync(ctx context.Context, key string) error {
 		}
 	}
 
-	// Ensure we have a StatefulSet running Thanos deployed.
-	existingStatefulSet, err := o.getStatefulSetFromThanosRulerKey(key)
+	ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
+
+	// In stateless mode, the shards run as Deployments instead of
+	// StatefulSets.
+	var expectedStatefulSets, expectedDeployments []string
+	if isStateless(tr) {
+		expectedDeployments = expectedStatefulSetShardNames(tr)
+	} else {
+		expectedStatefulSets = expectedStatefulSetShardNames(tr)
+	}
+
+	for shard := range expectedDeployments {
+		if err := o.createOrUpdateDeployment(ctx, tr, key, int32(shard), ruleConfigMapNames[shard], tlsAssets); err != nil {
+			return err
+		}
+	}
+
+	// Ensure we have a StatefulSet running Thanos deployed for each shard.
+	for shard, ssetName := range expectedStatefulSets {
+		logger := logger.With("statefulset", ssetName, "shard", fmt.Sprintf("%d", shard))
+
+		existingStatefulSet, err := o.getStatefulSetFromThanosRulerKey(key, int32(shard))
+		if err != nil {
+			return err
+		}
+
+		if existingStatefulSet == nil {
+			// When switching back from the stateless mode, the statefulset is
+			// created while the deployment of the shard keeps evaluating the
+			// rules. The deployment is deleted once the statefulset is
+			// available.
+			sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames[shard], "", int32(shard), tlsAssets)
+			if err != nil {
+				return fmt.Errorf("making thanos statefulset config failed: %w", err)
+			}
+
+			operator.SanitizeSTS(sset)
+			spanCtx, span := operator.StartSpan(ctx, "create-statefulset", attribute.String("statefulset", sset.Name))
+			_, err = ssetClient.Create(spanCtx, sset, metav1.CreateOptions{})
+			operator.EndSpan(span, err)
+			if err != nil {
+				return fmt.Errorf("creating thanos statefulset failed: %w", err)
+			}
+
+			continue
+		}
+
+		if o.rr.DeletionInProgress(existingStatefulSet) {
+			continue
+		}
+
+		newSSetInputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames[shard], existingStatefulSet.Spec)
+		if err != nil {
+			return err
+		}
+
+		sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames[shard], newSSetInputHash, int32(shard), tlsAssets)
+		if err != nil {
+			return fmt.Errorf("failed to generate statefulset: %w", err)
+		}
+
+		operator.SanitizeSTS(sset)
+
+		// The selector of a statefulset is immutable.
+		if !reflect.DeepEqual(existingStatefulSet.Spec.Selector, sset.Spec.Selector) {
+			o.metrics.StsDeleteCreateCounter().Inc()
+
+			if err := o.deleteStatefulSetWithOutdatedSelector(ctx, logger, existingStatefulSet, sset, int32(shard)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
+			logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+			continue
+		}
+
+		logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationKey])
+		spanCtx, span := operator.StartSpan(ctx, "update-statefulset", attribute.String("statefulset", sset.Name))
+		err = k8sutil.UpdateStatefulSet(spanCtx, ssetClient, sset)
+		operator.EndSpan(span, err)
+		sErr, ok := err.(*apierrors.StatusError)
+
+		if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
+			o.metrics.StsDeleteCreateCounter().Inc()
+
+			// Gather only reason for failed update
+			failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
+			for i, cause := range sErr.ErrStatus.Details.Causes {
+				failMsg[i] = cause.Message
+			}
+
+			logger.Info("recreating ThanosRuler StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+			propagationPolicy := metav1.DeletePropagationForeground
+			if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+				return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
+			}
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("updating StatefulSet failed: %w", err)
+		}
+	}
+
+	if err := o.deleteUnexpectedStatefulSets(ctx, tr, expectedStatefulSets, expectedDeployments); err != nil {
+		return err
+	}
+
+	return o.deleteUnexpectedDeployments(ctx, tr, expectedDeployments, expectedStatefulSets)
+}
+
+// deleteUnexpectedStatefulSets deletes the StatefulSets of the shards which
+// don't exist anymore (or all of them after switching to the stateless
+// mode).
+//
+// When the shard runs as a Deployment, the StatefulSet is only deleted once
+// the Deployment is available to avoid a gap in the rule evaluations.
+func (o *Operator) deleteUnexpectedStatefulSets(ctx context.Context, tr *monitoringv1.ThanosRuler, expectedStatefulSets, expectedDeployments []string) error {
+	ssets := map[string]struct{}{}
+	for _, ssetName := range expectedStatefulSets {
+		ssets[ssetName] = struct{}{}
+	}
+
+	deployments := map[string]struct{}{}
+	for _, deployName := range expectedDeployments {
+		deployments[deployName] = struct{}{}
+	}
+
+	ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
+	err := o.ssetInfs.ListAllByNamespace(tr.Namespace, labels.SelectorFromSet(labels.Set{applicationNameLabelValue: tr.Name}), func(obj any) {
+		s := obj.(*appsv1.StatefulSet)
+
+		if _, ok := ssets[s.Name]; ok {
+			return
+		}
+
+		if o.rr.DeletionInProgress(s) {
+			return
+		}
+
+		if _, ok := deployments[s.Name]; ok && !o.deploymentAvailable(tr.Namespace, s.Name) {
+			o.logger.Info("waiting for the Deployment to be available before deleting the StatefulSet", "name", s.GetName(), "namespace", s.GetNamespace())
+			return
+		}
+
+		if err := ssetClient.Delete(ctx, s.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil {
+			o.logger.Error("failed to delete StatefulSet object", "err", err, "name", s.GetName(), "namespace", s.GetNamespace())
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("listing StatefulSet resources failed: %w", err)
+	}
+
+	return nil
+}
+
+// deleteUnexpectedDeployments deletes the Deployments of the shards which
+// don't exist anymore (or all of them after switching back to the stateful
+// mode).
+//
+// When the shard runs as a StatefulSet, the Deployment is only deleted once
+// the StatefulSet is available to avoid a gap in the rule evaluations.
+func (o *Operator) deleteUnexpectedDeployments(ctx context.Context, tr *monitoringv1.ThanosRuler, expectedDeployments, expectedStatefulSets []string) error {
+	deployments := map[string]struct{}{}
+	for _, deployName := range expectedDeployments {
+		deployments[deployName] = struct{}{}
+	}
+
+	ssets := map[string]struct{}{}
+	for _, ssetName := range expectedStatefulSets {
+		ssets[ssetName] = struct{}{}
+	}
+
+	deployClient := o.kclient.AppsV1().Deployments(tr.Namespace)
+	err := o.deployInfs.ListAllByNamespace(tr.Namespace, labels.SelectorFromSet(labels.Set{applicationNameLabelValue: tr.Name}), func(obj any) {
+		d := obj.(*appsv1.Deployment)
+
+		if _, ok := deployments[d.Name]; ok {
+			return
+		}
+
+		if o.rr.DeletionInProgress(d) {
+			return
+		}
+
+		if _, ok := ssets[d.Name]; ok && !o.statefulSetAvailable(tr.Namespace, d.Name) {
+			o.logger.Info("waiting for the StatefulSet to be available before deleting the Deployment", "name", d.GetName(), "namespace", d.GetNamespace())
+			return
+		}
+
+		if err := deployClient.Delete(ctx, d.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil {
+			o.logger.Error("failed to delete Deployment object", "err", err, "name", d.GetName(), "namespace", d.GetNamespace())
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("listing Deployment resources failed: %w", err)
+	}
+
+	return nil
+}
+
+// deploymentAvailable returns true if all the replicas of the Deployment run
+// the latest revision and are available.
+func (o *Operator) deploymentAvailable(namespace, name string) bool {
+	obj, err := o.deployInfs.Get(namespace + "/" + name)
+	if err != nil {
+		return false
+	}
+
+	d := obj.(*appsv1.Deployment)
+	replicas := ptr.Deref(d.Spec.Replicas, 1)
+
+	return d.Status.ObservedGeneration >= d.Generation &&
+		d.Status.UpdatedReplicas == replicas &&
+		d.Status.AvailableReplicas >= replicas
+}
+
+// statefulSetAvailable returns true if all the replicas of the StatefulSet
+// run the latest revision and are available.
+func (o *Operator) statefulSetAvailable(namespace, name string) bool {
+	obj, err := o.ssetInfs.Get(namespace + "/" + name)
+	if err != nil {
+		return false
+	}
+
+	s := obj.(*appsv1.StatefulSet)
+	replicas := ptr.Deref(s.Spec.Replicas, 1)
+
+	return s.Status.ObservedGeneration >= s.Generation &&
+		s.Status.UpdatedReplicas == replicas &&
+		s.Status.AvailableReplicas >= replicas
+}
+
+// createOrUpdateDeployment reconciles the Deployment of the given shard when
+// the ThanosRuler object runs in stateless mode.
+func (o *Operator) createOrUpdateDeployment(ctx context.Context, tr *monitoringv1.ThanosRuler, key string, shard int32, ruleConfigMapNames []string, tlsAssets *operator.ShardedSecret) error {
+	logger := o.logger.With("key", key, "deployment", statefulSetNameByShard(tr.Name, shard), "shard", fmt.Sprintf("%d", shard))
+	deployClient := o.kclient.AppsV1().Deployments(tr.Namespace)
+
+	existingDeployment, err := o.getDeploymentFromThanosRulerKey(key, shard)
 	if err != nil {
 		return err
 	}
 
-	if existingStatefulSet == nil {
-		ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
-		sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames, "", tlsAssets)
+	if existingDeployment == nil {
+		// When switching to the stateless mode, the deployment is created
+		// while the statefulset of the shard keeps evaluating the rules. The
+		// statefulset is deleted once the deployment is available.
+		deploy, err := makeDeployment(tr, o.config, ruleConfigMapNames, "", shard, tlsAssets)
 		if err != nil {
-			return fmt.Errorf("making thanos statefulset config failed: %w", err)
+			return fmt.Errorf("making thanos deployment config failed: %w", err)
 		}
 
-		operator.SanitizeSTS(sset)
-		if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
-			return fmt.Errorf("creating thanos statefulset failed: %w", err)
+		spanCtx, span := operator.StartSpan(ctx, "create-deployment", attribute.String("deployment", deploy.Name))
+		_, err = deployClient.Create(spanCtx, deploy, metav1.CreateOptions{})
+		operator.EndSpan(span, err)
+		if err != nil {
+			return fmt.Errorf("creating thanos deployment failed: %w", err)
 		}
 
 		return nil
 	}
 
-	if o.rr.DeletionInProgress(existingStatefulSet) {
+	if o.rr.DeletionInProgress(existingDeployment) {
 		return nil
 	}
 
-	newSSetInputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames, existingStatefulSet.Spec)
+	newInputHash, err := createDeploymentInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames, existingDeployment.Spec)
 	if err != nil {
 		return err
 	}
 
-	sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames, newSSetInputHash, tlsAssets)
+	deploy, err := makeDeployment(tr, o.config, ruleConfigMapNames, newInputHash, shard, tlsAssets)
 	if err != nil {
-		return fmt.Errorf("failed to generate statefulset: %w", err)
+		return fmt.Errorf("failed to generate deployment: %w", err)
 	}
 
-	operator.SanitizeSTS(sset)
+	// The selector of a deployment is immutable. When it differs (e.g. the
+	// pod labels have changed), the deployment is deleted and recreated on
+	// the next reconciliation.
+	if !reflect.DeepEqual(existingDeployment.Spec.Selector, deploy.Spec.Selector) {
+		logger.Info("recreating ThanosRuler Deployment because the selector changed")
+		if err := deployClient.Delete(ctx, deploy.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil {
+			return fmt.Errorf("failed to delete Deployment with an outdated selector: %w", err)
+		}
+		return nil
+	}
 
-	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
-		logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+	if newInputHash == existingDeployment.Annotations[operator.InputHashAnnotationKey] {
+		logger.Debug("new deployment generation inputs match current, skipping any actions", "hash", newInputHash)
 		return nil
 	}
 
-	logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationKey])
-	ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
-	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
+	logger.Debug("new hash differs from the existing value", "new", newInputHash, "existing", existingDeployment.Annotations[operator.InputHashAnnotationKey])
+	spanCtx, span := operator.StartSpan(ctx, "update-deployment", attribute.String("deployment", deploy.Name))
+	err = k8sutil.UpdateDeployment(spanCtx, deployClient, deploy)
+	operator.EndSpan(span, err)
 	sErr, ok := err.(*apierrors.StatusError)
 
 	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
-		o.metrics.StsDeleteCreateCounter().Inc()
-
 		// Gather only reason for failed update
 		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
 		for i, cause := range sErr.ErrStatus.Details.Causes {
 			failMsg[i] = cause.Message
 		}
 
-		logger.Info("recreating ThanosRuler StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
-		propagationPolicy := metav1.DeletePropagationForeground
-		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
-			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
+		logger.Info("recreating ThanosRuler Deployment because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		if err := deployClient.Delete(ctx, deploy.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil {
+			return fmt.Errorf("failed to delete Deployment to avoid forbidden action: %w", err)
 		}
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("updating StatefulSet failed: %w", err)
+		return fmt.Errorf("updating Deployment failed: %w", err)
 	}
 
 	return nil
 }
 
+// getDeploymentFromThanosRulerKey returns a copy of the Deployment object
+// corresponding to the given shard of the ThanosRuler object identified by
+// key.
+// If the object is not found, it returns a nil pointer without error.
+func (o *Operator) getDeploymentFromThanosRulerKey(key string, shard int32) (*appsv1.Deployment, error) {
+	deployName := thanosKeyToStatefulSetKey(key, shard)
+
+	obj, err := o.deployInfs.Get(deployName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("Deployment not found", "key", deployName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve Deployment from informer: %w", err)
+	}
+
+	return obj.(*appsv1.Deployment).DeepCopy(), nil
+}
+
 // getStatefulSetFromThanosRulerKey returns a copy of the StatefulSet object
-// corresponding to the ThanosRuler object identified by key.
+// corresponding to the given shard of the ThanosRuler object identified by
+// key.
 // If the object is not found, it returns a nil pointer without error.
-func (o *Operator) getStatefulSetFromThanosRulerKey(key string) (*appsv1.StatefulSet, error) {
-	ssetName := thanosKeyToStatefulSetKey(key)
+func (o *Operator) getStatefulSetFromThanosRulerKey(key string, shard int32) (*appsv1.StatefulSet, error) {
+	ssetName := thanosKeyToStatefulSetKey(key, shard)
 
 	obj, err := o.ssetInfs.Get(ssetName)
 	if err != nil {
@@ -607,6 +959,100 @@ func (o *Operator) getStatefulSetFromThanosRulerKey(key string) (*appsv1.Statefu
 	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
 }
 
+// deleteStatefulSetWithOutdatedSelector deletes the statefulset whose
+// selector differs from the desired one. It is recreated on the next
+// reconciliation.
+//
+// When the only difference is the shard label (e.g. the statefulset of the
+// first shard was created before all shards selected the shard label), the
+// pods get the shard label first and the statefulset is deleted without its
+// pods so that the new selector adopts them. Otherwise (e.g. the pod labels
+// have changed), the statefulset is deleted with its pods because the new
+// statefulset wouldn't adopt them.
+func (o *Operator) deleteStatefulSetWithOutdatedSelector(ctx context.Context, logger *slog.Logger, existing, desired *appsv1.StatefulSet, shard int32) error {
+	ssetClient := o.kclient.AppsV1().StatefulSets(existing.Namespace)
+
+	if !onlyShardLabelAdded(existing.Spec.Selector, desired.Spec.Selector) {
+		logger.Info("recreating ThanosRuler StatefulSet because the selector changed")
+		if err := ssetClient.Delete(ctx, existing.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil {
+			return fmt.Errorf("failed to delete StatefulSet with an outdated selector: %w", err)
+		}
+		return nil
+	}
+
+	if err := o.labelShardPods(ctx, existing, shard); err != nil {
+		return fmt.Errorf("failed to add the shard label to the pods: %w", err)
+	}
+
+	logger.Info("recreating ThanosRuler StatefulSet because the selector doesn't select the shard label")
+	if err := ssetClient.Delete(ctx, existing.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationOrphan)}); err != nil {
+		return fmt.Errorf("failed to delete StatefulSet with an outdated selector: %w", err)
+	}
+
+	return nil
+}
+
+// onlyShardLabelAdded returns true if the desired selector only differs from
+// the existing one by the shard label.
+func onlyShardLabelAdded(existing, desired *metav1.LabelSelector) bool {
+	if existing == nil || desired == nil {
+		return false
+	}
+
+	if _, found := existing.MatchLabels[prompkg.ShardLabelName]; found {
+		return false
+	}
+
+	if _, found := desired.MatchLabels[prompkg.ShardLabelName]; !found {
+		return false
+	}
+
+	expected := maps.Clone(desired.MatchLabels)
+	delete(expected, prompkg.ShardLabelName)
+
+	return maps.Equal(existing.MatchLabels, expected) &&
+		reflect.DeepEqual(existing.MatchExpressions, desired.MatchExpressions)
+}
+
+// labelShardPods adds the shard label to the pods controlled by the
+// statefulset so that they match the selector of the statefulset which
+// replaces it.
+func (o *Operator) labelShardPods(ctx context.Context, sset *appsv1.StatefulSet, shard int32) error {
+	selector, err := metav1.LabelSelectorAsSelector(sset.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	pods, err := o.kclient.CoreV1().Pods(sset.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+
+	shardValue := strconv.Itoa(int(shard))
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]string{
+				prompkg.ShardLabelName: shardValue,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		if !metav1.IsControlledBy(&pod, sset) || pod.Labels[prompkg.ShardLabelName] == shardValue {
+			continue
+		}
+
+		if _, err := o.kclient.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: operator.PrometheusOperatorFieldManager}); err != nil {
+			return fmt.Errorf("failed to patch pod %s: %w", pod.Name, err)
+		}
+	}
+
+	return nil
+}
+
 // UpdateStatus implements the operator.Syncer interface.
 func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 	tr, err := operator.GetObjectFromKey[*monitoringv1.ThanosRuler](o.thanosRulerInfs, key)
@@ -622,21 +1068,62 @@ func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 		return nil
 	}
 
-	sset, err := o.getStatefulSetFromThanosRulerKey(key)
-	if err != nil {
-		return fmt.Errorf("failed to get StatefulSet: %w", err)
-	}
+	var (
+		availableCondition monitoringv1.Condition
+		messages           []string
+		shardStatuses      []monitoringv1.ShardStatus
+		replicas           int
+		updatedReplicas    int
+		availableReplicas  int
+	)
+	for shard := range shardsNumber(tr) {
+		reporter, err := o.newShardReporter(ctx, tr, key, shard)
+		if err != nil {
+			return err
+		}
 
-	if sset != nil && o.rr.DeletionInProgress(sset) {
-		return nil
-	}
+		if reporter == nil {
+			// The workload is being deleted.
+			return nil
+		}
 
-	stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
-	if err != nil {
-		return fmt.Errorf("failed to retrieve statefulset state: %w", err)
+		// The reporter updates the replica counters of the object for each
+		// shard, the totals are computed below.
+		cond := reporter.Update(tr)
+		switch {
+		case shard == 0, cond.Status == monitoringv1.ConditionFalse:
+			availableCondition = cond
+		case cond.Status == monitoringv1.ConditionDegraded && availableCondition.Status == monitoringv1.ConditionTrue:
+			availableCondition = cond
+		}
+		if cond.Message != "" {
+			messages = append(messages, fmt.Sprintf("shard %d: %s", shard, cond.Message))
+		}
+
+		shardStatuses = append(shardStatuses, monitoringv1.ShardStatus{
+			ShardID:             strconv.Itoa(int(shard)),
+			Replicas:            tr.Status.Replicas,
+			UpdatedReplicas:     tr.Status.UpdatedReplicas,
+			AvailableReplicas:   tr.Status.AvailableReplicas,
+			UnavailableReplicas: tr.Status.UnavailableReplicas,
+		})
+		replicas += int(tr.Status.Replicas)
+		updatedReplicas += int(tr.Status.UpdatedReplicas)
+		availableReplicas += int(tr.Status.AvailableReplicas)
+	}
+
+	if shardsNumber(tr) > 1 {
+		availableCondition.Message = strings.Join(messages, "\n")
+		tr.Status.ShardStatuses = shardStatuses
+	} else {
+		tr.Status.ShardStatuses = nil
 	}
+	tr.SetReplicas(replicas)
+	tr.SetUpdatedReplicas(updatedReplicas)
+	tr.SetAvailableReplicas(availableReplicas)
+	tr.SetUnavailableReplicas(replicas - availableReplicas)
+	tr.Status.Shards = shardsNumber(tr)
 
-	availableCondition := stsReporter.Update(tr)
 	reconciledCondition := o.reconciliations.GetCondition(key, tr.Generation)
 	tr.Status.Conditions = operator.UpdateConditions(tr.Status.Conditions, availableCondition, reconciledCondition)
 	tr.Status.Paused = tr.Spec.Paused
@@ -648,6 +1135,50 @@ func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 	return nil
 }
 
+// shardReporter reports the state of the pods of a ThanosRuler shard.
+type shardReporter interface {
+	Update(operator.GoverningObject) monitoringv1.Condition
+}
+
+// newShardReporter returns the reporter of the StatefulSet (or the Deployment
+// in stateless mode) running the given shard. It returns a nil reporter if the
+// workload is being deleted.
+func (o *Operator) newShardReporter(ctx context.Context, tr *monitoringv1.ThanosRuler, key string, shard int32) (shardReporter, error) {
+	if isStateless(tr) {
+		deploy, err := o.getDeploymentFromThanosRulerKey(key, shard)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Deployment: %w", err)
+		}
+
+		if deploy != nil && o.rr.DeletionInProgress(deploy) {
+			return nil, nil
+		}
+
+		reporter, err := operator.NewDeploymentReporter(ctx, o.kclient, deploy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve deployment state: %w", err)
+		}
+
+		return reporter, nil
+	}
+
+	sset, err := o.getStatefulSetFromThanosRulerKey(key, shard)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get StatefulSet: %w", err)
+	}
+
+	if sset != nil && o.rr.DeletionInProgress(sset) {
+		return nil, nil
+	}
+
+	stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve statefulset state: %w", err)
+	}
+
+	return stsReporter, nil
+}
+
 func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *operator.ShardedSecret, ruleConfigMapNames []string, ss appsv1.StatefulSetSpec) (string, error) {
 
 	// The controller should ignore any changes to RevisionHistoryLimit field because
@@ -681,6 +1212,37 @@ func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *opera
 	return fmt.Sprintf("%d", hash), nil
 }
 
+func createDeploymentInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *operator.ShardedSecret, ruleConfigMapNames []string, ds appsv1.DeploymentSpec) (string, error) {
+	// The controller should ignore any changes to RevisionHistoryLimit field because
+	// it may be modified by external actors.
+	ds.RevisionHistoryLimit = nil
+
+	hash, err := hashstructure.Hash(struct {
+		ThanosRulerLabels      map[string]string
+		ThanosRulerAnnotations map[string]string
+		ThanosRulerGeneration  int64
+		Config                 Config
+		DeploymentSpec         appsv1.DeploymentSpec
+		RuleConfigMaps         []string `hash:"set"`
+		ShardedSecret          *operator.ShardedSecret
+	}{
+		ThanosRulerLabels:      tr.Labels,
+		ThanosRulerAnnotations: tr.Annotations,
+		ThanosRulerGeneration:  tr.Generation,
+		Config:                 c,
+		DeploymentSpec:         ds,
+		RuleConfigMaps:         ruleConfigMapNames,
+		ShardedSecret:          tlsAssets,
+	},
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate combined hash: %w", err)
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
 func (o *Operator) enqueueForThanosRulerNamespace(nsName string) {
 	o.enqueueForNamespace(o.nsThanosRulerInf.GetStore(), nsName)
 }
@@ -776,7 +1338,19 @@ func applyConfigurationFromThanosRuler(a *monitoringv1.ThanosRuler) *monitoringv
 		WithReplicas(a.Status.Replicas).
 		WithAvailableReplicas(a.Status.AvailableReplicas).
 		WithUpdatedReplicas(a.Status.UpdatedReplicas).
-		WithUnavailableReplicas(a.Status.UnavailableReplicas)
+		WithUnavailableReplicas(a.Status.UnavailableReplicas).
+		WithShards(a.Status.Shards)
+
+	for _, shardStatus := range a.Status.ShardStatuses {
+		trac.WithShardStatuses(
+			monitoringv1ac.ShardStatus().
+				WithShardID(shardStatus.ShardID).
+				WithReplicas(shardStatus.Replicas).
+				WithUpdatedReplicas(shardStatus.UpdatedReplicas).
+				WithAvailableReplicas(shardStatus.AvailableReplicas).
+				WithUnavailableReplicas(shardStatus.UnavailableReplicas),
+		)
+	}
 
 	for _, condition := range a.Status.Conditions {
 		trac.WithConditions(
@@ -945,6 +1519,20 @@ func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *a
 	}
 	s.Data[rwConfigFile] = rwConfig
 
+	// The discovered query endpoints are written to the secret rather than
+	// passed as arguments so that changes don't trigger a rollout: Thanos
+	// Ruler watches the file and the kubelet updates the mounted secret.
+	if tr.Spec.QueryDiscovery != nil {
+		endpoints, err := o.discoverQueryEndpoints(tr)
+		if err != nil {
+			return fmt.Errorf("failed to discover query endpoints: %w", err)
+		}
+
+		if s.Data[querySDFile], err = makeQuerySDFile(endpoints); err != nil {
+			return err
+		}
+	}
+
 	if err = k8sutil.CreateOrUpdateSecret(ctx, sClient, s); err != nil {
 		return err
 	}
@@ -16,17 +16,24 @@ package thanos
 
 import (
 	"context"
+	"maps"
 	"testing"
 
+	"github.com/prometheus/common/promslog"
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/golden"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
 func TestCreateOrUpdateRulerConfigSecret(t *testing.T) {
@@ -89,3 +96,156 @@ func TestCreateOrUpdateRulerConfigSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelShardPodsBeforeSelectorChange(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+		},
+	}
+
+	sset, err := makeStatefulSet(tr, defaultTestConfig, []string{"rules-0"}, "", 0, &operator.ShardedSecret{})
+	require.NoError(t, err)
+
+	// Mimic the statefulset created before sharding was supported: neither
+	// the selector nor the pods have the shard label.
+	oldSset := sset.DeepCopy()
+	oldSset.UID = "sset-uid"
+	delete(oldSset.Spec.Selector.MatchLabels, prompkg.ShardLabelName)
+	delete(oldSset.Spec.Template.Labels, prompkg.ShardLabelName)
+
+	newPod := func(name string, owner *appsv1.StatefulSet) *v1.Pod {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels:    maps.Clone(oldSset.Spec.Template.Labels),
+			},
+		}
+		if owner != nil {
+			pod.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(owner, appsv1.SchemeGroupVersion.WithKind("StatefulSet"))}
+		}
+		return pod
+	}
+
+	kclient := fake.NewClientset(
+		oldSset,
+		newPod("thanos-ruler-test-0", oldSset),
+		newPod("thanos-ruler-test-1", oldSset),
+		newPod("orphan", nil),
+	)
+
+	o := &Operator{kclient: kclient}
+	require.NoError(t, o.labelShardPods(context.Background(), oldSset, 0))
+
+	selector, err := metav1.LabelSelectorAsSelector(sset.Spec.Selector)
+	require.NoError(t, err)
+
+	for _, name := range []string{"thanos-ruler-test-0", "thanos-ruler-test-1"} {
+		pod, err := kclient.CoreV1().Pods("ns").Get(context.Background(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.True(t, selector.Matches(labels.Set(pod.Labels)), "pod %s should match the new selector", name)
+	}
+
+	// Pods which aren't controlled by the statefulset aren't modified.
+	pod, err := kclient.CoreV1().Pods("ns").Get(context.Background(), "orphan", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.False(t, selector.Matches(labels.Set(pod.Labels)))
+}
+
+func TestDeleteStatefulSetWithOutdatedSelector(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+		},
+	}
+
+	existing, err := makeStatefulSet(tr, defaultTestConfig, []string{"rules-0"}, "", 0, &operator.ShardedSecret{})
+	require.NoError(t, err)
+	existing.UID = "sset-uid"
+
+	for _, tc := range []struct {
+		name           string
+		update         func(*appsv1.StatefulSet)
+		podMetadata    *monitoringv1.EmbeddedObjectMetadata
+		expectedPolicy metav1.DeletionPropagation
+		podsPatched    bool
+	}{
+		{
+			name: "missing shard label",
+			update: func(s *appsv1.StatefulSet) {
+				delete(s.Spec.Selector.MatchLabels, prompkg.ShardLabelName)
+				delete(s.Spec.Template.Labels, prompkg.ShardLabelName)
+			},
+			expectedPolicy: metav1.DeletePropagationOrphan,
+			podsPatched:    true,
+		},
+		{
+			name:   "pod labels changed",
+			update: func(*appsv1.StatefulSet) {},
+			podMetadata: &monitoringv1.EmbeddedObjectMetadata{
+				Labels: map[string]string{"team": "a"},
+			},
+			expectedPolicy: metav1.DeletePropagationForeground,
+		},
+		{
+			name: "missing shard label and pod labels changed",
+			update: func(s *appsv1.StatefulSet) {
+				delete(s.Spec.Selector.MatchLabels, prompkg.ShardLabelName)
+				delete(s.Spec.Template.Labels, prompkg.ShardLabelName)
+			},
+			podMetadata: &monitoringv1.EmbeddedObjectMetadata{
+				Labels: map[string]string{"team": "a"},
+			},
+			expectedPolicy: metav1.DeletePropagationForeground,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSset := existing.DeepCopy()
+			tc.update(oldSset)
+
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "thanos-ruler-test-0",
+					Namespace:       "ns",
+					Labels:          maps.Clone(oldSset.Spec.Template.Labels),
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(oldSset, appsv1.SchemeGroupVersion.WithKind("StatefulSet"))},
+				},
+			}
+			kclient := fake.NewClientset(oldSset, pod)
+			o := &Operator{kclient: kclient}
+
+			newTr := tr.DeepCopy()
+			newTr.Spec.PodMetadata = tc.podMetadata
+			desired, err := makeStatefulSet(newTr, defaultTestConfig, []string{"rules-0"}, "", 0, &operator.ShardedSecret{})
+			require.NoError(t, err)
+
+			require.NoError(t, o.deleteStatefulSetWithOutdatedSelector(context.Background(), promslog.NewNopLogger(), oldSset, desired, 0))
+
+			var (
+				policy  metav1.DeletionPropagation
+				patched bool
+			)
+			for _, a := range kclient.Actions() {
+				switch a := a.(type) {
+				case k8stesting.DeleteActionImpl:
+					if a.GetResource().Resource == "statefulsets" {
+						policy = ptr.Deref(a.GetDeleteOptions().PropagationPolicy, "")
+					}
+				case k8stesting.PatchActionImpl:
+					patched = patched || a.GetResource().Resource == "pods"
+				}
+			}
+			require.Equal(t, tc.expectedPolicy, policy)
+			require.Equal(t, tc.podsPatched, patched)
+		})
+	}
+}
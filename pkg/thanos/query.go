@@ -0,0 +1,878 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/mitchellh/hashstructure"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/listwatch"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+const (
+	queryControllerName = "thanos-query-controller"
+
+	// prometheusGoverningServiceName is the name of the default governing
+	// service of the Prometheus statefulsets.
+	prometheusGoverningServiceName = "prometheus-operated"
+
+	// queryEndpointsSDFile is the key of the endpoints secret containing the
+	// endpoints of Thanos Query in the file-based service discovery format.
+	queryEndpointsSDFile = "endpoints-sd.yaml"
+)
+
+// QueryOperator manages the life cycle of Thanos Query deployments.
+type QueryOperator struct {
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+
+	logger   *slog.Logger
+	accessor *operator.Accessor
+
+	controllerID  string
+	clusterDomain string
+
+	thanosQueryInfs *informers.ForResource
+	promInfs        *informers.ForResource
+	thanosRulerInfs *informers.ForResource
+	deployInfs      *informers.ForResource
+
+	rr *operator.ResourceReconciler
+
+	nsInf cache.SharedIndexInformer
+
+	metrics         *operator.Metrics
+	reconciliations *operator.ReconciliationTracker
+
+	config Config
+}
+
+// NewQueryOperator creates a new controller for ThanosQuery objects.
+func NewQueryOperator(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer) (*QueryOperator, error) {
+	logger = logger.With("component", queryControllerName)
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
+	}
+
+	mclient, err := monitoringclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
+	}
+
+	// All the metrics exposed by the controller get the controller="thanos-query" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "thanos-query"}, r)
+
+	o := &QueryOperator{
+		kclient:         client,
+		mclient:         mclient,
+		logger:          logger,
+		accessor:        operator.NewAccessor(logger),
+		metrics:         operator.NewMetrics(r),
+		reconciliations: &operator.ReconciliationTracker{},
+		controllerID:    c.ControllerID,
+		clusterDomain:   c.ClusterDomain,
+		config: Config{
+			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+			Annotations:            c.Annotations,
+			Labels:                 c.Labels,
+			LocalHost:              c.LocalHost,
+		},
+	}
+
+	o.thanosQueryInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ThanosQueryName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanosquery informers: %w", err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.thanosQueryInfs,
+		o.metrics,
+		monitoringv1alpha1.ThanosQueryKind,
+		r,
+		o.controllerID,
+	)
+
+	o.promInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.PrometheusAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.PromSelector.String()
+			},
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheus informers: %w", err)
+	}
+
+	o.thanosRulerInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.ThanosRulerSelector.String()
+			},
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ThanosRulerName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanosruler informers: %w", err)
+	}
+
+	o.deployInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = fmt.Sprintf("%s,%s=%s", operator.ManagedByOperatorLabelSelector(), operator.ApplicationNameLabelKey, queryApplicationNameLabelValue)
+			},
+		),
+		appsv1.SchemeGroupVersion.WithResource("deployments"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating deployment informers: %w", err)
+	}
+
+	lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
+		ctx,
+		o.logger,
+		c.KubernetesVersion,
+		o.kclient.CoreV1(),
+		o.kclient.AuthorizationV1().SelfSubjectAccessReviews(),
+		c.Namespaces.AllowList,
+		c.Namespaces.DenyList,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	o.logger.Debug("creating namespace informer", "privileged", privileged)
+	o.nsInf = cache.NewSharedIndexInformer(
+		o.metrics.NewInstrumentedListerWatcher(lw),
+		&v1.Namespace{},
+		resyncPeriod,
+		cache.Indexers{},
+	)
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *QueryOperator) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{"ThanosQuery", o.thanosQueryInfs},
+		{"Prometheus", o.promInfs},
+		{"ThanosRuler", o.thanosRulerInfs},
+		{"Deployment", o.deployInfs},
+	} {
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, "thanos-query", o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	if !operator.WaitForNamedCacheSync(ctx, "thanos-query", o.logger.With("informer", "Namespace"), o.nsInf) {
+		return fmt.Errorf("failed to sync cache for Namespace informer")
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// addHandlers adds the eventhandlers to the informers.
+func (o *QueryOperator) addHandlers() {
+	o.thanosQueryInfs.AddEventHandler(o.rr)
+	o.deployInfs.AddEventHandler(o.rr)
+
+	// The endpoints depend on the number of shards and replicas which are
+	// defined in the spec (generation) and in the annotations (automatic
+	// sharding).
+	o.promInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.PrometheusesKind,
+		o.enqueueForPrometheusNamespace,
+		operator.WithFilter(
+			operator.AnyFilter(
+				operator.GenerationChanged,
+				operator.LabelsChanged,
+				operator.AnnotationsChanged,
+			),
+		),
+	))
+
+	o.thanosRulerInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.ThanosRulerKind,
+		o.enqueueForThanosRulerNamespace,
+		operator.WithFilter(
+			operator.AnyFilter(
+				operator.GenerationChanged,
+				operator.LabelsChanged,
+			),
+		),
+	))
+
+	// A label change on a namespace may change the list of endpoints.
+	_, _ = o.nsInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: o.handleNamespaceUpdate,
+	})
+}
+
+// Run the controller.
+func (o *QueryOperator) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.thanosQueryInfs.Start(ctx.Done())
+	go o.promInfs.Start(ctx.Done())
+	go o.thanosRulerInfs.Start(ctx.Done())
+	go o.deployInfs.Start(ctx.Done())
+	go o.nsInf.Run(ctx.Done())
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	// Refresh the status of the existing ThanosQuery objects.
+	_ = o.thanosQueryInfs.ListAll(labels.Everything(), func(obj any) {
+		o.rr.EnqueueForStatus(obj.(*monitoringv1alpha1.ThanosQuery))
+	})
+
+	o.addHandlers()
+
+	go operator.StatusPoller(ctx, o)
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// Iterate implements the operator.StatusReconciler interface.
+func (o *QueryOperator) Iterate(processFn func(metav1.Object, []monitoringv1.Condition)) {
+	if err := o.thanosQueryInfs.ListAll(labels.Everything(), func(o any) {
+		tq := o.(*monitoringv1alpha1.ThanosQuery)
+		processFn(tq, tq.Status.Conditions)
+	}); err != nil {
+		o.logger.Error("failed to list ThanosQuery objects", "err", err)
+	}
+}
+
+// RefreshStatusFor implements the operator.StatusReconciler interface.
+func (o *QueryOperator) RefreshStatusFor(obj metav1.Object) {
+	o.rr.EnqueueForStatus(obj)
+}
+
+func (o *QueryOperator) handleNamespaceUpdate(oldo, curo any) {
+	old := oldo.(*v1.Namespace)
+	cur := curo.(*v1.Namespace)
+
+	// Periodic resync may resend the Namespace without changes in-between.
+	if old.ResourceVersion == cur.ResourceVersion {
+		return
+	}
+
+	o.logger.Debug("Namespace updated", "namespace", cur.GetName())
+	o.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
+
+	err := o.thanosQueryInfs.ListAll(labels.Everything(), func(obj any) {
+		tq := obj.(*monitoringv1alpha1.ThanosQuery)
+
+		for _, selector := range []*metav1.LabelSelector{
+			tq.Spec.PrometheusNamespaceSelector,
+			tq.Spec.ThanosRulerNamespaceSelector,
+		} {
+			sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, selector)
+			if err != nil {
+				o.logger.Error(
+					"failed to detect label selection change",
+					"err", err,
+					"name", tq.Name,
+					"namespace", tq.Namespace,
+				)
+				return
+			}
+
+			if sync {
+				o.rr.EnqueueForReconciliation(tq)
+				return
+			}
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosQuery instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+func (o *QueryOperator) enqueueForPrometheusNamespace(nsName string) {
+	o.enqueueForNamespace(nsName, func(tq *monitoringv1alpha1.ThanosQuery) (*metav1.LabelSelector, *metav1.LabelSelector) {
+		return tq.Spec.PrometheusSelector, tq.Spec.PrometheusNamespaceSelector
+	})
+}
+
+func (o *QueryOperator) enqueueForThanosRulerNamespace(nsName string) {
+	o.enqueueForNamespace(nsName, func(tq *monitoringv1alpha1.ThanosQuery) (*metav1.LabelSelector, *metav1.LabelSelector) {
+		return tq.Spec.ThanosRulerSelector, tq.Spec.ThanosRulerNamespaceSelector
+	})
+}
+
+// enqueueForNamespace enqueues all ThanosQuery object keys that may select
+// objects in the given namespace. selectorsFn returns the label and
+// namespace selectors of the ThanosQuery object for the kind of objects.
+func (o *QueryOperator) enqueueForNamespace(nsName string, selectorsFn func(*monitoringv1alpha1.ThanosQuery) (*metav1.LabelSelector, *metav1.LabelSelector)) {
+	nsObject, exists, err := o.nsInf.GetStore().GetByKey(nsName)
+	if err != nil {
+		o.logger.Error("get namespace to enqueue ThanosQuery instances failed",
+			"err", err,
+		)
+		return
+	}
+	if !exists {
+		o.logger.Error("get namespace to enqueue ThanosQuery instances failed: namespace does not exist",
+			"namespace", nsName,
+		)
+		return
+	}
+	ns := nsObject.(*v1.Namespace)
+
+	err = o.thanosQueryInfs.ListAll(labels.Everything(), func(obj any) {
+		tq := obj.(*monitoringv1alpha1.ThanosQuery)
+
+		selector, nsSelector := selectorsFn(tq)
+		if selector == nil {
+			// The ThanosQuery object doesn't select any object.
+			return
+		}
+
+		if nsSelector == nil {
+			if tq.Namespace == nsName {
+				o.rr.EnqueueForReconciliation(tq)
+			}
+			return
+		}
+
+		s, err := metav1.LabelSelectorAsSelector(nsSelector)
+		if err != nil {
+			o.logger.Error("failed to convert namespace selector",
+				"err", err,
+				"name", tq.Name,
+				"namespace", tq.Namespace,
+			)
+			return
+		}
+
+		if s.Matches(labels.Set(ns.Labels)) {
+			o.rr.EnqueueForReconciliation(tq)
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosQuery instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *QueryOperator) Sync(ctx context.Context, key string) error {
+	err := o.sync(ctx, key)
+	o.reconciliations.SetStatus(key, err)
+
+	return err
+}
+
+func (o *QueryOperator) sync(ctx context.Context, key string) error {
+	tq, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosQuery](o.thanosQueryInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if tq == nil {
+		o.reconciliations.ForgetObject(key)
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(tq) {
+		return nil
+	}
+
+	if tq.Spec.Paused {
+		return nil
+	}
+
+	operator.SetSpanObject(ctx, tq)
+	logger := o.logger.With("key", key)
+	logger.Info("sync thanos-query")
+
+	endpoints, err := o.discoverEndpoints(tq)
+	if err != nil {
+		return fmt.Errorf("failed to discover endpoints: %w", err)
+	}
+	logger.Debug("discovered endpoints", "count", len(endpoints))
+
+	assetStore := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
+	for _, tlsConfig := range []*monitoringv1.TLSConfig{
+		tq.Spec.GRPCServerTLSConfig,
+		tq.Spec.GRPCClientTLSConfig,
+	} {
+		if err := assetStore.AddTLSConfig(ctx, tq.Namespace, tlsConfig); err != nil {
+			return err
+		}
+	}
+
+	spanCtx, span := operator.StartSpan(ctx, "apply-tls-assets")
+	tlsAssets, err := operator.ReconcileShardedSecret(spanCtx, assetStore.TLSAssets(), o.kclient, newQueryTLSAssetSecret(tq, o.config))
+	operator.EndSpan(span, err)
+	if err != nil {
+		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
+	}
+
+	if err := o.createOrUpdateWebConfigSecret(ctx, tq); err != nil {
+		return fmt.Errorf("failed to synchronize web config secret: %w", err)
+	}
+
+	if err := o.createOrUpdateEndpointsSecret(ctx, tq, endpoints); err != nil {
+		return fmt.Errorf("failed to synchronize endpoints secret: %w", err)
+	}
+
+	svcClient := o.kclient.CoreV1().Services(tq.Namespace)
+	if _, err = k8sutil.CreateOrUpdateService(ctx, svcClient, makeQueryService(tq, o.config)); err != nil {
+		return fmt.Errorf("synchronizing service failed: %w", err)
+	}
+
+	existingDeployment, err := o.getDeploymentFromThanosQueryKey(key)
+	if err != nil {
+		return err
+	}
+
+	deployClient := o.kclient.AppsV1().Deployments(tq.Namespace)
+	store := assetStore.ForNamespace(tq.Namespace)
+	if existingDeployment == nil {
+		deploy, err := makeQueryDeployment(tq, o.config, "", tlsAssets, store)
+		if err != nil {
+			return fmt.Errorf("making thanos query deployment failed: %w", err)
+		}
+
+		spanCtx, span := operator.StartSpan(ctx, "create-deployment", attribute.String("deployment", deploy.Name))
+		_, err = deployClient.Create(spanCtx, deploy, metav1.CreateOptions{})
+		operator.EndSpan(span, err)
+		if err != nil {
+			return fmt.Errorf("creating thanos query deployment failed: %w", err)
+		}
+
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(existingDeployment) {
+		return nil
+	}
+
+	newInputHash, err := createQueryInputHash(*tq, o.config, tlsAssets, existingDeployment.Spec)
+	if err != nil {
+		return err
+	}
+
+	if newInputHash == existingDeployment.Annotations[operator.InputHashAnnotationKey] {
+		logger.Debug("new deployment generation inputs match current, skipping any actions", "hash", newInputHash)
+		return nil
+	}
+
+	deploy, err := makeQueryDeployment(tq, o.config, newInputHash, tlsAssets, store)
+	if err != nil {
+		return fmt.Errorf("failed to generate deployment: %w", err)
+	}
+
+	logger.Debug("new hash differs from the existing value", "new", newInputHash, "existing", existingDeployment.Annotations[operator.InputHashAnnotationKey])
+	spanCtx, span = operator.StartSpan(ctx, "update-deployment", attribute.String("deployment", deploy.Name))
+	err = k8sutil.UpdateDeployment(spanCtx, deployClient, deploy)
+	operator.EndSpan(span, err)
+	if err != nil {
+		return fmt.Errorf("updating Deployment failed: %w", err)
+	}
+
+	return nil
+}
+
+// discoverEndpoints returns the sorted list of gRPC addresses of the Thanos
+// sidecars and ThanosRuler pods selected by the ThanosQuery object, followed
+// by the static endpoints.
+//
+// The addresses use the DNS names of the pods provided by the governing
+// services of the statefulsets.
+func (o *QueryOperator) discoverEndpoints(tq *monitoringv1alpha1.ThanosQuery) ([]string, error) {
+	var endpoints []string
+
+	err := o.listSelectedObjects(tq, o.promInfs, tq.Spec.PrometheusSelector, tq.Spec.PrometheusNamespaceSelector, func(obj any) {
+		p := obj.(*monitoringv1.Prometheus)
+		if p.DeletionTimestamp != nil {
+			return
+		}
+
+		thanos := p.Spec.Thanos
+		if thanos == nil || thanos.ListenLocal || thanos.GRPCListenLocal {
+			return
+		}
+
+		svc := ptr.Deref(p.Spec.ServiceName, prometheusGoverningServiceName)
+		replicas := ptr.Deref(prompkg.ReplicasNumberPtr(p), 1)
+		for _, ssetName := range prompkg.ExpectedStatefulSetShardNames(p) {
+			for i := range replicas {
+				endpoints = append(endpoints, o.podEndpoint(fmt.Sprintf("%s-%d", ssetName, i), svc, p.Namespace))
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prometheus: %w", err)
+	}
+
+	err = o.listSelectedObjects(tq, o.thanosRulerInfs, tq.Spec.ThanosRulerSelector, tq.Spec.ThanosRulerNamespaceSelector, func(obj any) {
+		tr := obj.(*monitoringv1.ThanosRuler)
+		if tr.DeletionTimestamp != nil {
+			return
+		}
+
+		// Stateless rulers remote-write their data and their pods have no
+		// stable DNS names.
+		if isStateless(tr) {
+			return
+		}
+
+		svc := ptr.Deref(tr.Spec.ServiceName, governingServiceName)
+		for _, ssetName := range expectedStatefulSetShardNames(tr) {
+			for i := range ptr.Deref(tr.Spec.Replicas, 1) {
+				endpoints = append(endpoints, o.podEndpoint(fmt.Sprintf("%s-%d", ssetName, i), svc, tr.Namespace))
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("thanosruler: %w", err)
+	}
+
+	slices.Sort(endpoints)
+	for _, ep := range tq.Spec.Endpoints {
+		if !slices.Contains(endpoints, ep) {
+			endpoints = append(endpoints, ep)
+		}
+	}
+
+	return endpoints, nil
+}
+
+// listSelectedObjects calls appendFn for each object of the informers
+// matching the label and namespace selectors.
+// A nil selector selects no object while a nil namespace selector selects
+// the namespace of the ThanosQuery object only.
+func (o *QueryOperator) listSelectedObjects(tq *monitoringv1alpha1.ThanosQuery, infs *informers.ForResource, selector, nsSelector *metav1.LabelSelector, appendFn cache.AppendFunc) error {
+	if selector == nil {
+		return nil
+	}
+
+	objSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	namespaces := []string{tq.Namespace}
+	if nsSelector != nil {
+		s, err := metav1.LabelSelectorAsSelector(nsSelector)
+		if err != nil {
+			return fmt.Errorf("invalid namespace selector: %w", err)
+		}
+
+		namespaces, err = operator.ListMatchingNamespaces(s, o.nsInf)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ns := range namespaces {
+		if err := infs.ListAllByNamespace(ns, objSelector, appendFn); err != nil {
+			return fmt.Errorf("failed to list objects in namespace %s: %w", ns, err)
+		}
+	}
+
+	return nil
+}
+
+// podEndpoint returns the gRPC address of a pod from a statefulset.
+func (o *QueryOperator) podEndpoint(pod, svc, namespace string) string {
+	host := fmt.Sprintf("%s.%s.%s.svc", pod, svc, namespace)
+	if o.clusterDomain != "" {
+		host += "." + o.clusterDomain
+	}
+
+	return fmt.Sprintf("%s:%d", host, grpcPort)
+}
+
+// getDeploymentFromThanosQueryKey returns a copy of the Deployment object
+// corresponding to the ThanosQuery object identified by key.
+// If the object is not found, it returns a nil pointer without error.
+func (o *QueryOperator) getDeploymentFromThanosQueryKey(key string) (*appsv1.Deployment, error) {
+	keyParts := strings.Split(key, "/")
+	deployName := keyParts[0] + "/" + queryPrefixedName(keyParts[1])
+
+	obj, err := o.deployInfs.Get(deployName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("Deployment not found", "key", deployName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve Deployment from informer: %w", err)
+	}
+
+	return obj.(*appsv1.Deployment).DeepCopy(), nil
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+func (o *QueryOperator) UpdateStatus(ctx context.Context, key string) error {
+	tq, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosQuery](o.thanosQueryInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if tq == nil {
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(tq) {
+		return nil
+	}
+
+	deploy, err := o.getDeploymentFromThanosQueryKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to get Deployment: %w", err)
+	}
+
+	if deploy != nil && o.rr.DeletionInProgress(deploy) {
+		return nil
+	}
+
+	reporter, err := operator.NewDeploymentReporter(ctx, o.kclient, deploy)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve deployment state: %w", err)
+	}
+
+	availableCondition := reporter.Update(tq)
+	reconciledCondition := o.reconciliations.GetCondition(key, tq.Generation)
+	tq.Status.Conditions = operator.UpdateConditions(tq.Status.Conditions, availableCondition, reconciledCondition)
+	tq.Status.Paused = tq.Spec.Paused
+
+	if _, err = o.mclient.MonitoringV1alpha1().ThanosQueries(tq.Namespace).ApplyStatus(ctx, applyConfigurationFromThanosQuery(tq), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+func createQueryInputHash(tq monitoringv1alpha1.ThanosQuery, c Config, tlsAssets *operator.ShardedSecret, ds appsv1.DeploymentSpec) (string, error) {
+	// The controller should ignore any changes to RevisionHistoryLimit field because
+	// it may be modified by external actors.
+	ds.RevisionHistoryLimit = nil
+
+	hash, err := hashstructure.Hash(struct {
+		ThanosQueryLabels      map[string]string
+		ThanosQueryAnnotations map[string]string
+		ThanosQueryGeneration  int64
+		Config                 Config
+		DeploymentSpec         appsv1.DeploymentSpec
+		ShardedSecret          *operator.ShardedSecret
+	}{
+		ThanosQueryLabels:      tq.Labels,
+		ThanosQueryAnnotations: tq.Annotations,
+		ThanosQueryGeneration:  tq.Generation,
+		Config:                 c,
+		DeploymentSpec:         ds,
+		ShardedSecret:          tlsAssets,
+	},
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate combined hash: %w", err)
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
+func (o *QueryOperator) createOrUpdateWebConfigSecret(ctx context.Context, tq *monitoringv1alpha1.ThanosQuery) error {
+	var fields monitoringv1.WebConfigFileFields
+	if tq.Spec.Web != nil {
+		fields = tq.Spec.Web.WebConfigFileFields
+	}
+
+	webConfig, err := webconfig.New(
+		webConfigDir,
+		queryWebConfigSecretName(tq.Name),
+		fields,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize the web config: %w", err)
+	}
+
+	s := &v1.Secret{}
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(o.config.Labels),
+		operator.WithAnnotations(o.config.Annotations),
+		operator.WithManagingOwner(tq),
+	)
+
+	if err := webConfig.CreateOrUpdateWebConfigSecret(ctx, o.kclient.CoreV1().Secrets(tq.Namespace), s); err != nil {
+		return fmt.Errorf("failed to update the web config secret: %w", err)
+	}
+
+	return nil
+}
+
+// createOrUpdateEndpointsSecret writes the endpoints to the file service
+// discovery file read by Thanos Query.
+func (o *QueryOperator) createOrUpdateEndpointsSecret(ctx context.Context, tq *monitoringv1alpha1.ThanosQuery, endpoints []string) error {
+	sdFile, err := makeQuerySDFile(endpoints)
+	if err != nil {
+		return err
+	}
+
+	s := &v1.Secret{
+		Data: map[string][]byte{
+			queryEndpointsSDFile: sdFile,
+		},
+	}
+
+	operator.UpdateObject(
+		s,
+		operator.WithName(queryEndpointsSecretName(tq.Name)),
+		operator.WithNamespace(tq.GetNamespace()),
+		operator.WithLabels(o.config.Labels),
+		operator.WithAnnotations(o.config.Annotations),
+		operator.WithManagingOwner(tq),
+	)
+
+	return k8sutil.CreateOrUpdateSecret(ctx, o.kclient.CoreV1().Secrets(tq.Namespace), s)
+}
+
+// makeQuerySDFile returns the content of the file-based service discovery
+// file for the given endpoints.
+func makeQuerySDFile(endpoints []string) ([]byte, error) {
+	type targetGroup struct {
+		Targets []string `yaml:"targets"`
+	}
+
+	groups := []targetGroup{}
+	if len(endpoints) > 0 {
+		groups = append(groups, targetGroup{Targets: endpoints})
+	}
+
+	b, err := yaml.Marshal(groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal endpoints: %w", err)
+	}
+
+	return b, nil
+}
+
+func applyConfigurationFromThanosQuery(tq *monitoringv1alpha1.ThanosQuery) *monitoringv1alpha1ac.ThanosQueryApplyConfiguration {
+	tqac := monitoringv1alpha1ac.ThanosQueryStatus().
+		WithPaused(tq.Status.Paused).
+		WithReplicas(tq.Status.Replicas).
+		WithAvailableReplicas(tq.Status.AvailableReplicas).
+		WithUpdatedReplicas(tq.Status.UpdatedReplicas).
+		WithUnavailableReplicas(tq.Status.UnavailableReplicas)
+
+	for _, condition := range tq.Status.Conditions {
+		tqac.WithConditions(
+			monitoringv1ac.Condition().
+				WithType(condition.Type).
+				WithStatus(condition.Status).
+				WithLastTransitionTime(condition.LastTransitionTime).
+				WithReason(condition.Reason).
+				WithMessage(condition.Message).
+				WithObservedGeneration(condition.ObservedGeneration),
+		)
+	}
+
+	return monitoringv1alpha1ac.ThanosQuery(tq.Name, tq.Namespace).WithStatus(tqac)
+}
+
+func newQueryTLSAssetSecret(tq *monitoringv1alpha1.ThanosQuery, config Config) *v1.Secret {
+	s := &v1.Secret{
+		Data: map[string][]byte{},
+	}
+
+	operator.UpdateObject(
+		s,
+		operator.WithLabels(config.Labels),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithManagingOwner(tq),
+		operator.WithName(queryTLSAssetsSecretName(tq.Name)),
+		operator.WithNamespace(tq.GetNamespace()),
+	)
+
+	return s
+}
@@ -0,0 +1,350 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"fmt"
+	"maps"
+	"path"
+
+	"github.com/blang/semver/v4"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+const (
+	queryApplicationNameLabelValue = "thanos-query"
+	queryContainerName             = "thanos-query"
+
+	grpcPort = 10901
+	httpPort = 10902
+)
+
+// defaultQueryReplicaLabels are the replica labels set by the operator on
+// the Prometheus and ThanosRuler pods.
+var defaultQueryReplicaLabels = []string{"prometheus_replica", defaultReplicaLabelName}
+
+func makeQueryDeployment(tq *monitoringv1alpha1.ThanosQuery, config Config, inputHash string, tlsSecrets *operator.ShardedSecret, store assets.StoreGetter) (*appsv1.Deployment, error) {
+	spec, err := makeQueryDeploymentSpec(tq, config, tlsSecrets, store)
+	if err != nil {
+		return nil, err
+	}
+
+	deploy := &appsv1.Deployment{Spec: *spec}
+	operator.UpdateObject(
+		deploy,
+		operator.WithName(queryPrefixedName(tq.Name)),
+		operator.WithAnnotations(tq.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithInputHashAnnotation(inputHash),
+		operator.WithLabels(tq.GetLabels()),
+		operator.WithSelectorLabels(spec.Selector),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(tq),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	return deploy, nil
+}
+
+func makeQueryDeploymentSpec(tq *monitoringv1alpha1.ThanosQuery, config Config, tlsSecrets *operator.ShardedSecret, store assets.StoreGetter) (*appsv1.DeploymentSpec, error) {
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(tq.Spec.Version, ""), operator.DefaultThanosVersion)
+	version, err := semver.ParseTolerant(thanosVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Thanos Query version %q: %w", thanosVersion, err)
+	}
+
+	tqImagePath, err := operator.BuildImagePath(
+		ptr.Deref(tq.Spec.Image, ""),
+		operator.StringValOrDefault(config.ThanosDefaultBaseImage, operator.DefaultThanosBaseImage),
+		thanosVersion,
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image path: %w", err)
+	}
+
+	var tqCLIArgs []monitoringv1.Argument
+
+	if tq.Spec.LogLevel != "" && tq.Spec.LogLevel != "info" {
+		tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "log.level", Value: tq.Spec.LogLevel})
+	}
+	if tq.Spec.LogFormat != "" {
+		tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "log.format", Value: tq.Spec.LogFormat})
+	}
+
+	replicaLabels := tq.Spec.ReplicaLabels
+	if len(replicaLabels) == 0 {
+		replicaLabels = defaultQueryReplicaLabels
+	}
+	for _, l := range replicaLabels {
+		tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "query.replica-label", Value: l})
+	}
+
+	if tls := tq.Spec.GRPCServerTLSConfig; tls != nil {
+		cert, key, ca := tlsFiles(tls, store)
+		if cert != "" {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-server-tls-cert", Value: cert})
+		}
+		if key != "" {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-server-tls-key", Value: key})
+		}
+		if ca != "" {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-server-tls-client-ca", Value: ca})
+		}
+	}
+
+	if tls := tq.Spec.GRPCClientTLSConfig; tls != nil {
+		tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-client-tls-secure"})
+
+		cert, key, ca := tlsFiles(tls, store)
+		if cert != "" {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-client-tls-cert", Value: cert})
+		}
+		if key != "" {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-client-tls-key", Value: key})
+		}
+		if ca != "" {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-client-tls-ca", Value: ca})
+		}
+		if ptr.Deref(tls.InsecureSkipVerify, false) {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-client-tls-skip-verify"})
+		}
+		if tls.ServerName != nil {
+			tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "grpc-client-server-name", Value: *tls.ServerName})
+		}
+	}
+
+	tqVolumes := []v1.Volume{tlsSecrets.Volume("tls-assets")}
+	tqVolumeMounts := []v1.VolumeMount{
+		{
+			Name:      "tls-assets",
+			ReadOnly:  true,
+			MountPath: tlsAssetsDir,
+		},
+	}
+
+	// The endpoints are read from a file service discovery file rather than
+	// passed as arguments so that changes don't trigger a rollout. The
+	// secret key is mounted without subPath for the kubelet to update the
+	// file which is reloaded by Thanos Query.
+	tqVolumes, tqVolumeMounts, fullPath := mountSecretKey(
+		tqVolumes,
+		tqVolumeMounts,
+		&v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{
+				Name: queryEndpointsSecretName(tq.Name),
+			},
+			Key: queryEndpointsSDFile,
+		},
+		"endpoints-sd-config",
+	)
+	tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "store.sd-files", Value: fullPath})
+
+	if version.GTE(semver.MustParse("0.21.0")) {
+		var fields monitoringv1.WebConfigFileFields
+		if tq.Spec.Web != nil {
+			fields = tq.Spec.Web.WebConfigFileFields
+		}
+
+		webConfig, err := webconfig.New(webConfigDir, queryWebConfigSecretName(tq.Name), fields)
+		if err != nil {
+			return nil, err
+		}
+
+		confArg, configVol, configMount, err := webConfig.GetMountParameters()
+		if err != nil {
+			return nil, err
+		}
+		tqCLIArgs = append(tqCLIArgs, monitoringv1.Argument{Name: "http.config", Value: confArg.Value})
+		tqVolumes = append(tqVolumes, configVol...)
+		tqVolumeMounts = append(tqVolumeMounts, configMount...)
+	}
+
+	containerArgs, err := operator.BuildArgs(tqCLIArgs, tq.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first argument to thanos must be "query" to start thanos query, e.g. "thanos query --endpoint=..."
+	containerArgs = append([]string{"query"}, containerArgs...)
+
+	podAnnotations := map[string]string{}
+	podLabels := map[string]string{}
+	if tq.Spec.PodMetadata != nil {
+		maps.Copy(podLabels, tq.Spec.PodMetadata.Labels)
+		maps.Copy(podAnnotations, tq.Spec.PodMetadata.Annotations)
+	}
+
+	// The selector of a deployment is immutable, it should only contain the
+	// labels managed by the operator.
+	selectorLabels := makeQuerySelectorLabels(tq.Name)
+
+	finalLabels := config.Labels.Merge(podLabels)
+	maps.Copy(finalLabels, selectorLabels)
+
+	podAnnotations[operator.DefaultContainerAnnotationKey] = queryContainerName
+
+	operatorContainers := []v1.Container{
+		{
+			Name:            queryContainerName,
+			Image:           tqImagePath,
+			ImagePullPolicy: tq.Spec.ImagePullPolicy,
+			Args:            containerArgs,
+			VolumeMounts:    tqVolumeMounts,
+			Resources:       tq.Spec.Resources,
+			Ports: []v1.ContainerPort{
+				{
+					Name:          "grpc",
+					ContainerPort: grpcPort,
+					Protocol:      v1.ProtocolTCP,
+				},
+				{
+					Name:          defaultPortName,
+					ContainerPort: httpPort,
+					Protocol:      v1.ProtocolTCP,
+				},
+			},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &v1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				Capabilities: &v1.Capabilities{
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+		},
+	}
+
+	containers, err := k8sutil.MergePatchContainers(operatorContainers, tq.Spec.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
+	}
+
+	return &appsv1.DeploymentSpec{
+		Replicas: tq.Spec.Replicas,
+		Selector: &metav1.LabelSelector{
+			MatchLabels: selectorLabels,
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      finalLabels,
+				Annotations: podAnnotations,
+			},
+			Spec: v1.PodSpec{
+				NodeSelector:              tq.Spec.NodeSelector,
+				PriorityClassName:         tq.Spec.PriorityClassName,
+				ServiceAccountName:        tq.Spec.ServiceAccountName,
+				Containers:                containers,
+				Volumes:                   tqVolumes,
+				SecurityContext:           tq.Spec.SecurityContext,
+				Tolerations:               tq.Spec.Tolerations,
+				Affinity:                  tq.Spec.Affinity,
+				TopologySpreadConstraints: tq.Spec.TopologySpreadConstraints,
+				ImagePullSecrets:          tq.Spec.ImagePullSecrets,
+			},
+		},
+	}, nil
+}
+
+// tlsFiles returns the paths of the certificate, key and CA files from the
+// TLS configuration. The file fields take precedence over the Secret and
+// ConfigMap references which are mounted from the TLS assets secret.
+func tlsFiles(tls *monitoringv1.TLSConfig, store assets.StoreGetter) (string, string, string) {
+	cert, key, ca := tls.CertFile, tls.KeyFile, tls.CAFile
+
+	if cert == "" && tls.Cert != (monitoringv1.SecretOrConfigMap{}) {
+		cert = path.Join(tlsAssetsDir, store.TLSAsset(tls.Cert))
+	}
+
+	if key == "" && tls.KeySecret != nil {
+		key = path.Join(tlsAssetsDir, store.TLSAsset(tls.KeySecret))
+	}
+
+	if ca == "" && tls.CA != (monitoringv1.SecretOrConfigMap{}) {
+		ca = path.Join(tlsAssetsDir, store.TLSAsset(tls.CA))
+	}
+
+	return cert, key, ca
+}
+
+func makeQueryService(tq *monitoringv1alpha1.ThanosQuery, config Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Name:       defaultPortName,
+					Port:       httpPort,
+					TargetPort: intstr.FromString(defaultPortName),
+					Protocol:   v1.ProtocolTCP,
+				},
+				{
+					Name:       "grpc",
+					Port:       grpcPort,
+					TargetPort: intstr.FromString("grpc"),
+					Protocol:   v1.ProtocolTCP,
+				},
+			},
+			Selector: makeQuerySelectorLabels(tq.Name),
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(queryPrefixedName(tq.Name)),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(map[string]string{operator.ApplicationNameLabelKey: queryApplicationNameLabelValue}),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(tq),
+	)
+
+	return svc
+}
+
+func makeQuerySelectorLabels(name string) map[string]string {
+	return map[string]string{
+		operator.ApplicationNameLabelKey:     queryApplicationNameLabelValue,
+		operator.ManagedByLabelKey:           operator.ManagedByLabelValue,
+		operator.ApplicationInstanceLabelKey: name,
+		"thanos-query":                       name,
+	}
+}
+
+func queryPrefixedName(name string) string {
+	return fmt.Sprintf("thanos-query-%s", name)
+}
+
+func queryTLSAssetsSecretName(name string) string {
+	return fmt.Sprintf("%s-tls-assets", queryPrefixedName(name))
+}
+
+func queryEndpointsSecretName(name string) string {
+	return fmt.Sprintf("%s-endpoints", queryPrefixedName(name))
+}
+
+func queryWebConfigSecretName(name string) string {
+	return fmt.Sprintf("%s-web-config", queryPrefixedName(name))
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// querySDFile is the key of the ruler config secret containing the
+// discovered Thanos Query endpoints. The file uses the file-based service
+// discovery format and is reloaded by Thanos Ruler when it changes.
+const querySDFile = "query-sd.yaml"
+
+// discoverQueryEndpoints returns the sorted list of HTTP addresses of the
+// Services selected by the query discovery configuration of the ThanosRuler
+// object.
+//
+// Services which don't exist (yet) or which don't expose the expected port
+// are skipped.
+func (o *Operator) discoverQueryEndpoints(tr *monitoringv1.ThanosRuler) ([]string, error) {
+	qd := tr.Spec.QueryDiscovery
+	if qd == nil {
+		return nil, nil
+	}
+
+	var services []*v1.Service
+	for _, ref := range qd.Services {
+		key := ptr.Deref(ref.Namespace, tr.Namespace) + "/" + ref.Name
+		obj, err := o.svcInfs.Get(key)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				o.logger.Debug("query Service not found", "service", key, "thanos", tr.Name, "namespace", tr.Namespace)
+				continue
+			}
+			return nil, fmt.Errorf("failed to retrieve Service %q: %w", key, err)
+		}
+
+		services = append(services, obj.(*v1.Service))
+	}
+
+	if qd.ServiceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(qd.ServiceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid service selector: %w", err)
+		}
+
+		namespaces := []string{tr.Namespace}
+		if qd.ServiceNamespaceSelector != nil {
+			nsSelector, err := metav1.LabelSelectorAsSelector(qd.ServiceNamespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid service namespace selector: %w", err)
+			}
+
+			namespaces, err = operator.ListMatchingNamespaces(nsSelector, o.nsRuleInf)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, ns := range namespaces {
+			err := o.svcInfs.ListAllByNamespace(ns, selector, func(obj any) {
+				services = append(services, obj.(*v1.Service))
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list Services in namespace %s: %w", ns, err)
+			}
+		}
+	}
+
+	portName := ptr.Deref(qd.Port, defaultPortName)
+	endpoints := make([]string, 0, len(services))
+	for _, svc := range services {
+		if svc.DeletionTimestamp != nil {
+			continue
+		}
+
+		i := slices.IndexFunc(svc.Spec.Ports, func(p v1.ServicePort) bool { return p.Name == portName })
+		if i < 0 {
+			o.logger.Warn("query Service has no matching port", "service", svc.Namespace+"/"+svc.Name, "port", portName, "thanos", tr.Name, "namespace", tr.Namespace)
+			continue
+		}
+
+		endpoints = append(endpoints, o.serviceEndpoint(svc.Name, svc.Namespace, svc.Spec.Ports[i].Port))
+	}
+
+	slices.Sort(endpoints)
+	return slices.Compact(endpoints), nil
+}
+
+// serviceEndpoint returns the address of a Service port.
+func (o *Operator) serviceEndpoint(name, namespace string, port int32) string {
+	host := fmt.Sprintf("%s.%s.svc", name, namespace)
+	if o.clusterDomain != "" {
+		host += "." + o.clusterDomain
+	}
+
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+// enqueueForServiceNamespace enqueues the ThanosRuler objects which may
+// discover query Services in the given namespace.
+func (o *Operator) enqueueForServiceNamespace(nsName string) {
+	nsObject, exists, err := o.nsRuleInf.GetStore().GetByKey(nsName)
+	if err != nil {
+		o.logger.Error("get namespace to enqueue ThanosRuler instances failed",
+			"err", err,
+		)
+		return
+	}
+	if !exists {
+		o.logger.Error("get namespace to enqueue ThanosRuler instances failed: namespace does not exist",
+			"namespace", nsName,
+		)
+		return
+	}
+	ns := nsObject.(*v1.Namespace)
+
+	err = o.thanosRulerInfs.ListAll(labels.Everything(), func(obj any) {
+		tr := obj.(*monitoringv1.ThanosRuler)
+		if selectsServiceNamespace(tr, ns, o.logger) {
+			o.rr.EnqueueForReconciliation(tr)
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosRuler instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// selectsServiceNamespace returns true if the query discovery configuration
+// of the ThanosRuler object may select Services in the given namespace.
+func selectsServiceNamespace(tr *monitoringv1.ThanosRuler, ns *v1.Namespace, logger *slog.Logger) bool {
+	qd := tr.Spec.QueryDiscovery
+	if qd == nil {
+		return false
+	}
+
+	for _, ref := range qd.Services {
+		if ptr.Deref(ref.Namespace, tr.Namespace) == ns.Name {
+			return true
+		}
+	}
+
+	if qd.ServiceSelector == nil {
+		return false
+	}
+
+	if qd.ServiceNamespaceSelector == nil {
+		return tr.Namespace == ns.Name
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(qd.ServiceNamespaceSelector)
+	if err != nil {
+		logger.Error("failed to convert service namespace selector",
+			"err", err,
+			"name", tr.Name,
+			"namespace", tr.Namespace,
+		)
+		return false
+	}
+
+	return s.Matches(labels.Set(ns.Labels))
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/promslog"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+func TestDiscoverQueryEndpoints(t *testing.T) {
+	newService := func(ns, name string, labels map[string]string, ports ...v1.ServicePort) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+				Labels:    labels,
+			},
+			Spec: v1.ServiceSpec{
+				Ports: ports,
+			},
+		}
+	}
+	webPort := v1.ServicePort{Name: "web", Port: 9090}
+	httpPort := v1.ServicePort{Name: "http", Port: 10902}
+	query := map[string]string{"app": "thanos-query"}
+
+	kclient := fake.NewSimpleClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"team": "a"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other", Labels: map[string]string{"team": "b"}}},
+		newService("default", "query", query, webPort),
+		newService("default", "query-http", query, httpPort),
+		newService("default", "unrelated", nil, webPort),
+		newService("other", "query", query, webPort, httpPort),
+	)
+
+	svcInfs, err := informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(map[string]struct{}{v1.NamespaceAll: {}}, nil, kclient, 0, nil),
+		v1.SchemeGroupVersion.WithResource(string(v1.ResourceServices)),
+	)
+	require.NoError(t, err)
+
+	o := &Operator{
+		logger:    promslog.NewNopLogger(),
+		svcInfs:   svcInfs,
+		nsRuleInf: kubeinformers.NewSharedInformerFactory(kclient, 0).Core().V1().Namespaces().Informer(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	o.svcInfs.Start(ctx.Done())
+	go o.nsRuleInf.Run(ctx.Done())
+	require.Eventually(t, func() bool {
+		return o.svcInfs.HasSynced() && o.nsRuleInf.HasSynced()
+	}, time.Minute, 10*time.Millisecond)
+
+	for _, tc := range []struct {
+		name          string
+		clusterDomain string
+		discovery     *monitoringv1.ThanosRulerQueryDiscovery
+		expected      []string
+	}{
+		{
+			name: "no discovery",
+		},
+		{
+			name: "services by name",
+			discovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				Services: []monitoringv1.ThanosRulerQueryService{
+					{Name: "query"},
+					{Name: "query", Namespace: ptr.To("other")},
+					{Name: "missing"},
+				},
+			},
+			expected: []string{
+				"query.default.svc:9090",
+				"query.other.svc:9090",
+			},
+		},
+		{
+			name: "selector in the same namespace",
+			discovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				ServiceSelector: &metav1.LabelSelector{MatchLabels: query},
+			},
+			expected: []string{
+				"query.default.svc:9090",
+			},
+		},
+		{
+			name:          "selector in all namespaces with custom port and cluster domain",
+			clusterDomain: "cluster.local",
+			discovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				Services:                 []monitoringv1.ThanosRulerQueryService{{Name: "query-http"}},
+				ServiceSelector:          &metav1.LabelSelector{MatchLabels: query},
+				ServiceNamespaceSelector: &metav1.LabelSelector{},
+				Port:                     ptr.To("http"),
+			},
+			expected: []string{
+				"query-http.default.svc.cluster.local:10902",
+				"query.other.svc.cluster.local:10902",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o.clusterDomain = tc.clusterDomain
+
+			endpoints, err := o.discoverQueryEndpoints(&monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ThanosRulerSpec{
+					QueryDiscovery: tc.discovery,
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, endpoints)
+		})
+	}
+}
+
+func TestMakeQuerySDFile(t *testing.T) {
+	b, err := makeQuerySDFile(nil)
+	require.NoError(t, err)
+	require.Equal(t, "[]\n", string(b))
+
+	b, err = makeQuerySDFile([]string{"a:9090", "b:9090"})
+	require.NoError(t, err)
+	require.Equal(t, "- targets:\n  - a:9090\n  - b:9090\n", string(b))
+}
+
+func TestSelectsServiceNamespace(t *testing.T) {
+	ns := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other", Labels: map[string]string{"team": "b"}}}
+
+	for _, tc := range []struct {
+		name      string
+		discovery *monitoringv1.ThanosRulerQueryDiscovery
+		expected  bool
+	}{
+		{
+			name: "no discovery",
+		},
+		{
+			name: "service in the namespace",
+			discovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				Services: []monitoringv1.ThanosRulerQueryService{{Name: "query", Namespace: ptr.To("other")}},
+			},
+			expected: true,
+		},
+		{
+			name: "service in another namespace",
+			discovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				Services: []monitoringv1.ThanosRulerQueryService{{Name: "query"}},
+			},
+		},
+		{
+			name: "selector without namespace selector",
+			discovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				ServiceSelector: &metav1.LabelSelector{},
+			},
+		},
+		{
+			name: "selector with matching namespace selector",
+			discovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				ServiceSelector:          &metav1.LabelSelector{},
+				ServiceNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+			},
+			expected: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tr := &monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec:       monitoringv1.ThanosRulerSpec{QueryDiscovery: tc.discovery},
+			}
+			require.Equal(t, tc.expected, selectsServiceNamespace(tr, ns, promslog.NewNopLogger()))
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestQueryDiscoverEndpoints(t *testing.T) {
+	newPrometheus := func(ns, name string, mutate func(*monitoringv1.Prometheus)) *monitoringv1.Prometheus {
+		p := &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+				Labels:    map[string]string{"group": "a"},
+			},
+			Spec: monitoringv1.PrometheusSpec{
+				Thanos: &monitoringv1.ThanosSpec{},
+			},
+		}
+		if mutate != nil {
+			mutate(p)
+		}
+		return p
+	}
+
+	kclient := fake.NewSimpleClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"team": "a"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other", Labels: map[string]string{"team": "b"}}},
+	)
+	mclient := monitoringfake.NewSimpleClientset(
+		newPrometheus("default", "sharded", func(p *monitoringv1.Prometheus) {
+			p.Spec.Shards = ptr.To(int32(2))
+			p.Spec.Replicas = ptr.To(int32(2))
+		}),
+		newPrometheus("default", "local", func(p *monitoringv1.Prometheus) {
+			p.Spec.Thanos.GRPCListenLocal = true
+		}),
+		newPrometheus("default", "no-sidecar", func(p *monitoringv1.Prometheus) {
+			p.Spec.Thanos = nil
+		}),
+		newPrometheus("default", "not-selected", func(p *monitoringv1.Prometheus) {
+			p.Labels["group"] = "b"
+		}),
+		newPrometheus("other", "remote", func(p *monitoringv1.Prometheus) {
+			p.Spec.ServiceName = ptr.To("custom")
+		}),
+		&monitoringv1.ThanosRuler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rules",
+				Namespace: "default",
+				Labels:    map[string]string{"group": "a"},
+			},
+			Spec: monitoringv1.ThanosRulerSpec{
+				Replicas: ptr.To(int32(2)),
+				Shards:   ptr.To(int32(2)),
+			},
+		},
+		&monitoringv1.ThanosRuler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "stateless",
+				Namespace: "default",
+				Labels:    map[string]string{"group": "a"},
+			},
+			Spec: monitoringv1.ThanosRulerSpec{
+				RemoteWrite: []monitoringv1.RemoteWriteSpec{{URL: "http://example.com"}},
+			},
+		},
+	)
+
+	allNamespaces := map[string]struct{}{v1.NamespaceAll: {}}
+	newInformers := func(resource string) *informers.ForResource {
+		infs, err := informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(allNamespaces, nil, mclient, 0, nil),
+			monitoringv1.SchemeGroupVersion.WithResource(resource),
+		)
+		require.NoError(t, err)
+		return infs
+	}
+
+	o := &QueryOperator{
+		promInfs:        newInformers(monitoringv1.PrometheusName),
+		thanosRulerInfs: newInformers(monitoringv1.ThanosRulerName),
+		nsInf:           kubeinformers.NewSharedInformerFactory(kclient, 0).Core().V1().Namespaces().Informer(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	o.promInfs.Start(ctx.Done())
+	o.thanosRulerInfs.Start(ctx.Done())
+	go o.nsInf.Run(ctx.Done())
+	require.Eventually(t, func() bool {
+		return o.promInfs.HasSynced() && o.thanosRulerInfs.HasSynced() && o.nsInf.HasSynced()
+	}, time.Minute, 10*time.Millisecond)
+
+	groupA := &metav1.LabelSelector{MatchLabels: map[string]string{"group": "a"}}
+
+	for _, tc := range []struct {
+		name          string
+		clusterDomain string
+		spec          monitoringv1alpha1.ThanosQuerySpec
+		expected      []string
+	}{
+		{
+			name: "no selectors",
+			spec: monitoringv1alpha1.ThanosQuerySpec{
+				Endpoints: []string{"store:10901"},
+			},
+			expected: []string{"store:10901"},
+		},
+		{
+			name: "prometheus in the same namespace",
+			spec: monitoringv1alpha1.ThanosQuerySpec{
+				PrometheusSelector: groupA,
+			},
+			expected: []string{
+				"prometheus-sharded-0.prometheus-operated.default.svc:10901",
+				"prometheus-sharded-1.prometheus-operated.default.svc:10901",
+				"prometheus-sharded-shard-1-0.prometheus-operated.default.svc:10901",
+				"prometheus-sharded-shard-1-1.prometheus-operated.default.svc:10901",
+			},
+		},
+		{
+			name: "prometheus in all namespaces and thanos ruler",
+			spec: monitoringv1alpha1.ThanosQuerySpec{
+				PrometheusSelector:          groupA,
+				PrometheusNamespaceSelector: &metav1.LabelSelector{},
+				ThanosRulerSelector:         &metav1.LabelSelector{},
+				Endpoints:                   []string{"store:10901", "prometheus-remote-0.custom.other.svc:10901"},
+			},
+			expected: []string{
+				"prometheus-remote-0.custom.other.svc:10901",
+				"prometheus-sharded-0.prometheus-operated.default.svc:10901",
+				"prometheus-sharded-1.prometheus-operated.default.svc:10901",
+				"prometheus-sharded-shard-1-0.prometheus-operated.default.svc:10901",
+				"prometheus-sharded-shard-1-1.prometheus-operated.default.svc:10901",
+				"thanos-ruler-rules-0.thanos-ruler-operated.default.svc:10901",
+				"thanos-ruler-rules-1.thanos-ruler-operated.default.svc:10901",
+				"thanos-ruler-rules-shard-1-0.thanos-ruler-operated.default.svc:10901",
+				"thanos-ruler-rules-shard-1-1.thanos-ruler-operated.default.svc:10901",
+				"store:10901",
+			},
+		},
+		{
+			name:          "namespace selector with cluster domain",
+			clusterDomain: "cluster.local",
+			spec: monitoringv1alpha1.ThanosQuerySpec{
+				PrometheusSelector:          &metav1.LabelSelector{},
+				PrometheusNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+			},
+			expected: []string{
+				"prometheus-remote-0.custom.other.svc.cluster.local:10901",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o.clusterDomain = tc.clusterDomain
+
+			endpoints, err := o.discoverEndpoints(&monitoringv1alpha1.ThanosQuery{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: tc.spec,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, endpoints)
+		})
+	}
+}
+
+func TestMakeQueryDeployment(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuery{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			Labels:    map[string]string{"foo": "bar"},
+		},
+		Spec: monitoringv1alpha1.ThanosQuerySpec{
+			Replicas: ptr.To(int32(2)),
+			PodMetadata: &monitoringv1.EmbeddedObjectMetadata{
+				Labels: map[string]string{"thanos-query": "overridden", "pod": "label"},
+			},
+			GRPCServerTLSConfig: &monitoringv1.TLSConfig{
+				CertFile: "/etc/certs/tls.crt",
+				KeyFile:  "/etc/certs/tls.key",
+				CAFile:   "/etc/certs/ca.crt",
+			},
+			GRPCClientTLSConfig: &monitoringv1.TLSConfig{
+				SafeTLSConfig: monitoringv1.SafeTLSConfig{
+					CA: monitoringv1.SecretOrConfigMap{
+						ConfigMap: &v1.ConfigMapKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "ca"},
+							Key:                  "ca.crt",
+						},
+					},
+					ServerName: ptr.To("thanos.example.com"),
+				},
+			},
+		},
+	}
+
+	deploy, err := makeQueryDeployment(
+		tq,
+		Config{},
+		"abc",
+		&operator.ShardedSecret{},
+		assets.NewTestStoreBuilder().ForNamespace("default"),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "thanos-query-test", deploy.Name)
+	require.Equal(t, "abc", deploy.Annotations[operator.InputHashAnnotationKey])
+	require.Equal(t, "bar", deploy.Labels["foo"])
+	require.Equal(t, int32(2), *deploy.Spec.Replicas)
+	require.Equal(t, makeQuerySelectorLabels("test"), deploy.Spec.Selector.MatchLabels)
+	require.Equal(t, "test", deploy.Spec.Template.Labels["thanos-query"])
+	require.Equal(t, "label", deploy.Spec.Template.Labels["pod"])
+	require.Equal(t, operator.ManagedByLabelValue, deploy.Spec.Template.Labels[operator.ManagedByLabelKey])
+
+	require.Len(t, deploy.Spec.Template.Spec.Containers, 1)
+	require.Equal(t, []string{
+		"query",
+		"--query.replica-label=prometheus_replica",
+		"--query.replica-label=thanos_ruler_replica",
+		"--grpc-server-tls-cert=/etc/certs/tls.crt",
+		"--grpc-server-tls-key=/etc/certs/tls.key",
+		"--grpc-server-tls-client-ca=/etc/certs/ca.crt",
+		"--grpc-client-tls-secure",
+		"--grpc-client-tls-ca=/etc/thanos/certs/1_default_ca_ca.crt",
+		"--grpc-client-server-name=thanos.example.com",
+		"--store.sd-files=/etc/thanos/config/endpoints-sd-config/endpoints-sd.yaml",
+		"--http.config=/etc/thanos/web_config/web-config.yaml",
+	}, deploy.Spec.Template.Spec.Containers[0].Args)
+
+	// The endpoints file is mounted without subPath so that the kubelet
+	// updates it.
+	require.Contains(t, deploy.Spec.Template.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+		Name:      "endpoints-sd-config",
+		MountPath: "/etc/thanos/config/endpoints-sd-config",
+		ReadOnly:  true,
+	})
+}
+
+func TestMakeQueryDeploymentAdditionalArgsConflict(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuery{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1alpha1.ThanosQuerySpec{
+			AdditionalArgs: []monitoringv1.Argument{{Name: "store.sd-files", Value: "/tmp/sd.yaml"}},
+		},
+	}
+
+	_, err := makeQueryDeployment(tq, Config{}, "", &operator.ShardedSecret{}, assets.NewTestStoreBuilder().ForNamespace("default"))
+	require.Error(t, err)
+}
+
+func TestCreateOrUpdateEndpointsSecret(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuery{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+
+	kclient := fake.NewSimpleClientset()
+	o := &QueryOperator{kclient: kclient}
+
+	for _, tc := range []struct {
+		endpoints []string
+		expected  string
+	}{
+		{
+			expected: "[]\n",
+		},
+		{
+			endpoints: []string{"a:10901", "b:10901"},
+			expected:  "- targets:\n  - a:10901\n  - b:10901\n",
+		},
+	} {
+		require.NoError(t, o.createOrUpdateEndpointsSecret(context.Background(), tq, tc.endpoints))
+
+		s, err := kclient.CoreV1().Secrets("default").Get(context.Background(), "thanos-query-test-endpoints", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, string(s.Data["endpoints-sd.yaml"]))
+	}
+}
@@ -19,22 +19,29 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"strconv"
 	"strings"
 
+	"github.com/cespare/xxhash/v2"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	namespacelabeler "github.com/prometheus-operator/prometheus-operator/pkg/namespacelabeler"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
 const labelThanosRulerName = "thanos-ruler-name"
 
-func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitoringv1.ThanosRuler) ([]string, error) {
+// createOrUpdateRuleConfigMaps reconciles the rule ConfigMaps of all the
+// shards and returns the ConfigMap names indexed by shard.
+func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitoringv1.ThanosRuler) ([][]string, error) {
 	cClient := o.kclient.CoreV1().ConfigMaps(t.Namespace)
 
 	namespaces, err := o.selectRuleNamespaces(t)
@@ -67,21 +74,61 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		return nil, fmt.Errorf("initializing PrometheusRules failed: %w", err)
 	}
 
-	newRules, rejected, err := promRuleSelector.Select(namespaces)
+	selection, err := promRuleSelector.SelectRules(namespaces)
 	if err != nil {
 		return nil, fmt.Errorf("selecting PrometheusRules failed: %w", err)
 	}
 
 	if tKey, ok := o.accessor.MetaNamespaceKey(t); ok {
-		o.metrics.SetSelectedResources(tKey, monitoringv1.PrometheusRuleKind, len(newRules))
-		o.metrics.SetRejectedResources(tKey, monitoringv1.PrometheusRuleKind, rejected)
+		o.metrics.SetSelectedResources(tKey, monitoringv1.PrometheusRuleKind, len(selection.RuleFiles))
+		o.metrics.SetRejectedResources(tKey, monitoringv1.PrometheusRuleKind, len(selection.Rejected))
+	}
+
+	shardedRules, err := shardRuleFiles(selection, shardsNumber(t))
+	if err != nil {
+		return nil, fmt.Errorf("failed to distribute the rule groups across shards: %w", err)
 	}
 
 	currentConfigMapList, err := cClient.List(ctx, prometheusRulesConfigMapSelector(t.Name))
 	if err != nil {
 		return nil, err
 	}
-	currentConfigMaps := currentConfigMapList.Items
+
+	// ConfigMaps created before sharding was supported have no shard label
+	// and belong to the first shard.
+	currentConfigMaps := map[int32][]v1.ConfigMap{}
+	for _, cm := range currentConfigMapList.Items {
+		shard, err := strconv.ParseInt(cm.Labels[prompkg.ShardLabelName], 10, 32)
+		if err != nil {
+			shard = 0
+		}
+		currentConfigMaps[int32(shard)] = append(currentConfigMaps[int32(shard)], cm)
+	}
+
+	configMapNames := make([][]string, len(shardedRules))
+	for shard, newRules := range shardedRules {
+		configMapNames[shard], err = o.createOrUpdateShardRuleConfigMaps(ctx, t, int32(shard), newRules, currentConfigMaps[int32(shard)])
+		if err != nil {
+			return nil, err
+		}
+		delete(currentConfigMaps, int32(shard))
+	}
+
+	// Delete the ConfigMaps of the shards which don't exist anymore.
+	for _, cms := range currentConfigMaps {
+		for _, cm := range cms {
+			if err := cClient.Delete(ctx, cm.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to delete ConfigMap '%v': %w", cm.Name, err)
+			}
+		}
+	}
+
+	return configMapNames, nil
+}
+
+func (o *Operator) createOrUpdateShardRuleConfigMaps(ctx context.Context, t *monitoringv1.ThanosRuler, shard int32, newRules map[string]string, currentConfigMaps []v1.ConfigMap) ([]string, error) {
+	cClient := o.kclient.CoreV1().ConfigMaps(t.Namespace)
+	logger := o.logger.With("namespace", t.Namespace, "thanos", t.Name, "shard", shard)
 
 	currentRules := map[string]string{}
 	for _, cm := range currentConfigMaps {
@@ -90,10 +137,7 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 
 	equal := reflect.DeepEqual(newRules, currentRules)
 	if equal && len(currentConfigMaps) != 0 {
-		o.logger.Debug("no PrometheusRule changes",
-			"namespace", t.Namespace,
-			"thanos", t.Name,
-		)
+		logger.Debug("no PrometheusRule changes")
 		currentConfigMapNames := make([]string, 0, len(currentConfigMaps))
 		for _, cm := range currentConfigMaps {
 			currentConfigMapNames = append(currentConfigMapNames, cm.Name)
@@ -103,6 +147,7 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 
 	newConfigMaps, err := makeRulesConfigMaps(
 		t,
+		shard,
 		newRules,
 		operator.WithAnnotations(o.config.Annotations),
 		operator.WithLabels(o.config.Labels),
@@ -117,10 +162,7 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 	}
 
 	if len(currentConfigMaps) == 0 {
-		o.logger.Debug("no PrometheusRule configmap found, creating new one",
-			"namespace", t.Namespace,
-			"thanos", t.Name,
-		)
+		logger.Debug("no PrometheusRule configmap found, creating new one")
 		for _, cm := range newConfigMaps {
 			_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 			if err != nil {
@@ -139,10 +181,7 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 		}
 	}
 
-	o.logger.Debug("updating PrometheusRule",
-		"namespace", t.Namespace,
-		"thanos", t.Name,
-	)
+	logger.Debug("updating PrometheusRule")
 	for _, cm := range newConfigMaps {
 		_, err = cClient.Create(ctx, &cm, metav1.CreateOptions{})
 		if err != nil {
@@ -153,6 +192,56 @@ func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitori
 	return newConfigMapNames, nil
 }
 
+// shardRuleFiles distributes the rule groups of the selected rule files
+// across the shards and returns the rule files indexed by shard.
+//
+// A rule group is assigned to a shard by hashing the namespace and name of
+// the PrometheusRule object together with the group name which guarantees
+// that the assignment is stable across reconciliations. A rule file is
+// omitted from the shards which have no group of the file.
+func shardRuleFiles(selection *operator.PrometheusRuleSelection, shards int32) ([]map[string]string, error) {
+	if shards <= 1 {
+		return []map[string]string{selection.RuleFiles}, nil
+	}
+
+	shardedRules := make([]map[string]string, shards)
+	for i := range shardedRules {
+		shardedRules[i] = map[string]string{}
+	}
+
+	for _, filename := range sortutil.SortedKeys(selection.RuleFiles) {
+		var spec monitoringv1.PrometheusRuleSpec
+		if err := yaml.Unmarshal([]byte(selection.RuleFiles[filename]), &spec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rule file %q: %w", filename, err)
+		}
+
+		var prefix string
+		if src := selection.Sources[filename]; src != nil {
+			prefix = src.Namespace + "/" + src.Name + "/"
+		}
+
+		groups := make([][]monitoringv1.RuleGroup, shards)
+		for _, g := range spec.Groups {
+			shard := xxhash.Sum64String(prefix+g.Name) % uint64(shards)
+			groups[shard] = append(groups[shard], g)
+		}
+
+		for shard := range groups {
+			if len(groups[shard]) == 0 {
+				continue
+			}
+
+			content, err := yaml.Marshal(monitoringv1.PrometheusRuleSpec{Groups: groups[shard]})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal rule file %q: %w", filename, err)
+			}
+			shardedRules[shard][filename] = string(content)
+		}
+	}
+
+	return shardedRules, nil
+}
+
 func prometheusRulesConfigMapSelector(thanosRulerName string) metav1.ListOptions {
 	return metav1.ListOptions{LabelSelector: fmt.Sprintf("%v=%v", labelThanosRulerName, thanosRulerName)}
 }
@@ -192,7 +281,7 @@ func (o *Operator) selectRuleNamespaces(p *monitoringv1.ThanosRuler) ([]string,
 // future this can be replaced by a more sophisticated algorithm, but for now
 // simplicity should be sufficient.
 // [1] https://en.wikipedia.org/wiki/Bin_packing_problem#First-fit_algorithm
-func makeRulesConfigMaps(t *monitoringv1.ThanosRuler, ruleFiles map[string]string, opts ...operator.ObjectOption) ([]v1.ConfigMap, error) {
+func makeRulesConfigMaps(t *monitoringv1.ThanosRuler, shard int32, ruleFiles map[string]string, opts ...operator.ObjectOption) ([]v1.ConfigMap, error) {
 
 	buckets := []map[string]string{
 		{},
@@ -220,9 +309,12 @@ func makeRulesConfigMaps(t *monitoringv1.ThanosRuler, ruleFiles map[string]strin
 		)
 		operator.UpdateObject(
 			&cm,
-			operator.WithName(fmt.Sprintf("thanos-ruler-%s-rulefiles-%d", t.Name, i)),
+			operator.WithName(fmt.Sprintf("%s-rulefiles-%d", statefulSetNameByShard(t.Name, shard), i)),
 			operator.WithManagingOwner(t),
-			operator.WithLabels(map[string]string{labelThanosRulerName: t.Name}),
+			operator.WithLabels(map[string]string{
+				labelThanosRulerName:   t.Name,
+				prompkg.ShardLabelName: strconv.Itoa(int(shard)),
+			}),
 		)
 
 		ruleFileConfigMaps = append(ruleFileConfigMaps, cm)
@@ -0,0 +1,110 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestShardRuleFiles(t *testing.T) {
+	rule := &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rules",
+			Namespace: "default",
+		},
+	}
+	for i := range 20 {
+		rule.Spec.Groups = append(rule.Spec.Groups, monitoringv1.RuleGroup{
+			Name:  fmt.Sprintf("group-%d", i),
+			Rules: []monitoringv1.Rule{{Record: fmt.Sprintf("rule_%d", i)}},
+		})
+	}
+
+	content, err := yaml.Marshal(rule.Spec)
+	require.NoError(t, err)
+
+	selection := &operator.PrometheusRuleSelection{
+		RuleFiles: map[string]string{"default-rules.yaml": string(content)},
+		Sources:   map[string]*monitoringv1.PrometheusRule{"default-rules.yaml": rule},
+	}
+
+	// Without sharding, the rule files are unchanged.
+	shardedRules, err := shardRuleFiles(selection, 1)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]string{selection.RuleFiles}, shardedRules)
+
+	shardedRules, err = shardRuleFiles(selection, 3)
+	require.NoError(t, err)
+	require.Len(t, shardedRules, 3)
+
+	// Every group is assigned to exactly one shard.
+	groups := map[string]int{}
+	for shard, ruleFiles := range shardedRules {
+		for _, content := range ruleFiles {
+			var spec monitoringv1.PrometheusRuleSpec
+			require.NoError(t, yaml.Unmarshal([]byte(content), &spec))
+
+			for _, g := range spec.Groups {
+				_, found := groups[g.Name]
+				require.False(t, found, "group %s assigned to more than one shard", g.Name)
+				groups[g.Name] = shard
+			}
+		}
+	}
+	require.Len(t, groups, len(rule.Spec.Groups))
+
+	// The assignment is deterministic.
+	again, err := shardRuleFiles(selection, 3)
+	require.NoError(t, err)
+	require.Equal(t, shardedRules, again)
+}
+
+func TestMakeRulesConfigMapsShard(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			Shards: ptr.To(int32(2)),
+		},
+	}
+
+	for _, tc := range []struct {
+		shard    int32
+		expected string
+	}{
+		{shard: 0, expected: "thanos-ruler-test-rulefiles-0"},
+		{shard: 1, expected: "thanos-ruler-test-shard-1-rulefiles-0"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			cms, err := makeRulesConfigMaps(tr, tc.shard, map[string]string{"rules.yaml": "groups: []"})
+			require.NoError(t, err)
+			require.Len(t, cms, 1)
+			require.Equal(t, tc.expected, cms[0].Name)
+			require.Equal(t, "test", cms[0].Labels[labelThanosRulerName])
+			require.Equal(t, fmt.Sprintf("%d", tc.shard), cms[0].Labels["operator.prometheus.io/shard"])
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
 )
 
@@ -49,6 +50,7 @@ const (
 	defaultRetention          = "24h"
 	defaultEvaluationInterval = "15s"
 	defaultReplicaLabelName   = "thanos_ruler_replica"
+	shardExternalLabelName    = "thanos_ruler_shard"
 
 	defaultTerminationGracePeriodSeconds = int64(120)
 )
@@ -57,7 +59,7 @@ var (
 	minReplicas int32 = 1
 )
 
-func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, inputHash string, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSet, error) {
+func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, inputHash string, shard int32, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSet, error) {
 
 	if tr.Spec.Resources.Requests == nil {
 		tr.Spec.Resources.Requests = v1.ResourceList{}
@@ -66,7 +68,7 @@ func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapN
 		tr.Spec.Resources.Requests[v1.ResourceMemory] = resource.MustParse("200Mi")
 	}
 
-	spec, err := makeStatefulSetSpec(tr, config, ruleConfigMapNames, tlsSecrets)
+	spec, err := makeStatefulSetSpec(tr, config, ruleConfigMapNames, shard, tlsSecrets)
 	if err != nil {
 		return nil, err
 	}
@@ -74,12 +76,13 @@ func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapN
 	statefulset := &appsv1.StatefulSet{Spec: *spec}
 	operator.UpdateObject(
 		statefulset,
-		operator.WithName(prefixedName(tr.Name)),
+		operator.WithName(statefulSetNameByShard(tr.Name, shard)),
 		operator.WithAnnotations(tr.GetAnnotations()),
 		operator.WithAnnotations(config.Annotations),
 		operator.WithInputHashAnnotation(inputHash),
 		operator.WithLabels(tr.GetLabels()),
 		operator.WithSelectorLabels(spec.Selector),
+		operator.WithLabels(map[string]string{prompkg.ShardLabelName: strconv.Itoa(int(shard))}),
 		operator.WithLabels(config.Labels),
 		operator.WithManagingOwner(tr),
 		operator.WithoutKubectlAnnotations(),
@@ -89,57 +92,20 @@ func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapN
 		statefulset.Spec.Template.Spec.ImagePullSecrets = tr.Spec.ImagePullSecrets
 	}
 
-	storageSpec := tr.Spec.Storage
-	switch {
-	case storageSpec == nil:
-		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
-			Name: volumeName(tr.Name),
-			VolumeSource: v1.VolumeSource{
-				EmptyDir: &v1.EmptyDirVolumeSource{},
-			},
-		})
-
-	case storageSpec.EmptyDir != nil:
-		emptyDir := storageSpec.EmptyDir
-		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
-			Name: volumeName(tr.Name),
-			VolumeSource: v1.VolumeSource{
-				EmptyDir: emptyDir,
-			},
-		})
-
-	case storageSpec.Ephemeral != nil:
-		ephemeral := storageSpec.Ephemeral
-		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
-			Name: volumeName(tr.Name),
-			VolumeSource: v1.VolumeSource{
-				Ephemeral: ephemeral,
-			},
-		})
-
-	default: // storageSpec.VolumeClaimTemplate
-		pvcTemplate := operator.MakeVolumeClaimTemplate(storageSpec.VolumeClaimTemplate)
-		if pvcTemplate.Name == "" {
-			pvcTemplate.Name = volumeName(tr.Name)
-		}
-		if storageSpec.VolumeClaimTemplate.Spec.AccessModes == nil {
-			pvcTemplate.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
-		} else {
-			pvcTemplate.Spec.AccessModes = storageSpec.VolumeClaimTemplate.Spec.AccessModes
-		}
-		pvcTemplate.Spec.Resources = storageSpec.VolumeClaimTemplate.Spec.Resources
-		pvcTemplate.Spec.Selector = storageSpec.VolumeClaimTemplate.Spec.Selector
-		statefulset.Spec.VolumeClaimTemplates = append(statefulset.Spec.VolumeClaimTemplates, *pvcTemplate)
-	}
+	addStorageVolume(statefulset, dataStorage(tr), volumeName(tr.Name))
 
 	statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, tr.Spec.Volumes...)
 
 	return statefulset, nil
 }
 
-func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSetSpec, error) {
-	if tr.Spec.QueryConfig == nil && len(tr.Spec.QueryEndpoints) < 1 {
-		return nil, errors.New(tr.GetName() + ": thanos ruler requires query config or at least one query endpoint to be specified")
+func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, shard int32, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSetSpec, error) {
+	if tr.Spec.QueryConfig == nil && len(tr.Spec.QueryEndpoints) < 1 && tr.Spec.QueryDiscovery == nil {
+		return nil, errors.New(tr.GetName() + ": thanos ruler requires query config, query discovery or at least one query endpoint to be specified")
+	}
+
+	if tr.Spec.QueryConfig != nil && tr.Spec.QueryDiscovery != nil {
+		return nil, errors.New(tr.GetName() + ": query config and query discovery can't be set at the same time")
 	}
 
 	thanosVersion := operator.StringValOrDefault(ptr.Deref(tr.Spec.Version, ""), operator.DefaultThanosVersion)
@@ -203,6 +169,9 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 	}
 
 	trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "label", Value: fmt.Sprintf(`%s="$(POD_NAME)"`, defaultReplicaLabelName)})
+	if shardsNumber(tr) > 1 {
+		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "label", Value: fmt.Sprintf(`%s="%d"`, shardExternalLabelName, shard)})
+	}
 	labels := operator.Map(tr.Spec.Labels)
 	for _, k := range labels.SortedKeys() {
 		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "label", Value: fmt.Sprintf(`%s="%s"`, k, labels[k])})
@@ -264,6 +233,21 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		}
 	}
 
+	if tr.Spec.QueryDiscovery != nil {
+		trVolumes, trVolumeMounts, fullPath = mountSecretKey(
+			trVolumes,
+			trVolumeMounts,
+			&v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{
+					Name: rulerConfigSecretName(tr.Name),
+				},
+				Key: querySDFile,
+			},
+			"query-sd-config",
+		)
+		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "query.sd-files", Value: fullPath})
+	}
+
 	if tr.Spec.AlertManagersConfig != nil {
 		trVolumes, trVolumeMounts, fullPath = mountSecretKey(trVolumes, trVolumeMounts, tr.Spec.AlertManagersConfig, "alertmanager-config")
 		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alertmanagers.config-file", Value: fullPath})
@@ -273,11 +257,15 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 		}
 	}
 
-	if tr.Spec.ObjectStorageConfigFile != nil {
-		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: *tr.Spec.ObjectStorageConfigFile})
-	} else if tr.Spec.ObjectStorageConfig != nil {
-		trVolumes, trVolumeMounts, fullPath = mountSecretKey(trVolumes, trVolumeMounts, tr.Spec.ObjectStorageConfig, "objstorage-config")
-		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: fullPath})
+	// In stateless mode, the rule evaluations are remote-written and there
+	// are no TSDB blocks to upload.
+	if !isStateless(tr) {
+		if tr.Spec.ObjectStorageConfigFile != nil {
+			trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: *tr.Spec.ObjectStorageConfigFile})
+		} else if tr.Spec.ObjectStorageConfig != nil {
+			trVolumes, trVolumeMounts, fullPath = mountSecretKey(trVolumes, trVolumeMounts, tr.Spec.ObjectStorageConfig, "objstorage-config")
+			trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: fullPath})
+		}
 	}
 
 	if tr.Spec.TracingConfigFile != "" {
@@ -418,16 +406,17 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 	finalLabels := config.Labels.Merge(podLabels)
 	maps.Copy(finalLabels, selectorLabels)
 
+	// All shards select the shard label so that the selectors of the
+	// different shards are disjoint. The statefulset of the first shard
+	// created before sharding was supported doesn't select it and it gets
+	// recreated by the operator.
+	finalLabels[prompkg.ShardLabelName] = strconv.Itoa(int(shard))
+	finalSelectorLabels := maps.Clone(finalLabels)
+
 	podAnnotations[operator.DefaultContainerAnnotationKey] = "thanos-ruler"
 
-	storageVolName := volumeName(tr.Name)
-	if tr.Spec.Storage != nil {
-		if tr.Spec.Storage.VolumeClaimTemplate.Name != "" {
-			storageVolName = tr.Spec.Storage.VolumeClaimTemplate.Name
-		}
-	}
 	trVolumeMounts = append(trVolumeMounts, v1.VolumeMount{
-		Name:      storageVolName,
+		Name:      storageVolumeName(dataStorage(tr), volumeName(tr.Name)),
 		MountPath: storageDir,
 	})
 
@@ -487,7 +476,7 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 			Type: appsv1.RollingUpdateStatefulSetStrategyType,
 		},
 		Selector: &metav1.LabelSelector{
-			MatchLabels: finalLabels,
+			MatchLabels: finalSelectorLabels,
 		},
 		Template: v1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
@@ -559,10 +548,115 @@ func makeStatefulSetService(tr *monitoringv1.ThanosRuler, config Config) *v1.Ser
 	return svc
 }
 
+// addStorageVolume adds the volume (or the volume claim template) backing the
+// data directory to the statefulset.
+func addStorageVolume(statefulset *appsv1.StatefulSet, storageSpec *monitoringv1.StorageSpec, volName string) {
+	switch {
+	case storageSpec == nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volName,
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+
+	case storageSpec.EmptyDir != nil:
+		emptyDir := storageSpec.EmptyDir
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volName,
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: emptyDir,
+			},
+		})
+
+	case storageSpec.Ephemeral != nil:
+		ephemeral := storageSpec.Ephemeral
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volName,
+			VolumeSource: v1.VolumeSource{
+				Ephemeral: ephemeral,
+			},
+		})
+
+	default: // storageSpec.VolumeClaimTemplate
+		pvcTemplate := operator.MakeVolumeClaimTemplate(storageSpec.VolumeClaimTemplate)
+		if pvcTemplate.Name == "" {
+			pvcTemplate.Name = volName
+		}
+		if storageSpec.VolumeClaimTemplate.Spec.AccessModes == nil {
+			pvcTemplate.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+		} else {
+			pvcTemplate.Spec.AccessModes = storageSpec.VolumeClaimTemplate.Spec.AccessModes
+		}
+		pvcTemplate.Spec.Resources = storageSpec.VolumeClaimTemplate.Spec.Resources
+		pvcTemplate.Spec.Selector = storageSpec.VolumeClaimTemplate.Spec.Selector
+		statefulset.Spec.VolumeClaimTemplates = append(statefulset.Spec.VolumeClaimTemplates, *pvcTemplate)
+	}
+}
+
+// storageVolumeName returns the name of the volume backing the data
+// directory.
+func storageVolumeName(storageSpec *monitoringv1.StorageSpec, volName string) string {
+	if storageSpec != nil && storageSpec.VolumeClaimTemplate.Name != "" {
+		return storageSpec.VolumeClaimTemplate.Name
+	}
+
+	return volName
+}
+
 func prefixedName(name string) string {
 	return fmt.Sprintf("thanos-ruler-%s", name)
 }
 
+// isStateless returns true if the ThanosRuler object runs in stateless mode
+// which is the case when remote write is configured.
+func isStateless(tr *monitoringv1.ThanosRuler) bool {
+	return len(tr.Spec.RemoteWrite) > 0
+}
+
+// dataStorage returns the storage of the data directory. In stateless mode,
+// persistent volume claims are ignored because the pods are managed by a
+// deployment.
+func dataStorage(tr *monitoringv1.ThanosRuler) *monitoringv1.StorageSpec {
+	storage := tr.Spec.Storage
+	if storage != nil && isStateless(tr) && storage.EmptyDir == nil && storage.Ephemeral == nil {
+		return nil
+	}
+
+	return storage
+}
+
+// shardsNumber returns the normalized number of shards.
+func shardsNumber(tr *monitoringv1.ThanosRuler) int32 {
+	if ptr.Deref(tr.Spec.Shards, 1) <= 1 {
+		return 1
+	}
+
+	return *tr.Spec.Shards
+}
+
+// expectedStatefulSetShardNames returns the names of the statefulsets for
+// all the shards of the ThanosRuler object.
+func expectedStatefulSetShardNames(tr *monitoringv1.ThanosRuler) []string {
+	names := make([]string, 0, shardsNumber(tr))
+	for shard := range shardsNumber(tr) {
+		names = append(names, statefulSetNameByShard(tr.Name, shard))
+	}
+
+	return names
+}
+
+// statefulSetNameByShard returns the name of the statefulset for the given
+// shard. The first shard uses the same name as the statefulset created
+// before sharding was supported.
+func statefulSetNameByShard(name string, shard int32) string {
+	if shard == 0 {
+		return prefixedName(name)
+	}
+
+	return fmt.Sprintf("%s-shard-%d", prefixedName(name), shard)
+}
+
 func volumeName(name string) string {
 	return fmt.Sprintf("%s-data", prefixedName(name))
 }
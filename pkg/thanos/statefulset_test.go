@@ -25,6 +25,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -74,11 +75,17 @@ func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
 			Annotations: annotations,
 		},
 		Spec: monitoringv1.ThanosRulerSpec{QueryEndpoints: emptyQueryEndpoints},
-	}, defaultTestConfig, nil, "abc", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "abc", 0, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 
-	require.Equal(t, labels, sset.Labels, pretty.Compare(labels, sset.Labels))
+	expectedLabels := map[string]string{
+		"operator.prometheus.io/shard": "0",
+	}
+	for k, v := range labels {
+		expectedLabels[k] = v
+	}
+	require.Equal(t, expectedLabels, sset.Labels, pretty.Compare(expectedLabels, sset.Labels))
 
 	require.Equal(t, expectedAnnotations, sset.Annotations, pretty.Compare(expectedAnnotations, sset.Annotations))
 }
@@ -99,7 +106,7 @@ func TestPodLabelsAnnotations(t *testing.T) {
 				Labels:      labels,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	valLabel := sset.Spec.Template.ObjectMeta.Labels["testlabel"]
@@ -117,7 +124,7 @@ func TestThanosDefaultBaseImageFlag(t *testing.T) {
 
 	sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
 		Spec: monitoringv1.ThanosRulerSpec{QueryEndpoints: emptyQueryEndpoints},
-	}, thanosBaseImageConfig, nil, "", &operator.ShardedSecret{})
+	}, thanosBaseImageConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	image := sset.Spec.Template.Spec.Containers[0].Image
@@ -252,7 +259,7 @@ func TestStatefulSetVolumes(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, []string{"rules-configmap-one"}, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, []string{"rules-configmap-one"}, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 	require.Equal(t, expected.Spec.Template.Spec.Volumes, sset.Spec.Template.Spec.Volumes)
 	require.Equal(t, expected.Spec.Template.Spec.Containers[0].VolumeMounts, sset.Spec.Template.Spec.Containers[0].VolumeMounts)
@@ -278,7 +285,7 @@ func TestTracing(t *testing.T) {
 				Key: secretKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
@@ -326,7 +333,7 @@ func TestTracingFile(t *testing.T) {
 				Key: testKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	{
@@ -370,7 +377,7 @@ func TestObjectStorage(t *testing.T) {
 				Key: secretKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
@@ -418,7 +425,7 @@ func TestObjectStorageFile(t *testing.T) {
 				Key: testKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	{
@@ -462,7 +469,7 @@ func TestAlertRelabel(t *testing.T) {
 				Key: secretKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
@@ -510,7 +517,7 @@ func TestAlertRelabelFile(t *testing.T) {
 				Key: testKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	{
@@ -605,7 +612,7 @@ func TestLabelsAndAlertDropLabels(t *testing.T) {
 					Labels:          tc.Labels,
 					AlertDropLabels: tc.AlertDropLabels,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 			require.NoError(t, err)
 
 			ruler := sset.Spec.Template.Spec.Containers[0]
@@ -628,7 +635,7 @@ func TestAdditionalContainers(t *testing.T) {
 	// The base to compare everything against
 	baseSet, err := makeStatefulSet(&monitoringv1.ThanosRuler{
 		Spec: monitoringv1.ThanosRulerSpec{QueryEndpoints: emptyQueryEndpoints},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	// Add an extra container
@@ -641,7 +648,7 @@ func TestAdditionalContainers(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Len(t, addSset.Spec.Template.Spec.Containers, len(baseSet.Spec.Template.Spec.Containers)+1)
@@ -659,7 +666,7 @@ func TestAdditionalContainers(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, len(baseSet.Spec.Template.Spec.Containers), len(modSset.Spec.Template.Spec.Containers))
@@ -683,7 +690,7 @@ func TestRetention(t *testing.T) {
 					Retention:      tc.specRetention,
 					QueryEndpoints: emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 
@@ -758,7 +765,7 @@ func TestPodTemplateConfig(t *testing.T) {
 			AdditionalArgs:     additionalArgs,
 			HostUsers:          ptr.To(true),
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, nodeSelector, sset.Spec.Template.Spec.NodeSelector)
@@ -786,7 +793,7 @@ func TestExternalQueryURL(t *testing.T) {
 			AlertQueryURL:  "https://example.com/",
 			QueryEndpoints: emptyQueryEndpoints,
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
 
@@ -810,7 +817,7 @@ func TestSidecarResources(t *testing.T) {
 		}
 		// thanos-ruler sset will only have a configReloader side car
 		// if it has to mount a ConfigMap
-		sset, err := makeStatefulSet(tr, testConfig, []string{"my-configmap"}, "", &operator.ShardedSecret{})
+		sset, err := makeStatefulSet(tr, testConfig, []string{"my-configmap"}, "", 0, &operator.ShardedSecret{})
 		require.NoError(t, err)
 		return sset
 	})
@@ -824,13 +831,13 @@ func TestStatefulSetMinReadySeconds(t *testing.T) {
 		},
 	}
 
-	statefulSet, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 	require.Equal(t, int32(0), statefulSet.MinReadySeconds)
 
 	// assert set correctly if not nil
 	tr.Spec.MinReadySeconds = ptr.To(int32(5))
-	statefulSet, err = makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{})
+	statefulSet, err = makeStatefulSetSpec(&tr, defaultTestConfig, nil, 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 	require.Equal(t, int32(5), statefulSet.MinReadySeconds)
 }
@@ -844,7 +851,7 @@ func TestStatefulSetServiceName(t *testing.T) {
 
 	// assert set correctly
 	expect := governingServiceName
-	spec, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{})
+	spec, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 	require.Equal(t, expect, spec.ServiceName)
 }
@@ -880,7 +887,7 @@ func TestStatefulSetPVC(t *testing.T) {
 				VolumeClaimTemplate: pvc,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 	ssetPvc := sset.Spec.VolumeClaimTemplates[0]
@@ -910,7 +917,7 @@ func TestStatefulEmptyDir(t *testing.T) {
 				EmptyDir: &emptyDir,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 	ssetVolumes := sset.Spec.Template.Spec.Volumes
@@ -948,7 +955,7 @@ func TestStatefulSetEphemeral(t *testing.T) {
 				Ephemeral: &ephemeral,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 	require.NoError(t, err)
 	ssetVolumes := sset.Spec.Template.Spec.Volumes
@@ -975,7 +982,7 @@ func TestThanosVersion(t *testing.T) {
 					QueryEndpoints: emptyQueryEndpoints,
 					Version:        ptr.To(tc.version),
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			if tc.expectedError {
 				require.Error(t, err)
@@ -1006,7 +1013,7 @@ func TestStatefulSetDNSPolicyAndDNSConfig(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 	require.NoError(t, err)
 
 	require.Equal(t, v1.DNSClusterFirst, sset.Spec.Template.Spec.DNSPolicy, "expected DNS policy to match")
@@ -1039,7 +1046,7 @@ func TestStatefulSetenableServiceLinks(t *testing.T) {
 				QueryEndpoints:     emptyQueryEndpoints,
 				EnableServiceLinks: test.enableServiceLinks,
 			},
-		}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 		require.NoError(t, err)
 
 		if test.expectedEnableServiceLinks != nil {
@@ -1086,7 +1093,7 @@ func TestRuleQueryOffset(t *testing.T) {
 					RuleQueryOffset: ts.ruleQueryOffset,
 					QueryEndpoints:  emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 
@@ -1105,6 +1112,56 @@ func TestRuleQueryOffset(t *testing.T) {
 	}
 }
 
+func TestQueryDiscovery(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryDiscovery: &monitoringv1.ThanosRulerQueryDiscovery{
+				Services: []monitoringv1.ThanosRulerQueryService{{Name: "query"}},
+			},
+		},
+	}
+
+	sset, err := makeStatefulSet(tr, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
+	require.NoError(t, err)
+
+	container := sset.Spec.Template.Spec.Containers[0]
+	require.Contains(t, container.Args, "--query.sd-files=/etc/thanos/config/query-sd-config/query-sd.yaml")
+	require.Contains(t, container.VolumeMounts, v1.VolumeMount{
+		Name:      "query-sd-config",
+		MountPath: "/etc/thanos/config/query-sd-config",
+		ReadOnly:  true,
+	})
+
+	// The endpoints are read from the ruler config secret which isn't
+	// mounted with subPath so that updates are propagated to the pods.
+	var found bool
+	for _, vol := range sset.Spec.Template.Spec.Volumes {
+		if vol.Name == "query-sd-config" {
+			require.Equal(t, "thanos-ruler-test-config", vol.Secret.SecretName)
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	// Static endpoints can be combined with query discovery.
+	tr.Spec.QueryEndpoints = []string{"static:9090"}
+	sset, err = makeStatefulSet(tr, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
+	require.NoError(t, err)
+	require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args, "--query=static:9090")
+
+	// But not with a query config.
+	tr.Spec.QueryConfig = &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "query"},
+		Key:                  "query.yaml",
+	}
+	_, err = makeStatefulSet(tr, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
+	require.Error(t, err)
+}
+
 func TestRuleConcurrentEval(t *testing.T) {
 	ruleConcurrentEval := int32(5)
 
@@ -1140,7 +1197,7 @@ func TestRuleConcurrentEval(t *testing.T) {
 					RuleConcurrentEval: ts.ruleConcurrentEval,
 					QueryEndpoints:     emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 
@@ -1194,7 +1251,7 @@ func TestRuleOutageTolerance(t *testing.T) {
 					RuleOutageTolerance: ts.ruleOutageTolerance,
 					QueryEndpoints:      emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 
@@ -1248,7 +1305,7 @@ func TestRuleGracePeriod(t *testing.T) {
 					RuleGracePeriod: ts.ruleGracePeriod,
 					QueryEndpoints:  emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 
@@ -1290,7 +1347,7 @@ func TestRuleResendDelay(t *testing.T) {
 					ResendDelay:    ts.resendDelay,
 					QueryEndpoints: emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 
@@ -1352,7 +1409,7 @@ func TestEnableFeatures(t *testing.T) {
 					EnableFeatures: ts.enableFeatures,
 					QueryEndpoints: emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
 
 			require.NoError(t, err)
 
@@ -1375,3 +1432,77 @@ func TestEnableFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestStatefulSetShards(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+		},
+	}
+
+	// Without sharding, the statefulset name and arguments aren't modified.
+	require.Equal(t, []string{"thanos-ruler-test"}, expectedStatefulSetShardNames(tr))
+	sset, err := makeStatefulSet(tr, defaultTestConfig, nil, "", 0, &operator.ShardedSecret{})
+	require.NoError(t, err)
+	require.Equal(t, "thanos-ruler-test", sset.Name)
+	require.Equal(t, "0", sset.Spec.Selector.MatchLabels["operator.prometheus.io/shard"])
+	for _, arg := range sset.Spec.Template.Spec.Containers[0].Args {
+		require.False(t, strings.HasPrefix(arg, "--label=thanos_ruler_shard="), arg)
+	}
+
+	tr.Spec.Shards = ptr.To(int32(3))
+	require.Equal(t, []string{"thanos-ruler-test", "thanos-ruler-test-shard-1", "thanos-ruler-test-shard-2"}, expectedStatefulSetShardNames(tr))
+
+	for _, tc := range []struct {
+		shard int32
+		name  string
+	}{
+		{shard: 0, name: "thanos-ruler-test"},
+		{shard: 2, name: "thanos-ruler-test-shard-2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet(tr, defaultTestConfig, nil, "", tc.shard, &operator.ShardedSecret{})
+			require.NoError(t, err)
+
+			shardID := fmt.Sprintf("%d", tc.shard)
+			require.Equal(t, tc.name, sset.Name)
+			require.Equal(t, shardID, sset.Labels["operator.prometheus.io/shard"])
+			require.Equal(t, shardID, sset.Spec.Template.Labels["operator.prometheus.io/shard"])
+			require.Equal(t, shardID, sset.Spec.Selector.MatchLabels["operator.prometheus.io/shard"])
+			require.Contains(t, sset.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf(`--label=thanos_ruler_shard="%d"`, tc.shard))
+		})
+	}
+}
+
+func TestStatefulSetShardSelectorsAreDisjoint(t *testing.T) {
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryEndpoints: emptyQueryEndpoints,
+			Shards:         ptr.To(int32(3)),
+		},
+	}
+
+	ssets := make([]*appsv1.StatefulSet, 0, shardsNumber(tr))
+	for shard := range shardsNumber(tr) {
+		sset, err := makeStatefulSet(tr, defaultTestConfig, nil, "", shard, &operator.ShardedSecret{})
+		require.NoError(t, err)
+		ssets = append(ssets, sset)
+	}
+
+	for i, sset := range ssets {
+		selector, err := metav1.LabelSelectorAsSelector(sset.Spec.Selector)
+		require.NoError(t, err)
+
+		for j, other := range ssets {
+			require.Equal(t, i == j, selector.Matches(labels.Set(other.Spec.Template.Labels)), "selector of shard %d matching pods of shard %d", i, j)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const storeControllerName = "thanos-store-controller"
+
+// StoreOperator manages the life cycle of Thanos Store Gateway statefulsets.
+type StoreOperator struct {
+	*bucketOperator
+
+	storeInfs *informers.ForResource
+}
+
+// NewStoreOperator creates a new controller for ThanosStore objects.
+func NewStoreOperator(restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...BucketControllerOption) (*StoreOperator, error) {
+	logger = logger.With("component", storeControllerName)
+
+	// All the metrics exposed by the controller get the controller="thanos-store" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "thanos-store"}, r)
+
+	bo, err := newBucketOperator(restConfig, c, logger, r, storeApplicationNameLabelValue, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &StoreOperator{bucketOperator: bo}
+
+	o.storeInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ThanosStoreName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanosstore informers: %w", err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.storeInfs,
+		o.metrics,
+		monitoringv1alpha1.ThanosStoreKind,
+		r,
+		o.controllerID,
+	)
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *StoreOperator) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{"ThanosStore", o.storeInfs},
+		{"StatefulSet", o.ssetInfs},
+	} {
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, "thanos-store", o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// Run the controller.
+func (o *StoreOperator) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.storeInfs.Start(ctx.Done())
+	go o.ssetInfs.Start(ctx.Done())
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	// Refresh the status of the existing ThanosStore objects.
+	_ = o.storeInfs.ListAll(labels.Everything(), func(obj any) {
+		o.rr.EnqueueForStatus(obj.(*monitoringv1alpha1.ThanosStore))
+	})
+
+	o.storeInfs.AddEventHandler(o.rr)
+	o.ssetInfs.AddEventHandler(o.rr)
+
+	go bucketStatusPoller(ctx, o, bucketStatusRefreshInterval)
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// Iterate implements the operator.StatusReconciler interface.
+func (o *StoreOperator) Iterate(processFn func(metav1.Object, []monitoringv1.Condition)) {
+	if err := o.storeInfs.ListAll(labels.Everything(), func(o any) {
+		ts := o.(*monitoringv1alpha1.ThanosStore)
+		processFn(ts, ts.Status.Conditions)
+	}); err != nil {
+		o.logger.Error("failed to list ThanosStore objects", "err", err)
+	}
+}
+
+// RefreshStatusFor implements the operator.StatusReconciler interface.
+func (o *StoreOperator) RefreshStatusFor(obj metav1.Object) {
+	o.rr.EnqueueForStatus(obj)
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *StoreOperator) Sync(ctx context.Context, key string) error {
+	err := o.sync(ctx, key)
+	o.reconciliations.SetStatus(key, err)
+
+	return err
+}
+
+func (o *StoreOperator) sync(ctx context.Context, key string) error {
+	ts, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosStore](o.storeInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if ts == nil {
+		o.forget(key)
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(ts) {
+		return nil
+	}
+
+	if ts.Spec.Paused {
+		return nil
+	}
+
+	operator.SetSpanObject(ctx, ts)
+	o.logger.Info("sync thanos-store", "key", key)
+
+	if err := operator.CheckStorageClass(ctx, o.canReadStorageClass, o.kclient, ts.Spec.Storage); err != nil {
+		return err
+	}
+
+	assetStore := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
+	if err := assetStore.AddTLSConfig(ctx, ts.Namespace, ts.Spec.GRPCServerTLSConfig); err != nil {
+		return err
+	}
+
+	c := makeStoreComponent(ts, assetStore.ForNamespace(ts.Namespace))
+
+	spanCtx, span := operator.StartSpan(ctx, "apply-tls-assets")
+	tlsAssets, err := operator.ReconcileShardedSecret(spanCtx, assetStore.TLSAssets(), o.kclient, newBucketTLSAssetSecret(c, o.config))
+	operator.EndSpan(span, err)
+	if err != nil {
+		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
+	}
+
+	return o.syncComponent(ctx, key, c, tlsAssets)
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+func (o *StoreOperator) UpdateStatus(ctx context.Context, key string) error {
+	ts, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosStore](o.storeInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if ts == nil {
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(ts) {
+		return nil
+	}
+
+	// The TLS assets aren't needed to query the pods.
+	c := &bucketComponent{
+		application: storeApplicationNameLabelValue,
+		owner:       ts,
+		common:      &ts.Spec.CommonThanosBucketFields,
+	}
+
+	conditions, ok, err := o.updateStatus(ctx, key, c, ts, ts.Status.Conditions, false)
+	if err != nil || !ok {
+		return err
+	}
+
+	ts.Status.Conditions = conditions
+	ts.Status.Paused = ts.Spec.Paused
+
+	tsac := monitoringv1alpha1ac.ThanosStore(ts.Name, ts.Namespace).WithStatus(applyConfigurationFromBucketStatus(&ts.Status))
+	if _, err = o.mclient.MonitoringV1alpha1().ThanosStores(ts.Namespace).ApplyStatus(ctx, tsac, metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+// makeStoreComponent returns the statefulset description of the ThanosStore
+// object.
+func makeStoreComponent(ts *monitoringv1alpha1.ThanosStore, store assets.StoreGetter) *bucketComponent {
+	var (
+		args         []monitoringv1.Argument
+		volumes      []v1.Volume
+		volumeMounts []v1.VolumeMount
+		fullPath     string
+	)
+
+	if ts.Spec.MinTime != "" {
+		args = append(args, monitoringv1.Argument{Name: "min-time", Value: ts.Spec.MinTime})
+	}
+
+	if ts.Spec.MaxTime != "" {
+		args = append(args, monitoringv1.Argument{Name: "max-time", Value: ts.Spec.MaxTime})
+	}
+
+	if ts.Spec.IndexCacheConfig != nil {
+		volumes, volumeMounts, fullPath = mountSecretKey(volumes, volumeMounts, ts.Spec.IndexCacheConfig, "index-cache-config")
+		args = append(args, monitoringv1.Argument{Name: "index-cache.config-file", Value: fullPath})
+	}
+
+	if ts.Spec.CachingBucketConfig != nil {
+		volumes, volumeMounts, fullPath = mountSecretKey(volumes, volumeMounts, ts.Spec.CachingBucketConfig, "caching-bucket-config")
+		args = append(args, monitoringv1.Argument{Name: "store.caching-bucket.config-file", Value: fullPath})
+	}
+
+	if tls := ts.Spec.GRPCServerTLSConfig; tls != nil {
+		cert, key, ca := tlsFiles(tls, store)
+		if cert != "" {
+			args = append(args, monitoringv1.Argument{Name: "grpc-server-tls-cert", Value: cert})
+		}
+		if key != "" {
+			args = append(args, monitoringv1.Argument{Name: "grpc-server-tls-key", Value: key})
+		}
+		if ca != "" {
+			args = append(args, monitoringv1.Argument{Name: "grpc-server-tls-client-ca", Value: ca})
+		}
+	}
+
+	return &bucketComponent{
+		application:  storeApplicationNameLabelValue,
+		command:      "store",
+		owner:        ts,
+		common:       &ts.Spec.CommonThanosBucketFields,
+		replicas:     ts.Spec.Replicas,
+		args:         args,
+		volumes:      volumes,
+		volumeMounts: volumeMounts,
+		grpc:         true,
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/config"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// clientTimeout is the timeout of the requests sent by the HTTP clients.
+const clientTimeout = 10 * time.Second
+
+// Endpoint identifies the web server of a pod.
+type Endpoint struct {
+	// URL is the base URL of the web server.
+	URL url.URL
+	// Client is the HTTP client configured with the TLS settings and the
+	// credentials of the web server.
+	Client *http.Client
+}
+
+// PodEndpoint returns the endpoint of the web server listening on the given
+// port of the pod. The HTTP client is created by NewHTTPClient with the pod's
+// DNS name (`<hostname>.<subdomain>.<namespace>.svc`) as the default server
+// name.
+func PodEndpoint(ctx context.Context, kclient kubernetes.Interface, pod *v1.Pod, port int32, tlsConfig *monitoringv1.WebTLSConfig, clientConfig *monitoringv1.WebClientConfig) (Endpoint, error) {
+	scheme := "http"
+	if tlsConfig != nil {
+		scheme = "https"
+	}
+
+	serverName := pod.Name
+	if pod.Spec.Hostname != "" && pod.Spec.Subdomain != "" {
+		serverName = fmt.Sprintf("%s.%s.%s.svc", pod.Spec.Hostname, pod.Spec.Subdomain, pod.Namespace)
+	}
+
+	client, err := NewHTTPClient(
+		ctx,
+		assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1()),
+		pod.Namespace,
+		tlsConfig,
+		clientConfig,
+		serverName,
+	)
+	if err != nil {
+		return Endpoint{}, err
+	}
+
+	return Endpoint{
+		URL: url.URL{
+			Scheme: scheme,
+			Host:   net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port))),
+		},
+		Client: client,
+	}, nil
+}
+
+// NewHTTPClient returns an HTTP client connecting to a web server configured
+// with the given TLS configuration.
+//
+// When the web server is configured with TLS, the server's certificate is
+// verified against the CA of the client's TLS configuration or, if not
+// defined, against the certificate of the web server's TLS configuration. The
+// server name defaults to serverName.
+// The client sends the credentials of the client configuration (if any).
+func NewHTTPClient(ctx context.Context, store *assets.StoreBuilder, namespace string, tlsConfig *monitoringv1.WebTLSConfig, clientConfig *monitoringv1.WebClientConfig, serverName string) (*http.Client, error) {
+	if err := clientConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	var cfg config.HTTPClientConfig
+	if clientConfig != nil {
+		if ba := clientConfig.BasicAuth; ba != nil {
+			username, err := store.GetSecretKey(ctx, namespace, ba.Username)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the basic auth username: %w", err)
+			}
+
+			password, err := store.GetSecretKey(ctx, namespace, ba.Password)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the basic auth password: %w", err)
+			}
+
+			cfg.BasicAuth = &config.BasicAuth{
+				Username: username,
+				Password: config.Secret(password),
+			}
+		}
+
+		if auth := clientConfig.Authorization; auth != nil {
+			credentials, err := store.GetSecretKey(ctx, namespace, *auth.Credentials)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the authorization credentials: %w", err)
+			}
+
+			cfg.Authorization = &config.Authorization{
+				Type:        strings.TrimSpace(auth.Type),
+				Credentials: config.Secret(credentials),
+			}
+			if cfg.Authorization.Type == "" {
+				cfg.Authorization.Type = "Bearer"
+			}
+		}
+	}
+
+	if tlsConfig != nil {
+		var err error
+		cfg.TLSConfig, err = makeClientTLSConfig(ctx, store, namespace, tlsConfig, clientConfig, serverName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := config.NewClientFromConfig(cfg, "prometheus-operator")
+	if err != nil {
+		return nil, err
+	}
+	client.Timeout = clientTimeout
+
+	return client, nil
+}
+
+func makeClientTLSConfig(ctx context.Context, store *assets.StoreBuilder, namespace string, tlsConfig *monitoringv1.WebTLSConfig, clientConfig *monitoringv1.WebClientConfig, serverName string) (config.TLSConfig, error) {
+	var (
+		safeTLSConfig monitoringv1.SafeTLSConfig
+		cfg           = config.TLSConfig{ServerName: serverName}
+	)
+	if clientConfig != nil && clientConfig.TLSConfig != nil {
+		safeTLSConfig = *clientConfig.TLSConfig
+	}
+
+	ca, err := store.GetKey(ctx, namespace, safeTLSConfig.CA)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to get the CA: %w", err)
+	}
+
+	if ca == "" {
+		if tlsConfig.CertFile != nil {
+			return cfg, errors.New("can't verify the web server's certificate defined by certFile")
+		}
+
+		ca, err = store.GetKey(ctx, namespace, tlsConfig.Cert)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to get the web server's certificate: %w", err)
+		}
+	}
+	cfg.CA = ca
+
+	if safeTLSConfig.Cert != (monitoringv1.SecretOrConfigMap{}) {
+		cfg.Cert, err = store.GetKey(ctx, namespace, safeTLSConfig.Cert)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to get the client certificate: %w", err)
+		}
+	}
+
+	if safeTLSConfig.KeySecret != nil {
+		key, err := store.GetSecretKey(ctx, namespace, *safeTLSConfig.KeySecret)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to get the client key: %w", err)
+		}
+		cfg.Key = config.Secret(key)
+	}
+
+	if safeTLSConfig.ServerName != nil {
+		cfg.ServerName = *safeTLSConfig.ServerName
+	}
+	cfg.InsecureSkipVerify = ptr.Deref(safeTLSConfig.InsecureSkipVerify, false)
+
+	if safeTLSConfig.MinVersion != nil {
+		cfg.MinVersion = config.TLSVersions[string(*safeTLSConfig.MinVersion)]
+	}
+	if safeTLSConfig.MaxVersion != nil {
+		cfg.MaxVersion = config.TLSVersions[string(*safeTLSConfig.MaxVersion)]
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webconfig_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/webconfig"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "admin" || p != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	store := assets.NewTestStoreBuilder(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-tls", Namespace: "ns"},
+			Data: map[string][]byte{
+				"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}),
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "ns"},
+			Data: map[string][]byte{
+				"username": []byte("admin"),
+				"password": []byte("secret"),
+			},
+		},
+	)
+
+	tlsConfig := &monitoringv1.WebTLSConfig{
+		Cert: monitoringv1.SecretOrConfigMap{
+			Secret: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "web-tls"},
+				Key:                  "tls.crt",
+			},
+		},
+	}
+
+	basicAuth := &monitoringv1.BasicAuth{
+		Username: v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "credentials"},
+			Key:                  "username",
+		},
+		Password: v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "credentials"},
+			Key:                  "password",
+		},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		clientConfig *monitoringv1.WebClientConfig
+		serverName   string
+		expectedCode int
+		expectedErr  bool
+	}{
+		{
+			name:         "no credentials",
+			serverName:   "example.com",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "basic auth",
+			clientConfig: &monitoringv1.WebClientConfig{BasicAuth: basicAuth},
+			serverName:   "example.com",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "server name mismatch",
+			clientConfig: &monitoringv1.WebClientConfig{BasicAuth: basicAuth},
+			serverName:   "pod-0.svc.ns.svc",
+			expectedErr:  true,
+		},
+		{
+			name: "server name override",
+			clientConfig: &monitoringv1.WebClientConfig{
+				BasicAuth: basicAuth,
+				TLSConfig: &monitoringv1.SafeTLSConfig{ServerName: ptr.To("example.com")},
+			},
+			serverName:   "pod-0.svc.ns.svc",
+			expectedCode: http.StatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := webconfig.NewHTTPClient(context.Background(), store, "ns", tlsConfig, tc.clientConfig, tc.serverName)
+			require.NoError(t, err)
+
+			resp, err := client.Get(srv.URL)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			require.Equal(t, tc.expectedCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestPodEndpoint(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	host, p, err := net.SplitHostPort(u.Host)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(p)
+	require.NoError(t, err)
+
+	kclient := fake.NewClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-tls", Namespace: "ns"},
+			Data: map[string][]byte{
+				"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}),
+			},
+		},
+	)
+
+	tlsConfig := &monitoringv1.WebTLSConfig{
+		Cert: monitoringv1.SecretOrConfigMap{
+			Secret: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "web-tls"}, Key: "tls.crt"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		tlsConfig   *monitoringv1.WebTLSConfig
+		spec        v1.PodSpec
+		scheme      string
+		expectedErr bool
+	}{
+		{
+			name:   "no TLS",
+			scheme: "http",
+		},
+		{
+			// The test server's certificate is valid for example.com.
+			name:      "pod name as server name",
+			tlsConfig: tlsConfig,
+			scheme:    "https",
+		},
+		{
+			name:        "pod DNS name as server name",
+			tlsConfig:   tlsConfig,
+			spec:        v1.PodSpec{Hostname: "pod-0", Subdomain: "svc"},
+			scheme:      "https",
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "example.com", Namespace: "ns"},
+				Spec:       tc.spec,
+				Status:     v1.PodStatus{PodIP: host},
+			}
+
+			ep, err := webconfig.PodEndpoint(context.Background(), kclient, pod, int32(port), tc.tlsConfig, nil)
+			require.NoError(t, err)
+			require.Equal(t, url.URL{Scheme: tc.scheme, Host: u.Host}, ep.URL)
+
+			if tc.tlsConfig == nil {
+				return
+			}
+
+			resp, err := ep.Client.Get(ep.URL.String())
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			resp.Body.Close()
+		})
+	}
+}
@@ -29,10 +29,10 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 )
 
-var (
-	volumeName = "web-config"
-	configFile = "web-config.yaml"
-)
+// ConfigFile is the name of the web config file.
+const ConfigFile = "web-config.yaml"
+
+var volumeName = "web-config"
 
 // Config is the web configuration for prometheus and alertmanager instance.
 //
@@ -67,7 +67,7 @@ func New(mountingDir string, secretName string, configFileFields monitoringv1.We
 // In addition, GetMountParameters returns a web.config.file command line option pointing
 // to the file in the volume mount.
 func (c Config) GetMountParameters() (monitoringv1.Argument, []v1.Volume, []v1.VolumeMount, error) {
-	destinationPath := path.Join(c.mountingDir, configFile)
+	destinationPath := path.Join(c.mountingDir, ConfigFile)
 
 	var volumes []v1.Volume
 	var mounts []v1.VolumeMount
@@ -99,20 +99,21 @@ func (c Config) GetMountParameters() (monitoringv1.Argument, []v1.Volume, []v1.V
 // The format of the web config file is available in the official prometheus documentation:
 // https://prometheus.io/docs/prometheus/latest/configuration/https/#https-and-authentication
 func (c Config) CreateOrUpdateWebConfigSecret(ctx context.Context, secretClient clientv1.SecretInterface, s *v1.Secret) error {
-	data, err := c.generateConfigFileContents()
+	data, err := c.GenerateConfigFileContents()
 	if err != nil {
 		return err
 	}
 
 	s.Name = c.secretName
 	s.Data = map[string][]byte{
-		configFile: data,
+		ConfigFile: data,
 	}
 
 	return k8sutil.CreateOrUpdateSecret(ctx, secretClient, s)
 }
 
-func (c Config) generateConfigFileContents() ([]byte, error) {
+// GenerateConfigFileContents returns the contents of the web config file.
+func (c Config) GenerateConfigFileContents() ([]byte, error) {
 	if c.tlsConfig == nil && c.httpConfig == nil {
 		return []byte{}, nil
 	}
@@ -272,7 +273,7 @@ func (c Config) makeVolume() v1.Volume {
 func (c Config) makeVolumeMount(filePath string) v1.VolumeMount {
 	return v1.VolumeMount{
 		Name:      volumeName,
-		SubPath:   configFile,
+		SubPath:   ConfigFile,
 		ReadOnly:  true,
 		MountPath: filePath,
 	}